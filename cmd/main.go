@@ -1,12 +1,29 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"github.com/aalug/cv-backend-go/internal/api"
 	"github.com/aalug/cv-backend-go/internal/config"
+	memdb "github.com/aalug/cv-backend-go/internal/db/memory"
+	"github.com/aalug/cv-backend-go/internal/db/migrations"
 	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	sqlitedb "github.com/aalug/cv-backend-go/internal/db/sqlite"
+	"github.com/aalug/cv-backend-go/internal/logger"
+	"github.com/aalug/cv-backend-go/internal/metrics"
+	"github.com/aalug/cv-backend-go/internal/resume"
+	"github.com/aalug/cv-backend-go/internal/seed"
+	"github.com/aalug/cv-backend-go/pkg/utils"
 	_ "github.com/lib/pq"
 	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 )
 
 func main() {
@@ -15,25 +32,306 @@ func main() {
 		log.Fatal("cannot load env file: ", err)
 	}
 
-	conn, err := sql.Open(cfg.DBDriver, cfg.DBSource)
+	appLogger, err := logger.New(os.Stdout, cfg.LogLevel)
 	if err != nil {
-		log.Fatal("cannot connect to the db: ", err)
+		log.Fatal("cannot create logger: ", err)
 	}
+	// the commands log with the log package, route it through the JSON logger as well
+	slog.SetDefault(appLogger)
 
-	store := db.NewStore(conn)
+	command, args := "serve", os.Args[1:]
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
 
 	// @BasePath /api/v1
 	// @contact.name aalug
 	// @contact.url https://github.com/aalug
 	// @contact.email a.a.gulczynski@gmail.com
 
-	server := api.NewServer(cfg, store)
+	// @securityDefinitions.apikey BearerAuth
+	// @in header
+	// @name Authorization
+	// @description Type "Bearer" followed by a space and the access token from /auth/login.
+
+	switch cfg.StoreBackend {
+	case config.StoreBackendPostgres:
+	case config.StoreBackendMemory:
+		// without a database only the server can run, the other commands would change data that is thrown away
+		if command != "serve" {
+			log.Fatalf("command %q needs STORE_BACKEND=%s", command, config.StoreBackendPostgres)
+		}
+		serveMemory(cfg, appLogger)
+		return
+	default:
+		log.Fatalf("unknown STORE_BACKEND %q, available backends: %s, %s", cfg.StoreBackend, config.StoreBackendPostgres, config.StoreBackendMemory)
+	}
+
+	conn := openDB(cfg)
+
+	switch command {
+	case "serve":
+		serveDB(cfg, conn, appLogger)
+	case "migrate":
+		runMigrations(cfg.DBDriver, conn, args)
+	case "createuser":
+		// create a user for the admin endpoints
+		createUser(newStore(cfg.DBDriver, conn, nil), args)
+	case "importresume":
+		// import a cv profile from a JSON Resume file
+		importResume(newStore(cfg.DBDriver, conn, nil), args)
+	case "seed":
+		// create or update a cv profile from a YAML seed file
+		seedCvProfile(newStore(cfg.DBDriver, conn, nil), args)
+	default:
+		log.Fatalf("unknown command %q, available commands: serve, migrate, createuser, importresume, seed", command)
+	}
+}
+
+// openDB connects to the database of DB_DRIVER and checks that it is reachable
+func openDB(cfg config.Config) *sql.DB {
+	var conn *sql.DB
+	var err error
+	switch cfg.DBDriver {
+	case config.DBDriverPostgres:
+		conn, err = sql.Open(cfg.DBDriver, cfg.DBSource)
+		if err != nil {
+			log.Fatal("cannot connect to the db: ", err)
+		}
+
+		conn.SetMaxOpenConns(cfg.DBMaxOpenConns)
+		conn.SetMaxIdleConns(cfg.DBMaxIdleConns)
+		conn.SetConnMaxLifetime(cfg.DBConnMaxLifetime)
+		conn.SetConnMaxIdleTime(cfg.DBConnMaxIdleTime)
+	case config.DBDriverSQLite:
+		// SQLite has a single writer, Open limits the pool to one connection and ignores the pool settings
+		conn, err = sqlitedb.Open(cfg.DBSource)
+		if err != nil {
+			log.Fatal("cannot open the db: ", err)
+		}
+	default:
+		log.Fatalf("unknown DB_DRIVER %q, available drivers: %s, %s", cfg.DBDriver, config.DBDriverPostgres, config.DBDriverSQLite)
+	}
+
+	// sql.Open only validates the arguments, fail fast when the db is not reachable
+	pingCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	err = conn.PingContext(pingCtx)
+	cancel()
+	if err != nil {
+		log.Fatal("cannot ping the db: ", err)
+	}
+
+	return conn
+}
+
+// serveDB serves the data of the database, with AUTO_MIGRATE the migrations are applied first
+func serveDB(cfg config.Config, conn *sql.DB, appLogger *slog.Logger) {
+	if cfg.AutoMigrate {
+		migrateUp(cfg.DBDriver, conn)
+	}
+
+	m := metrics.New()
+	if err := m.RegisterDB(conn, "cv_db"); err != nil {
+		log.Fatal("cannot register db metrics: ", err)
+	}
+
+	serve(cfg, newStore(cfg.DBDriver, conn, m.ObserveQuery), m, appLogger)
+}
+
+// newStore creates the store of the DB_DRIVER database, observe can be nil
+func newStore(driverName string, conn *sql.DB, observe db.QueryObserver) db.Store {
+	if driverName == config.DBDriverSQLite {
+		return sqlitedb.NewInstrumentedStore(conn, observe)
+	}
+	return db.NewInstrumentedStore(conn, observe)
+}
+
+// serveMemory serves data kept in memory, with SEED_FILE the store starts with the cv profile of the file
+func serveMemory(cfg config.Config, appLogger *slog.Logger) {
+	store := memdb.NewStore()
+
+	if cfg.SeedFile != "" {
+		params, err := readSeedFile(cfg.SeedFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		cvProfile, err := store.SeedCvProfileTx(context.Background(), params)
+		if err != nil {
+			log.Fatal("cannot seed cv profile: ", err)
+		}
+		log.Printf("cv profile %d seeded", cvProfile.ID)
+	}
+
+	log.Println("using the in-memory store, all data is lost when the server stops")
+	serve(cfg, store, metrics.New(), appLogger)
+}
+
+// serve runs the HTTP server until it receives SIGINT or SIGTERM
+func serve(cfg config.Config, store db.Store, m *metrics.Metrics, appLogger *slog.Logger) {
+	server, err := api.NewServer(cfg, store, m, appLogger)
 	if err != nil {
 		log.Fatal("cannot create server: ", err)
 	}
 
-	err = server.Start(cfg.ServerAddress)
+	// stop the server gracefully on Ctrl+C and when the container is stopped
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err = server.Start(ctx, cfg.ServerAddress)
+	if err != nil {
+		log.Fatal("cannot start the server: ", err)
+	}
+
+	log.Println("server stopped")
+}
+
+// runMigrations runs the embedded migrations, args are "up", "down N" or "version"
+func runMigrations(driverName string, conn *sql.DB, args []string) {
+	const usage = "usage: migrate up | migrate down <N> | migrate version"
+	if len(args) == 0 {
+		log.Fatal(usage)
+	}
+
+	switch {
+	case args[0] == "up" && len(args) == 1:
+		migrateUp(driverName, conn)
+	case args[0] == "down" && len(args) == 2:
+		n, err := strconv.Atoi(args[1])
+		if err != nil {
+			log.Fatal(usage)
+		}
+
+		migrator := newMigrator(driverName, conn)
+		defer migrator.Close()
+		if err := migrator.Down(n); err != nil {
+			log.Fatal("cannot roll back migrations: ", err)
+		}
+		logMigrationVersion(migrator)
+	case args[0] == "version" && len(args) == 1:
+		migrator := newMigrator(driverName, conn)
+		defer migrator.Close()
+		logMigrationVersion(migrator)
+	default:
+		log.Fatal(usage)
+	}
+}
+
+// migrateUp applies all migrations that have not been applied yet
+func migrateUp(driverName string, conn *sql.DB) {
+	migrator := newMigrator(driverName, conn)
+	defer migrator.Close()
+
+	if err := migrator.Up(); err != nil {
+		log.Fatal("cannot apply migrations: ", err)
+	}
+	logMigrationVersion(migrator)
+}
+
+func newMigrator(driverName string, conn *sql.DB) *migrations.Migrator {
+	migrator, err := migrations.NewMigrator(context.Background(), driverName, conn)
+	if err != nil {
+		log.Fatal("cannot create migrator: ", err)
+	}
+	return migrator
+}
+
+func logMigrationVersion(migrator *migrations.Migrator) {
+	version, dirty, err := migrator.Version()
 	if err != nil {
-		log.Fatal("cannot start the server:", err)
+		log.Fatal("cannot get migration version: ", err)
 	}
+	log.Printf("schema version %d (latest %d), dirty: %t", version, migrations.LatestVersion(), dirty)
+}
+
+// createUser creates a user with a hashed password, args are the username and the password
+func createUser(store db.Store, args []string) {
+	if len(args) != 2 {
+		log.Fatal("usage: createuser <username> <password>")
+	}
+
+	hashedPassword, err := utils.HashPassword(args[1])
+	if err != nil {
+		log.Fatal("cannot hash password: ", err)
+	}
+
+	params := db.CreateUserParams{
+		Username:       args[0],
+		HashedPassword: hashedPassword,
+	}
+	user, err := store.CreateUser(context.Background(), params)
+	if err != nil {
+		log.Fatal("cannot create user: ", err)
+	}
+
+	log.Printf("user %s created", user.Username)
+}
+
+// importResume creates a cv profile with all of its children from a JSON Resume file, args is the path to the file
+func importResume(store db.Store, args []string) {
+	if len(args) != 1 {
+		log.Fatal("usage: importresume <resume.json>")
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		log.Fatal("cannot read resume file: ", err)
+	}
+
+	var jsonResume resume.JSONResume
+	if err := json.Unmarshal(data, &jsonResume); err != nil {
+		log.Fatal("cannot parse resume file: ", err)
+	}
+
+	params, err := jsonResume.ImportParams()
+	if err != nil {
+		log.Fatal("invalid resume: ", err)
+	}
+
+	cvProfile, err := store.ImportCvProfileTx(context.Background(), params)
+	if err != nil {
+		log.Fatal("cannot import resume: ", err)
+	}
+
+	log.Printf("cv profile %d created", cvProfile.ID)
+}
+
+// seedCvProfile creates or updates a cv profile, with all of its children, from a YAML seed file
+func seedCvProfile(store db.Store, args []string) {
+	if len(args) != 1 {
+		log.Fatal("usage: seed <seed.yaml>")
+	}
+
+	params, err := readSeedFile(args[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cvProfile, err := store.SeedCvProfileTx(context.Background(), params)
+	if err != nil {
+		log.Fatal("cannot seed cv profile: ", err)
+	}
+
+	log.Printf("cv profile %d seeded", cvProfile.ID)
+}
+
+// readSeedFile parses and validates a YAML seed file
+func readSeedFile(path string) (db.SeedCvProfileTxParams, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return db.SeedCvProfileTxParams{}, fmt.Errorf("cannot open seed file: %w", err)
+	}
+	defer f.Close()
+
+	file, err := seed.Parse(f)
+	if err != nil {
+		return db.SeedCvProfileTxParams{}, fmt.Errorf("cannot parse seed file: %w", err)
+	}
+
+	params, err := file.Params()
+	if err != nil {
+		return db.SeedCvProfileTxParams{}, fmt.Errorf("invalid seed file: %w", err)
+	}
+
+	return params, nil
 }
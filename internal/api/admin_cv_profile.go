@@ -0,0 +1,58 @@
+package api
+
+import (
+	"github.com/aalug/cv-backend-go/internal/resume"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type cvProfileIDRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"` // profile cv id
+}
+
+type importJSONResumeResponse struct {
+	CvProfileID int32  `json:"cv_profile_id"`
+	Name        string `json:"name"`
+}
+
+// @Schemes
+// @Summary Import CV profile from JSON Resume
+// @Description Create a CV profile with its education, work experience, skills and projects from a resume.json file in the jsonresume.org schema
+// @Tags admin
+// @Security BearerAuth
+// @Param request body resume.JSONResume true "Resume in the JSON Resume schema"
+// @Accept json
+// @Produce json
+// @Success 201 {object} importJSONResumeResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Missing, invalid or expired access token"
+// @Failure 409 {object} ErrorResponse "A skill from the resume already exists"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /admin/cv-profiles/import [post]
+// importJSONResume handles creating a cv profile from a JSON Resume
+func (server *Server) importJSONResume(ctx *gin.Context) {
+	var request resume.JSONResume
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	params, err := request.ImportParams()
+	if err != nil {
+		writeError(ctx, newAPIError(http.StatusBadRequest, CodeValidationFailed, err.Error(), nil))
+		return
+	}
+
+	cvProfile, err := server.store.ImportCvProfileTx(ctx, params)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeNotFound))
+		return
+	}
+	setProfileID(ctx, cvProfile.ID)
+
+	ctx.JSON(http.StatusCreated, importJSONResumeResponse{
+		CvProfileID: cvProfile.ID,
+		Name:        cvProfile.Name,
+	})
+}
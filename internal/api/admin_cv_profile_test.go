@@ -0,0 +1,194 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/internal/resume"
+	"github.com/aalug/cv-backend-go/internal/token"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestImportJSONResumeAPI(t *testing.T) {
+	username := utils.RandomString(6)
+	cvProfile := generateRandomCvProfile()
+	jsonResume := resume.ToJSONResume(resume.Data{
+		Profile:    cvProfile,
+		Experience: generateRandomCvExperienceRows(),
+		Skills:     generateRandomSkills(),
+		Projects:   generateRandomProjectRows(),
+	})
+	params, err := jsonResume.ImportParams()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		body          interface{}
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: jsonResume,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ImportCvProfileTx(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(cvProfile, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchImportedCvProfile(t, recorder.Body, cvProfile)
+			},
+		},
+		{
+			name:      "No Authorization",
+			body:      jsonResume,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ImportCvProfileTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Missing Name",
+			body: resume.JSONResume{Basics: resume.JSONResumeBasics{Email: utils.RandomEmail()}},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ImportCvProfileTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Date",
+			body: resume.JSONResume{
+				Basics: resume.JSONResumeBasics{Name: cvProfile.Name, Email: cvProfile.Email},
+				Work: []resume.JSONResumeWork{
+					{Name: utils.RandomString(5), Position: utils.RandomString(5), StartDate: "yesterday"},
+				},
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ImportCvProfileTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid JSON",
+			body: []int{1, 2},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ImportCvProfileTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Unique Violation",
+			body: jsonResume,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ImportCvProfileTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CvProfile{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: jsonResume,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ImportCvProfileTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CvProfile{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/cv-profiles/import", baseUrl)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+// requireBodyMatchImportedCvProfile asserts that the response body matches the created cv profile
+func requireBodyMatchImportedCvProfile(t *testing.T, body *bytes.Buffer, cvProfile db.CvProfile) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotResponse importJSONResumeResponse
+	err = json.Unmarshal(data, &gotResponse)
+	require.NoError(t, err)
+
+	require.Equal(t, cvProfile.ID, gotResponse.CvProfileID)
+	require.Equal(t, cvProfile.Name, gotResponse.Name)
+}
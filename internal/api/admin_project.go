@@ -0,0 +1,310 @@
+package api
+
+import (
+	"database/sql"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type projectIDRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"` // project id
+}
+
+type createProjectRequest struct {
+	Title            string  `json:"title" binding:"required"`
+	ShortDescription string  `json:"short_description" binding:"required"`
+	Description      string  `json:"description" binding:"required"`
+	Image            string  `json:"image" binding:"required"`
+	HexThemeColor    string  `json:"hex_theme_color" binding:"required,hexcolor"`
+	ProjectUrl       string  `json:"project_url" binding:"required"`
+	Significance     int32   `json:"significance" binding:"min=0"`
+	CvProfileID      int32   `json:"cv_profile_id" binding:"required,min=1"`
+	SkillIDs         []int32 `json:"skill_ids" binding:"unique,dive,min=1"`
+	TechnologyIDs    []int32 `json:"technology_ids" binding:"unique,dive,min=1"`
+}
+
+// @Schemes
+// @Summary Create project
+// @Description Create a project together with its skills and technologies
+// @Tags admin
+// @Security BearerAuth
+// @Param request body createProjectRequest true "Project details"
+// @Accept json
+// @Produce json
+// @Success 201 {object} db.ListProjectsWithTechnologiesRow
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Missing, invalid or expired access token"
+// @Failure 404 {object} ErrorResponse "CV profile, skill or technology with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /admin/projects [post]
+// createProject handles creating a project with its skill and technology links
+func (server *Server) createProject(ctx *gin.Context) {
+	var request createProjectRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+	setProfileID(ctx, request.CvProfileID)
+
+	params := db.CreateProjectTxParams{
+		CreateProjectParams: db.CreateProjectParams{
+			Title:            request.Title,
+			ShortDescription: request.ShortDescription,
+			Description:      request.Description,
+			Image:            request.Image,
+			HexThemeColor:    request.HexThemeColor,
+			ProjectUrl:       request.ProjectUrl,
+			Significance:     request.Significance,
+			CvProfileID:      request.CvProfileID,
+		},
+		SkillIDs:      request.SkillIDs,
+		TechnologyIDs: request.TechnologyIDs,
+	}
+
+	project, err := server.store.CreateProjectTx(ctx, params)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProjectNotFound))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, project)
+}
+
+type updateProjectRequest struct {
+	Title            string  `json:"title" binding:"required"`
+	ShortDescription string  `json:"short_description" binding:"required"`
+	Description      string  `json:"description" binding:"required"`
+	Image            string  `json:"image" binding:"required"`
+	HexThemeColor    string  `json:"hex_theme_color" binding:"required,hexcolor"`
+	ProjectUrl       string  `json:"project_url" binding:"required"`
+	Significance     int32   `json:"significance" binding:"min=0"`
+	SkillIDs         []int32 `json:"skill_ids" binding:"unique,dive,min=1"`
+	TechnologyIDs    []int32 `json:"technology_ids" binding:"unique,dive,min=1"`
+}
+
+// @Schemes
+// @Summary Replace project
+// @Description Replace all details, skills and technologies of a project with provided ID
+// @Tags admin
+// @Security BearerAuth
+// @Param id path integer true "Project ID"
+// @Param request body updateProjectRequest true "Project details"
+// @Accept json
+// @Produce json
+// @Success 200 {object} db.ListProjectsWithTechnologiesRow
+// @Failure 400 {object} ErrorResponse "Invalid ID or request body"
+// @Failure 401 {object} ErrorResponse "Missing, invalid or expired access token"
+// @Failure 404 {object} ErrorResponse "Project, skill or technology with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /admin/projects/{id} [put]
+// updateProject handles replacing a project with its skill and technology links
+func (server *Server) updateProject(ctx *gin.Context) {
+	var uriRequest projectIDRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	var request updateProjectRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	// PUT replaces the whole project, so missing links mean no links
+	if request.SkillIDs == nil {
+		request.SkillIDs = []int32{}
+	}
+	if request.TechnologyIDs == nil {
+		request.TechnologyIDs = []int32{}
+	}
+
+	params := db.UpdateProjectTxParams{
+		UpdateProjectParams: db.UpdateProjectParams{
+			ID:               uriRequest.ID,
+			Title:            request.Title,
+			ShortDescription: request.ShortDescription,
+			Description:      request.Description,
+			Image:            request.Image,
+			HexThemeColor:    request.HexThemeColor,
+			ProjectUrl:       request.ProjectUrl,
+			Significance:     request.Significance,
+		},
+		SkillIDs:      request.SkillIDs,
+		TechnologyIDs: request.TechnologyIDs,
+	}
+
+	project, err := server.store.UpdateProjectTx(ctx, params)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProjectNotFound))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, project)
+}
+
+type patchProjectRequest struct {
+	Title            *string `json:"title" binding:"omitempty,min=1"`
+	ShortDescription *string `json:"short_description" binding:"omitempty,min=1"`
+	Description      *string `json:"description" binding:"omitempty,min=1"`
+	Image            *string `json:"image" binding:"omitempty,min=1"`
+	HexThemeColor    *string `json:"hex_theme_color" binding:"omitempty,hexcolor"`
+	ProjectUrl       *string `json:"project_url" binding:"omitempty,min=1"`
+	Significance     *int32  `json:"significance" binding:"omitempty,min=0"`
+	SkillIDs         []int32 `json:"skill_ids" binding:"omitempty,unique,dive,min=1"`
+	TechnologyIDs    []int32 `json:"technology_ids" binding:"omitempty,unique,dive,min=1"`
+}
+
+// @Schemes
+// @Summary Update project
+// @Description Update only the provided fields of a project with provided ID. Skills and technologies are replaced only when provided.
+// @Tags admin
+// @Security BearerAuth
+// @Param id path integer true "Project ID"
+// @Param request body patchProjectRequest true "Project details to update"
+// @Accept json
+// @Produce json
+// @Success 200 {object} db.ListProjectsWithTechnologiesRow
+// @Failure 400 {object} ErrorResponse "Invalid ID or request body"
+// @Failure 401 {object} ErrorResponse "Missing, invalid or expired access token"
+// @Failure 404 {object} ErrorResponse "Project, skill or technology with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /admin/projects/{id} [patch]
+// patchProject handles partially updating a project
+func (server *Server) patchProject(ctx *gin.Context) {
+	var uriRequest projectIDRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	var request patchProjectRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	// the fields are merged in the UPDATE, so a concurrent patch cannot be overwritten with stale values
+	params := db.PatchProjectTxParams{
+		PatchProjectParams: db.PatchProjectParams{
+			ID: uriRequest.ID,
+		},
+		SkillIDs:      request.SkillIDs,
+		TechnologyIDs: request.TechnologyIDs,
+	}
+
+	if request.Title != nil {
+		params.Title = sql.NullString{String: *request.Title, Valid: true}
+	}
+	if request.ShortDescription != nil {
+		params.ShortDescription = sql.NullString{String: *request.ShortDescription, Valid: true}
+	}
+	if request.Description != nil {
+		params.Description = sql.NullString{String: *request.Description, Valid: true}
+	}
+	if request.Image != nil {
+		params.Image = sql.NullString{String: *request.Image, Valid: true}
+	}
+	if request.HexThemeColor != nil {
+		params.HexThemeColor = sql.NullString{String: *request.HexThemeColor, Valid: true}
+	}
+	if request.ProjectUrl != nil {
+		params.ProjectUrl = sql.NullString{String: *request.ProjectUrl, Valid: true}
+	}
+	if request.Significance != nil {
+		params.Significance = sql.NullInt32{Int32: *request.Significance, Valid: true}
+	}
+
+	updatedProject, err := server.store.PatchProjectTx(ctx, params)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProjectNotFound))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedProject)
+}
+
+// @Schemes
+// @Summary Delete project
+// @Description Delete a project with provided ID together with its skill and technology links
+// @Tags admin
+// @Security BearerAuth
+// @Param id path integer true "Project ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Missing, invalid or expired access token"
+// @Failure 404 {object} ErrorResponse "Project with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /admin/projects/{id} [delete]
+// deleteProject handles deleting a project
+func (server *Server) deleteProject(ctx *gin.Context) {
+	var request projectIDRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	err := server.store.DeleteProjectTx(ctx, request.ID)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProjectNotFound))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+type reorderProjectsRequest struct {
+	ProjectIDs []int32 `json:"project_ids" binding:"required,min=1,unique,dive,min=1"`
+}
+
+// @Schemes
+// @Summary Reorder projects
+// @Description Give the projects of a cv profile the significances of their positions in project_ids, the first one gets significance 1.
+// @Description project_ids has to list every project of the cv profile exactly once.
+// @Tags admin
+// @Security BearerAuth
+// @Param id path integer true "CV profile ID"
+// @Param request body reorderProjectsRequest true "The new order of the projects"
+// @Accept json
+// @Produce json
+// @Success 200 {object} []db.ListProjectsWithTechnologiesRow
+// @Failure 400 {object} ErrorResponse "Invalid ID or request body"
+// @Failure 401 {object} ErrorResponse "Missing, invalid or expired access token"
+// @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 409 {object} ErrorResponse "project_ids does not list every project of the cv profile exactly once (ORDER_MISMATCH)"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /admin/cv-profiles/{id}/projects/order [put]
+// reorderProjects handles changing the order of the projects of a cv profile
+func (server *Server) reorderProjects(ctx *gin.Context) {
+	var uriRequest cvProfileIDRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+	setProfileID(ctx, uriRequest.ID)
+
+	var request reorderProjectsRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	params := db.ReorderProjectsTxParams{
+		CvProfileID: uriRequest.ID,
+		ProjectIDs:  request.ProjectIDs,
+	}
+
+	projects, err := server.store.ReorderProjectsTx(ctx, params)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, projects)
+}
@@ -0,0 +1,741 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/internal/token"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateProjectAPI(t *testing.T) {
+	username := utils.RandomString(6)
+	project := generateRandomProject()
+	projectRow := generateRandomProjectRows()[0]
+	skillIDs := []int32{1, 2}
+	technologyIDs := []int32{3}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"title":             project.Title,
+				"short_description": project.ShortDescription,
+				"description":       project.Description,
+				"image":             project.Image,
+				"hex_theme_color":   project.HexThemeColor,
+				"project_url":       project.ProjectUrl,
+				"significance":      project.Significance,
+				"cv_profile_id":     project.CvProfileID,
+				"skill_ids":         skillIDs,
+				"technology_ids":    technologyIDs,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.CreateProjectTxParams{
+					CreateProjectParams: db.CreateProjectParams{
+						Title:            project.Title,
+						ShortDescription: project.ShortDescription,
+						Description:      project.Description,
+						Image:            project.Image,
+						HexThemeColor:    project.HexThemeColor,
+						ProjectUrl:       project.ProjectUrl,
+						Significance:     project.Significance,
+						CvProfileID:      project.CvProfileID,
+					},
+					SkillIDs:      skillIDs,
+					TechnologyIDs: technologyIDs,
+				}
+				store.EXPECT().
+					CreateProjectTx(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(projectRow, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchProject(t, recorder.Body, projectRow)
+			},
+		},
+		{
+			name: "Expired Token",
+			body: gin.H{
+				"title":             project.Title,
+				"short_description": project.ShortDescription,
+				"description":       project.Description,
+				"image":             project.Image,
+				"hex_theme_color":   project.HexThemeColor,
+				"project_url":       project.ProjectUrl,
+				"cv_profile_id":     project.CvProfileID,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, -time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProjectTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "No Authorization",
+			body: gin.H{
+				"title":             project.Title,
+				"short_description": project.ShortDescription,
+				"description":       project.Description,
+				"image":             project.Image,
+				"hex_theme_color":   project.HexThemeColor,
+				"project_url":       project.ProjectUrl,
+				"cv_profile_id":     project.CvProfileID,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProjectTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Body",
+			body: gin.H{
+				"title":         project.Title,
+				"cv_profile_id": project.CvProfileID,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProjectTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Duplicated Skill IDs",
+			body: gin.H{
+				"title":             project.Title,
+				"short_description": project.ShortDescription,
+				"description":       project.Description,
+				"image":             project.Image,
+				"hex_theme_color":   project.HexThemeColor,
+				"project_url":       project.ProjectUrl,
+				"cv_profile_id":     project.CvProfileID,
+				"skill_ids":         []int32{1, 1},
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProjectTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Foreign Key Violation",
+			body: gin.H{
+				"title":             project.Title,
+				"short_description": project.ShortDescription,
+				"description":       project.Description,
+				"image":             project.Image,
+				"hex_theme_color":   project.HexThemeColor,
+				"project_url":       project.ProjectUrl,
+				"cv_profile_id":     project.CvProfileID,
+				"skill_ids":         skillIDs,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProjectTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ListProjectsWithTechnologiesRow{}, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: gin.H{
+				"title":             project.Title,
+				"short_description": project.ShortDescription,
+				"description":       project.Description,
+				"image":             project.Image,
+				"hex_theme_color":   project.HexThemeColor,
+				"project_url":       project.ProjectUrl,
+				"cv_profile_id":     project.CvProfileID,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateProjectTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ListProjectsWithTechnologiesRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/projects", baseUrl)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestUpdateProjectAPI(t *testing.T) {
+	username := utils.RandomString(6)
+	project := generateRandomProject()
+	projectRow := generateRandomProjectRows()[0]
+
+	body := gin.H{
+		"title":             project.Title,
+		"short_description": project.ShortDescription,
+		"description":       project.Description,
+		"image":             project.Image,
+		"hex_theme_color":   project.HexThemeColor,
+		"project_url":       project.ProjectUrl,
+		"significance":      project.Significance,
+	}
+
+	testCases := []struct {
+		name          string
+		id            int32
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   project.ID,
+			body: body,
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.UpdateProjectTxParams{
+					UpdateProjectParams: db.UpdateProjectParams{
+						ID:               project.ID,
+						Title:            project.Title,
+						ShortDescription: project.ShortDescription,
+						Description:      project.Description,
+						Image:            project.Image,
+						HexThemeColor:    project.HexThemeColor,
+						ProjectUrl:       project.ProjectUrl,
+						Significance:     project.Significance,
+					},
+					SkillIDs:      []int32{},
+					TechnologyIDs: []int32{},
+				}
+				store.EXPECT().
+					UpdateProjectTx(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(projectRow, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchProject(t, recorder.Body, projectRow)
+			},
+		},
+		{
+			name: "Invalid ID",
+			id:   0,
+			body: body,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateProjectTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Body",
+			id:   project.ID,
+			body: gin.H{
+				"title": project.Title,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateProjectTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   project.ID,
+			body: body,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateProjectTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ListProjectsWithTechnologiesRow{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			id:   project.ID,
+			body: body,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateProjectTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ListProjectsWithTechnologiesRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/projects/%d", baseUrl, tc.id)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestPatchProjectAPI(t *testing.T) {
+	username := utils.RandomString(6)
+	project := generateRandomProject()
+	projectRow := generateRandomProjectRows()[0]
+	newTitle := utils.RandomString(8)
+
+	testCases := []struct {
+		name          string
+		id            int32
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   project.ID,
+			body: gin.H{
+				"title":     newTitle,
+				"skill_ids": []int32{},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.PatchProjectTxParams{
+					PatchProjectParams: db.PatchProjectParams{
+						ID:    project.ID,
+						Title: sql.NullString{String: newTitle, Valid: true},
+					},
+					SkillIDs: []int32{},
+				}
+				store.EXPECT().
+					PatchProjectTx(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(projectRow, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchProject(t, recorder.Body, projectRow)
+			},
+		},
+		{
+			name: "Invalid Body",
+			id:   project.ID,
+			body: gin.H{
+				"title": "",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchProjectTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   project.ID,
+			body: gin.H{
+				"title": newTitle,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchProjectTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ListProjectsWithTechnologiesRow{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			id:   project.ID,
+			body: gin.H{
+				"title": newTitle,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PatchProjectTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ListProjectsWithTechnologiesRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/projects/%d", baseUrl, tc.id)
+			req, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestDeleteProjectAPI(t *testing.T) {
+	username := utils.RandomString(6)
+	project := generateRandomProject()
+
+	testCases := []struct {
+		name          string
+		id            int32
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   project.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteProjectTx(gomock.Any(), gomock.Eq(project.ID)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid ID",
+			id:   0,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteProjectTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   project.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteProjectTx(gomock.Any(), gomock.Eq(project.ID)).
+					Times(1).
+					Return(sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			id:   project.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteProjectTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/admin/projects/%d", baseUrl, tc.id)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+// generateRandomProject generates and returns a random project
+func generateRandomProject() db.Project {
+	return db.Project{
+		ID:               utils.RandomInt(1, 1000),
+		Title:            utils.RandomString(6),
+		ShortDescription: utils.RandomString(5),
+		Description:      utils.RandomString(10),
+		Image:            utils.RandomString(6),
+		HexThemeColor:    utils.RandomHexColor(),
+		ProjectUrl:       utils.RandomString(6),
+		CvProfileID:      utils.RandomInt(1, 1000),
+		Significance:     utils.RandomInt(1, 50),
+	}
+}
+
+// requireBodyMatchProject asserts that the response body matches the provided project
+func requireBodyMatchProject(t *testing.T, body *bytes.Buffer, project db.ListProjectsWithTechnologiesRow) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotProject db.ListProjectsWithTechnologiesRow
+	err = json.Unmarshal(data, &gotProject)
+	require.NoError(t, err)
+
+	require.Equal(t, project, gotProject)
+}
+
+func TestReorderProjectsAPI(t *testing.T) {
+	username := utils.RandomString(6)
+	cvProfileID := utils.RandomInt(1, 1000)
+	projects := generateRandomProjectRows()[1:4]
+	projectIDs := []int32{projects[2].ID, projects[0].ID, projects[1].ID}
+	body := gin.H{
+		"project_ids": projectIDs,
+	}
+
+	testCases := []struct {
+		name          string
+		id            int32
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   cvProfileID,
+			body: body,
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ReorderProjectsTxParams{
+					CvProfileID: cvProfileID,
+					ProjectIDs:  projectIDs,
+				}
+				store.EXPECT().
+					ReorderProjectsTx(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(projects, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchProjects(t, recorder.Body, projects)
+			},
+		},
+		{
+			name: "Invalid ID",
+			id:   0,
+			body: body,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderProjectsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Empty Order",
+			id:   cvProfileID,
+			body: gin.H{
+				"project_ids": []int32{},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderProjectsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Order Mismatch",
+			id:   cvProfileID,
+			body: body,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderProjectsTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, db.ErrOrderMismatch)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				require.Equal(t, CodeOrderMismatch, requireBodyErrorResponse(t, recorder.Body).Code)
+			},
+		},
+		{
+			name: "Profile Not Found",
+			id:   cvProfileID,
+			body: body,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderProjectsTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Equal(t, CodeProfileNotFound, requireBodyErrorResponse(t, recorder.Body).Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			id:   cvProfileID,
+			body: body,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderProjectsTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/cv-profiles/%d/projects/order", baseUrl, tc.id)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
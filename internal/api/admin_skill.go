@@ -0,0 +1,177 @@
+package api
+
+import (
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type createSkillRequest struct {
+	Name          string `json:"name" binding:"required,max=255"`
+	Description   string `json:"description" binding:"required"`
+	Category      string `json:"category" binding:"required"`
+	Importance    int32  `json:"importance" binding:"required,min=1"`
+	Image         string `json:"image" binding:"required"`
+	HexThemeColor string `json:"hex_theme_color" binding:"required,hexcolor"`
+	CvProfileID   int32  `json:"cv_profile_id" binding:"required,min=1"`
+}
+
+// @Schemes
+// @Summary Create skill
+// @Description Create a skill of a cv profile. Names, and importances within a category, are unique per cv profile.
+// @Tags admin
+// @Security BearerAuth
+// @Param request body createSkillRequest true "Skill details"
+// @Accept json
+// @Produce json
+// @Success 201 {object} db.Skill
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Missing, invalid or expired access token"
+// @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 409 {object} ErrorResponse "The cv profile already has a skill with this name (SKILL_NAME_TAKEN) or with this importance in the category (SKILL_IMPORTANCE_TAKEN)"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /admin/skills [post]
+// createSkill handles creating a skill
+func (server *Server) createSkill(ctx *gin.Context) {
+	var request createSkillRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+	setProfileID(ctx, request.CvProfileID)
+
+	params := db.CreateSkillParams{
+		Name:          request.Name,
+		Slug:          utils.Slugify(request.Name),
+		Description:   request.Description,
+		Category:      request.Category,
+		Importance:    request.Importance,
+		Image:         request.Image,
+		HexThemeColor: request.HexThemeColor,
+		CvProfileID:   request.CvProfileID,
+	}
+
+	skill, err := server.store.CreateSkill(ctx, params)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, skill)
+}
+
+type reorderSkillsRequest struct {
+	Category string  `json:"category" binding:"required"`
+	SkillIDs []int32 `json:"skill_ids" binding:"required,min=1,unique,dive,min=1"`
+}
+
+// @Schemes
+// @Summary Reorder skills
+// @Description Give the skills of a category the importances of their positions in skill_ids, the first one gets importance 1.
+// @Description skill_ids has to list every skill of the category exactly once, the skills can swap their importances.
+// @Tags admin
+// @Security BearerAuth
+// @Param id path integer true "CV profile ID"
+// @Param request body reorderSkillsRequest true "Category and the new order of its skills"
+// @Accept json
+// @Produce json
+// @Success 200 {object} []db.Skill
+// @Failure 400 {object} ErrorResponse "Invalid ID or request body"
+// @Failure 401 {object} ErrorResponse "Missing, invalid or expired access token"
+// @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 409 {object} ErrorResponse "skill_ids does not list every skill of the category exactly once (ORDER_MISMATCH)"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /admin/cv-profiles/{id}/skills/order [put]
+// reorderSkills handles changing the order of the skills of a category
+func (server *Server) reorderSkills(ctx *gin.Context) {
+	var uriRequest cvProfileIDRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+	setProfileID(ctx, uriRequest.ID)
+
+	var request reorderSkillsRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	params := db.ReorderSkillsTxParams{
+		CvProfileID: uriRequest.ID,
+		Category:    request.Category,
+		SkillIDs:    request.SkillIDs,
+	}
+
+	skills, err := server.store.ReorderSkillsTx(ctx, params)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, skills)
+}
+
+type upsertSkillCategoryRequest struct {
+	Name          string `json:"name" binding:"required,max=255"`
+	Description   string `json:"description"`
+	HexThemeColor string `json:"hex_theme_color" binding:"omitempty,hexcolor"`
+	DisplayOrder  int32  `json:"display_order" binding:"min=0"`
+}
+
+// @Schemes
+// @Summary Set skill category
+// @Description Create the skill category with the name, or replace its description, theme color and display order.
+// @Description The name is the category of the skills, categories are listed by display order and then by name.
+// @Tags admin
+// @Security BearerAuth
+// @Param id path integer true "CV profile ID"
+// @Param request body upsertSkillCategoryRequest true "Skill category details"
+// @Accept json
+// @Produce json
+// @Success 200 {object} db.SkillCategory
+// @Failure 400 {object} ErrorResponse "Invalid ID or request body"
+// @Failure 401 {object} ErrorResponse "Missing, invalid or expired access token"
+// @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /admin/cv-profiles/{id}/skill-categories [put]
+// upsertSkillCategory handles creating or replacing a skill category
+func (server *Server) upsertSkillCategory(ctx *gin.Context) {
+	var uriRequest cvProfileIDRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+	setProfileID(ctx, uriRequest.ID)
+
+	var request upsertSkillCategoryRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	params := db.UpsertSkillCategoryParams{
+		CvProfileID:   uriRequest.ID,
+		Name:          request.Name,
+		Description:   request.Description,
+		HexThemeColor: request.HexThemeColor,
+		DisplayOrder:  request.DisplayOrder,
+	}
+
+	// the profile is checked first, the foreign key violation of a missing profile would be a REFERENCE_NOT_FOUND error
+	if !server.cvProfileExists(ctx, uriRequest.ID) {
+		return
+	}
+
+	category, err := server.store.UpsertSkillCategory(ctx, params)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, category)
+}
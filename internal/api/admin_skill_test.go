@@ -0,0 +1,612 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/internal/token"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateSkillAPI(t *testing.T) {
+	username := utils.RandomString(6)
+	skill := generateRandomSkills()[1]
+	skill.Slug = utils.Slugify(skill.Name)
+	body := gin.H{
+		"name":            skill.Name,
+		"description":     skill.Description,
+		"category":        skill.Category,
+		"importance":      skill.Importance,
+		"image":           skill.Image,
+		"hex_theme_color": skill.HexThemeColor,
+		"cv_profile_id":   skill.CvProfileID,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.CreateSkillParams{
+					Name:          skill.Name,
+					Slug:          utils.Slugify(skill.Name),
+					Description:   skill.Description,
+					Category:      skill.Category,
+					Importance:    skill.Importance,
+					Image:         skill.Image,
+					HexThemeColor: skill.HexThemeColor,
+					CvProfileID:   skill.CvProfileID,
+				}
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(skill, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+				var gotSkill db.Skill
+				require.NoError(t, json.Unmarshal(data, &gotSkill))
+				require.Equal(t, skill, gotSkill)
+			},
+		},
+		{
+			name:      "No Authorization",
+			body:      body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Body",
+			body: gin.H{
+				"name":          skill.Name,
+				"importance":    0,
+				"cv_profile_id": skill.CvProfileID,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Hex Theme Color",
+			body: gin.H{
+				"name":            skill.Name,
+				"description":     skill.Description,
+				"category":        skill.Category,
+				"importance":      skill.Importance,
+				"image":           skill.Image,
+				"hex_theme_color": "00ADD8",
+				"cv_profile_id":   skill.CvProfileID,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Name Taken",
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Skill{}, &pq.Error{Code: "23505", Constraint: "unique_profile_skill_name"})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				require.Equal(t, CodeSkillNameTaken, requireBodyErrorResponse(t, recorder.Body).Code)
+			},
+		},
+		{
+			name: "Slug Taken",
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Skill{}, &pq.Error{Code: "23505", Constraint: "unique_profile_skill_slug"})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				require.Equal(t, CodeSkillNameTaken, requireBodyErrorResponse(t, recorder.Body).Code)
+			},
+		},
+		{
+			name: "Importance Taken",
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Skill{}, &pq.Error{Code: "23505", Constraint: "unique_profile_skill_category_importance"})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				require.Equal(t, CodeSkillImportanceTaken, requireBodyErrorResponse(t, recorder.Body).Code)
+			},
+		},
+		{
+			name: "Foreign Key Violation",
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Skill{}, &pq.Error{Code: "23503"})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSkill(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Skill{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/skills", baseUrl)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestReorderSkillsAPI(t *testing.T) {
+	username := utils.RandomString(6)
+	skills := generateRandomSkills()[1:4]
+	cvProfileID := skills[0].CvProfileID
+	category := skills[0].Category
+	skillIDs := []int32{skills[2].ID, skills[0].ID, skills[1].ID}
+	body := gin.H{
+		"category":  category,
+		"skill_ids": skillIDs,
+	}
+
+	testCases := []struct {
+		name          string
+		id            int32
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   cvProfileID,
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ReorderSkillsTxParams{
+					CvProfileID: cvProfileID,
+					Category:    category,
+					SkillIDs:    skillIDs,
+				}
+				store.EXPECT().
+					ReorderSkillsTx(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(skills, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSkills(t, recorder.Body, skills)
+			},
+		},
+		{
+			name:      "No Authorization",
+			id:        cvProfileID,
+			body:      body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderSkillsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid ID",
+			id:   0,
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderSkillsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Duplicate Skill IDs",
+			id:   cvProfileID,
+			body: gin.H{
+				"category":  category,
+				"skill_ids": []int32{skillIDs[0], skillIDs[0]},
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderSkillsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Missing Category",
+			id:   cvProfileID,
+			body: gin.H{
+				"skill_ids": skillIDs,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderSkillsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Order Mismatch",
+			id:   cvProfileID,
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderSkillsTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, db.ErrOrderMismatch)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+				require.Equal(t, CodeOrderMismatch, requireBodyErrorResponse(t, recorder.Body).Code)
+			},
+		},
+		{
+			name: "Profile Not Found",
+			id:   cvProfileID,
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderSkillsTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Equal(t, CodeProfileNotFound, requireBodyErrorResponse(t, recorder.Body).Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			id:   cvProfileID,
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReorderSkillsTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/cv-profiles/%d/skills/order", baseUrl, tc.id)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestUpsertSkillCategoryAPI(t *testing.T) {
+	username := utils.RandomString(6)
+	category := db.SkillCategory{
+		ID:            1,
+		CvProfileID:   1,
+		Name:          utils.RandomString(8),
+		Description:   utils.RandomString(20),
+		HexThemeColor: utils.RandomHexColor(),
+		DisplayOrder:  2,
+	}
+	body := gin.H{
+		"name":            category.Name,
+		"description":     category.Description,
+		"hex_theme_color": category.HexThemeColor,
+		"display_order":   category.DisplayOrder,
+	}
+
+	testCases := []struct {
+		name          string
+		id            int32
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   category.CvProfileID,
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.UpsertSkillCategoryParams{
+					CvProfileID:   category.CvProfileID,
+					Name:          category.Name,
+					Description:   category.Description,
+					HexThemeColor: category.HexThemeColor,
+					DisplayOrder:  category.DisplayOrder,
+				}
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(category.CvProfileID)).
+					Times(1).
+					Return(true, nil)
+				store.EXPECT().
+					UpsertSkillCategory(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(category, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got db.SkillCategory
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, category, got)
+			},
+		},
+		{
+			name:      "No Authorization",
+			id:        category.CvProfileID,
+			body:      body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertSkillCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Theme Color",
+			id:   category.CvProfileID,
+			body: gin.H{
+				"name":            category.Name,
+				"hex_theme_color": "red",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertSkillCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Missing Name",
+			id:   category.CvProfileID,
+			body: gin.H{
+				"display_order": category.DisplayOrder,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertSkillCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Profile Not Found",
+			id:   category.CvProfileID,
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(category.CvProfileID)).
+					Times(1).
+					Return(false, nil)
+				store.EXPECT().
+					UpsertSkillCategory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Equal(t, CodeProfileNotFound, requireBodyErrorResponse(t, recorder.Body).Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			id:   category.CvProfileID,
+			body: body,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(category.CvProfileID)).
+					Times(1).
+					Return(true, nil)
+				store.EXPECT().
+					UpsertSkillCategory(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SkillCategory{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/cv-profiles/%d/skill-categories", baseUrl, tc.id)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/aalug/cv-backend-go/internal/cache"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cacheMiddleware serves the successful responses of the route from the cache, with a strong ETag
+// and the Cache-Control max-age, and answers If-None-Match with 304 Not Modified.
+// The :id parameter of the route has to be the ID of the cv profile that the response belongs to.
+func cacheMiddleware(c *cache.Cache, maxAge time.Duration) gin.HandlerFunc {
+	cacheControl := "no-cache"
+	if maxAge > 0 {
+		cacheControl = fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds()))
+	}
+
+	return func(ctx *gin.Context) {
+		profileID, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+		if err != nil {
+			// the handler responds with a validation error
+			ctx.Next()
+			return
+		}
+
+		key := ctx.Request.URL.RequestURI()
+		if entry, ok := c.Get(int32(profileID), key); ok {
+			ctx.Abort()
+			writeCachedResponse(ctx, entry, cacheControl)
+			return
+		}
+
+		// the generation is read before the handler reads the data, so a concurrent write is never cached
+		generation := c.Generation(int32(profileID))
+		writer := &bufferedWriter{ResponseWriter: ctx.Writer, status: http.StatusOK}
+		ctx.Writer = writer
+		ctx.Next()
+		ctx.Writer = writer.ResponseWriter
+
+		// errors are not cached, they are written as they are
+		if writer.status != http.StatusOK {
+			writer.flush()
+			return
+		}
+
+		entry := cache.Entry{
+			Body:        writer.body.Bytes(),
+			ContentType: writer.Header().Get("Content-Type"),
+			ETag:        cache.ETag(writer.body.Bytes()),
+		}
+		c.Set(int32(profileID), key, generation, entry)
+		writeCachedResponse(ctx, entry, cacheControl)
+	}
+}
+
+// writeCachedResponse writes the entry, or 304 Not Modified when the client already has it
+func writeCachedResponse(ctx *gin.Context, entry cache.Entry, cacheControl string) {
+	ctx.Header("ETag", entry.ETag)
+	ctx.Header("Cache-Control", cacheControl)
+
+	if etagMatches(ctx.GetHeader("If-None-Match"), entry.ETag) {
+		ctx.Status(http.StatusNotModified)
+		ctx.Writer.WriteHeaderNow()
+		return
+	}
+
+	ctx.Data(http.StatusOK, entry.ContentType, entry.Body)
+}
+
+// etagMatches reports whether the If-None-Match header lists etag, using the weak comparison
+// that RFC 9110 requires for If-None-Match
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, value := range strings.Split(ifNoneMatch, ",") {
+		value = strings.TrimSpace(value)
+		if value == "*" || strings.TrimPrefix(value, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedWriter holds the response of the handler, so the ETag can be computed before the headers are sent
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return false
+}
+
+// flush writes the held response to the underlying writer
+func (w *bufferedWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.WriteHeaderNow()
+	_, _ = w.ResponseWriter.Write(w.body.Bytes())
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newCachingTestServer(t *testing.T, store db.Store) *Server {
+	cfg := testConfig()
+	cfg.CacheTTL = time.Minute
+	cfg.CacheMaxAge = 30 * time.Second
+	return newTestServerWithConfig(t, store, cfg)
+}
+
+// getWithETag sends a GET request with the If-None-Match header, when etag is not empty
+func getWithETag(t *testing.T, server *Server, url, etag string) *httptest.ResponseRecorder {
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if etag != "" {
+		request.Header.Set("If-None-Match", etag)
+	}
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestCacheMiddleware_ETag(t *testing.T) {
+	cvProfile := generateRandomCvProfile()
+	skills := generateRandomSkills()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	// every response below is served from the cache
+	store.EXPECT().
+		ListSkills(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(skills, nil)
+
+	server := newCachingTestServer(t, store)
+	url := fmt.Sprintf("%s/skills/%d", baseUrl, cvProfile.ID)
+
+	recorder := getWithETag(t, server, url, "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	requireBodyMatchSkills(t, recorder.Body, skills)
+	etag := recorder.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	require.Equal(t, "public, max-age=30", recorder.Header().Get("Cache-Control"))
+	require.Equal(t, "application/json; charset=utf-8", recorder.Header().Get("Content-Type"))
+
+	recorder = getWithETag(t, server, url, "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	requireBodyMatchSkills(t, recorder.Body, skills)
+	require.Equal(t, etag, recorder.Header().Get("ETag"))
+
+	recorder = getWithETag(t, server, url, etag)
+	require.Equal(t, http.StatusNotModified, recorder.Code)
+	require.Empty(t, recorder.Body.String())
+	require.Equal(t, etag, recorder.Header().Get("ETag"))
+	require.Equal(t, "public, max-age=30", recorder.Header().Get("Cache-Control"))
+
+	recorder = getWithETag(t, server, url, `"other", W/`+etag)
+	require.Equal(t, http.StatusNotModified, recorder.Code)
+
+	recorder = getWithETag(t, server, url, `"other"`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	requireBodyMatchSkills(t, recorder.Body, skills)
+}
+
+func TestCacheMiddleware_InvalidatedByWrites(t *testing.T) {
+	cvProfile := generateRandomCvProfile()
+	skills := generateRandomSkills()
+	project := generateRandomProject()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListSkills(gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(skills, nil)
+	store.EXPECT().
+		DeleteProjectTx(gomock.Any(), gomock.Eq(project.ID)).
+		Times(1).
+		Return(nil)
+
+	server := newCachingTestServer(t, store)
+	url := fmt.Sprintf("%s/skills/%d", baseUrl, cvProfile.ID)
+
+	recorder := getWithETag(t, server, url, "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	etag := recorder.Header().Get("ETag")
+
+	deleteRequest, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/admin/projects/%d", baseUrl, project.ID), nil)
+	require.NoError(t, err)
+	addAuthorization(t, deleteRequest, server.tokenMaker, authorizationTypeBearer, "admin", time.Minute)
+	deleteRecorder := httptest.NewRecorder()
+	server.router.ServeHTTP(deleteRecorder, deleteRequest)
+	require.Equal(t, http.StatusNoContent, deleteRecorder.Code)
+
+	// the skills did not change, so the client's copy is still valid
+	recorder = getWithETag(t, server, url, etag)
+	require.Equal(t, http.StatusNotModified, recorder.Code)
+}
+
+func TestCacheMiddleware_ErrorsNotCached(t *testing.T) {
+	cvProfile := generateRandomCvProfile()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListSkills(gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(nil, sql.ErrConnDone)
+
+	server := newCachingTestServer(t, store)
+	url := fmt.Sprintf("%s/skills/%d", baseUrl, cvProfile.ID)
+
+	for i := 0; i < 2; i++ {
+		recorder := getWithETag(t, server, url, "")
+		require.Equal(t, http.StatusInternalServerError, recorder.Code)
+		require.Empty(t, recorder.Header().Get("ETag"))
+		require.Empty(t, recorder.Header().Get("Cache-Control"))
+		response := requireBodyErrorResponse(t, recorder.Body)
+		require.Equal(t, CodeInternal, response.Code)
+	}
+}
+
+func TestCacheMiddleware_Disabled(t *testing.T) {
+	cvProfile := generateRandomCvProfile()
+	skills := generateRandomSkills()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListSkills(gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(skills, nil)
+
+	// without a TTL every request reads the store, but the ETag still saves the body
+	server := newTestServer(t, store)
+	url := fmt.Sprintf("%s/skills/%d", baseUrl, cvProfile.ID)
+
+	recorder := getWithETag(t, server, url, "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "no-cache", recorder.Header().Get("Cache-Control"))
+
+	recorder = getWithETag(t, server, url, recorder.Header().Get("ETag"))
+	require.Equal(t, http.StatusNotModified, recorder.Code)
+}
+
+func TestEtagMatches(t *testing.T) {
+	const etag = `"abc"`
+
+	testCases := []struct {
+		name        string
+		ifNoneMatch string
+		matches     bool
+	}{
+		{name: "Empty", ifNoneMatch: "", matches: false},
+		{name: "Same", ifNoneMatch: `"abc"`, matches: true},
+		{name: "Weak", ifNoneMatch: `W/"abc"`, matches: true},
+		{name: "List", ifNoneMatch: `"x", "abc"`, matches: true},
+		{name: "Any", ifNoneMatch: "*", matches: true},
+		{name: "Different", ifNoneMatch: `"abcd"`, matches: false},
+		{name: "Unquoted", ifNoneMatch: "abc", matches: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.matches, etagMatches(tc.ifNoneMatch, etag))
+		})
+	}
+}
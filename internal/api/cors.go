@@ -0,0 +1,114 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/aalug/cv-backend-go/internal/config"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// corsExposedHeaders can be read by the scripts of the allowed origins
+var corsExposedHeaders = []string{"ETag", "Retry-After", requestIDHeaderKey}
+
+// newCORSMiddleware creates the CORS policy of a route group that accepts requests from origins. The methods,
+// headers and max age are shared by all groups. Without origins only same-origin requests are accepted.
+func newCORSMiddleware(cfg config.Config, origins []string, allowCredentials bool) (gin.HandlerFunc, error) {
+	corsConfig := cors.Config{
+		AllowMethods:     cfg.CORSAllowedMethods,
+		AllowHeaders:     cfg.CORSAllowedHeaders,
+		ExposeHeaders:    corsExposedHeaders,
+		AllowCredentials: allowCredentials,
+		MaxAge:           cfg.CORSMaxAge,
+	}
+
+	allowOrigin, allowAll, err := originMatcher(origins)
+	if err != nil {
+		return nil, err
+	}
+	if allowAll {
+		// browsers reject credentials with "Access-Control-Allow-Origin: *"
+		if allowCredentials {
+			return nil, errors.New("credentials cannot be allowed for all origins")
+		}
+		corsConfig.AllowAllOrigins = true
+	} else {
+		corsConfig.AllowOriginFunc = allowOrigin
+	}
+
+	return cors.New(corsConfig), nil
+}
+
+// originRule allows an origin, or all subdomains of its host
+type originRule struct {
+	scheme     string
+	host       string
+	subdomains bool
+}
+
+func (r originRule) matches(scheme, host string) bool {
+	if scheme != r.scheme {
+		return false
+	}
+	if r.subdomains {
+		return strings.HasSuffix(host, "."+r.host)
+	}
+	return host == r.host
+}
+
+// parseOriginRule parses origins like https://example.com, or https://*.example.com for all subdomains
+func parseOriginRule(origin string) (originRule, error) {
+	scheme, host, ok := strings.Cut(strings.ToLower(origin), "://")
+	if !ok || (scheme != "http" && scheme != "https") {
+		return originRule{}, fmt.Errorf("origin %q must start with http:// or https://", origin)
+	}
+
+	rule := originRule{scheme: scheme, host: host}
+	rule.host, rule.subdomains = strings.CutPrefix(host, "*.")
+
+	u, err := url.Parse(scheme + "://" + rule.host)
+	if err != nil || u.Host != rule.host || u.Hostname() == "" || strings.Contains(rule.host, "*") {
+		return originRule{}, fmt.Errorf("origin %q must be a scheme and a host, the only wildcard allowed is a *. subdomain", origin)
+	}
+	return rule, nil
+}
+
+// originMatcher returns a function that reports whether an origin is allowed by one of origins,
+// allowAll is true when origins contain "*"
+func originMatcher(origins []string) (allowOrigin func(origin string) bool, allowAll bool, err error) {
+	var rules []originRule
+	for _, origin := range origins {
+		switch origin = strings.TrimSpace(origin); origin {
+		case "":
+		case "*":
+			allowAll = true
+		default:
+			rule, err := parseOriginRule(origin)
+			if err != nil {
+				return nil, false, err
+			}
+			rules = append(rules, rule)
+		}
+	}
+
+	return func(origin string) bool {
+		scheme, host, ok := strings.Cut(strings.ToLower(origin), "://")
+		if !ok || strings.ContainsAny(host, "/@") {
+			return false
+		}
+		for _, rule := range rules {
+			if rule.matches(scheme, host) {
+				return true
+			}
+		}
+		return false
+	}, allowAll, nil
+}
+
+// noContent answers the OPTIONS requests that are not CORS preflight requests
+func noContent(ctx *gin.Context) {
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,235 @@
+package api
+
+import (
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	"github.com/aalug/cv-backend-go/internal/logger"
+	"github.com/aalug/cv-backend-go/internal/metrics"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestOriginMatcher(t *testing.T) {
+	testCases := []struct {
+		name     string
+		origins  []string
+		allowed  []string
+		rejected []string
+		allowAll bool
+		wantErr  bool
+	}{
+		{
+			name:     "Exact",
+			origins:  []string{"https://dashboard.example.com"},
+			allowed:  []string{"https://dashboard.example.com", "HTTPS://Dashboard.Example.com"},
+			rejected: []string{"http://dashboard.example.com", "https://example.com", "https://dashboard.example.com:8080", "https://dashboard.example.com.evil.com"},
+		},
+		{
+			name:     "Subdomains",
+			origins:  []string{" https://*.example.com "},
+			allowed:  []string{"https://www.example.com", "https://a.b.example.com"},
+			rejected: []string{"https://example.com", "https://evilexample.com", "https://www.example.com.evil.com", "https://user@www.example.com", "null"},
+		},
+		{
+			name:     "Port",
+			origins:  []string{"http://localhost:3000"},
+			allowed:  []string{"http://localhost:3000"},
+			rejected: []string{"http://localhost", "http://localhost:3001"},
+		},
+		{
+			name:     "All",
+			origins:  []string{"*", "https://example.com"},
+			allowed:  []string{"https://example.com"},
+			allowAll: true,
+		},
+		{
+			name:     "None",
+			origins:  []string{"", " "},
+			rejected: []string{"https://example.com"},
+		},
+		{name: "NoScheme", origins: []string{"example.com"}, wantErr: true},
+		{name: "InvalidScheme", origins: []string{"ftp://example.com"}, wantErr: true},
+		{name: "Path", origins: []string{"https://example.com/admin"}, wantErr: true},
+		{name: "WildcardInside", origins: []string{"https://dashboard.*.example.com"}, wantErr: true},
+		{name: "WildcardOnly", origins: []string{"https://*"}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allowOrigin, allowAll, err := originMatcher(tc.origins)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.allowAll, allowAll)
+			for _, origin := range tc.allowed {
+				require.True(t, allowOrigin(origin), origin)
+			}
+			for _, origin := range tc.rejected {
+				require.False(t, allowOrigin(origin), origin)
+			}
+		})
+	}
+}
+
+// newCORSTestServer creates a server whose public endpoints are open to all origins and whose
+// admin endpoints accept only the dashboard with credentials
+func newCORSTestServer(t *testing.T) *Server {
+	ctrl := gomock.NewController(t)
+	cfg := testConfig()
+	cfg.CORSAllowedOrigins = []string{"*"}
+	cfg.CORSAdminOrigins = []string{"https://dashboard.example.com", "https://*.preview.example.com"}
+	cfg.CORSAllowedMethods = []string{"GET", "POST", "PATCH", "DELETE"}
+	cfg.CORSAllowedHeaders = []string{"Content-Type", "Authorization"}
+	cfg.CORSAllowCredentials = true
+	cfg.CORSMaxAge = time.Hour
+	return newTestServerWithConfig(t, mockdb.NewMockStore(ctrl), cfg)
+}
+
+func TestCORSPreflight(t *testing.T) {
+	testCases := []struct {
+		name          string
+		url           string
+		origin        string
+		requestMethod string
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:          "PublicAnyOrigin",
+			url:           baseUrl + "/cv-profiles/1",
+			origin:        "https://someone.example.org",
+			requestMethod: http.MethodGet,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+				require.Equal(t, "*", recorder.Header().Get("Access-Control-Allow-Origin"))
+				require.Equal(t, "GET,POST,PATCH,DELETE", recorder.Header().Get("Access-Control-Allow-Methods"))
+				require.Equal(t, "Content-Type,Authorization", recorder.Header().Get("Access-Control-Allow-Headers"))
+				require.Equal(t, "3600", recorder.Header().Get("Access-Control-Max-Age"))
+				require.Empty(t, recorder.Header().Get("Access-Control-Allow-Credentials"))
+			},
+		},
+		{
+			name:          "PublicSearch",
+			url:           baseUrl + "/search?q=go",
+			origin:        "https://someone.example.org",
+			requestMethod: http.MethodGet,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+				require.Equal(t, "*", recorder.Header().Get("Access-Control-Allow-Origin"))
+			},
+		},
+		{
+			name:          "AdminDashboard",
+			url:           baseUrl + "/admin/projects/1",
+			origin:        "https://dashboard.example.com",
+			requestMethod: http.MethodDelete,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				// preflight requests have no credentials, so they must not need authorization
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+				require.Equal(t, "https://dashboard.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+				require.Equal(t, "true", recorder.Header().Get("Access-Control-Allow-Credentials"))
+				require.Equal(t, "GET,POST,PATCH,DELETE", recorder.Header().Get("Access-Control-Allow-Methods"))
+				require.Equal(t, "3600", recorder.Header().Get("Access-Control-Max-Age"))
+			},
+		},
+		{
+			name:          "AdminPreviewSubdomain",
+			url:           baseUrl + "/admin/skills",
+			origin:        "https://pr-42.preview.example.com",
+			requestMethod: http.MethodPost,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+				require.Equal(t, "https://pr-42.preview.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+			},
+		},
+		{
+			name:          "LoginDashboard",
+			url:           baseUrl + "/auth/login",
+			origin:        "https://dashboard.example.com",
+			requestMethod: http.MethodPost,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+				require.Equal(t, "https://dashboard.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+			},
+		},
+		{
+			name:          "AdminOtherOrigin",
+			url:           baseUrl + "/admin/projects/1",
+			origin:        "https://someone.example.org",
+			requestMethod: http.MethodDelete,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				require.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+			},
+		},
+		{
+			name:          "LoginOtherOrigin",
+			url:           baseUrl + "/auth/login",
+			origin:        "https://preview.example.com",
+			requestMethod: http.MethodPost,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newCORSTestServer(t)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodOptions, tc.url, nil)
+			require.NoError(t, err)
+			request.Header.Set("Origin", tc.origin)
+			request.Header.Set("Access-Control-Request-Method", tc.requestMethod)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCORSRequest(t *testing.T) {
+	server := newCORSTestServer(t)
+
+	// invalid requests do not need the store, the CORS headers are set before the handler runs
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, baseUrl+"/skills/0", nil)
+	require.NoError(t, err)
+	request.Header.Set("Origin", "https://someone.example.org")
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Equal(t, "*", recorder.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "Etag,Retry-After,X-Request-Id", recorder.Header().Get("Access-Control-Expose-Headers"))
+
+	// requests without an Origin header are not CORS requests and are not restricted
+	recorder = httptest.NewRecorder()
+	request, err = http.NewRequest(http.MethodDelete, baseUrl+"/admin/projects/0", nil)
+	require.NoError(t, err)
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, "user", time.Minute)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestNewServer_InvalidCORSConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	cfg := testConfig()
+	cfg.CORSAllowedOrigins = []string{"example.com"}
+	_, err := NewServer(cfg, store, metrics.New(), logger.Discard())
+	require.ErrorContains(t, err, "CORS_ALLOWED_ORIGINS")
+
+	cfg = testConfig()
+	cfg.CORSAdminOrigins = []string{"*"}
+	cfg.CORSAllowCredentials = true
+	_, err = NewServer(cfg, store, metrics.New(), logger.Discard())
+	require.ErrorContains(t, err, "CORS_ADMIN_ORIGINS")
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type listCvExperiencesRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"` // profile cv id
+}
+
+type listCvExperiencesQueryRequest struct {
+	Page     int32 `form:"page" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=15"`
+}
+
+// @Schemes
+// @Summary List work experience for a profile cv
+// @Description List work experience, newest first, for a profile cv with provided ID
+// @Tags cv-profiles
+// @Param id path integer true "CV profile ID"
+// @Param page query integer true "Page number"
+// @Param page_size query integer true "Page size"
+// @Produce json
+// @Success 200 {object} []db.ListCvExperiencesWithDetailsRow
+// @Failure 400 {object} ErrorResponse "Invalid ID, page or page size"
+// @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /cv-profiles/{id}/experience [get]
+// listCvExperiences returns a list of work experiences for a profile cv
+func (server *Server) listCvExperiences(ctx *gin.Context) {
+	// get and validate the cv profile id
+	var request listCvExperiencesRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+	setProfileID(ctx, request.ID)
+
+	// get and validate the query params - page and page size
+	var queryRequest listCvExperiencesQueryRequest
+	if err := ctx.ShouldBindQuery(&queryRequest); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	params := db.ListCvExperiencesWithDetailsParams{
+		CvProfileID: request.ID,
+		Limit:       queryRequest.PageSize,
+		Offset:      (queryRequest.Page - 1) * queryRequest.PageSize,
+	}
+
+	experiences, err := server.store.ListCvExperiencesWithDetails(ctx, params)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
+
+	if len(experiences) == 0 && !server.cvProfileExists(ctx, request.ID) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, experiences)
+}
@@ -0,0 +1,270 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListCvExperiencesAPI(t *testing.T) {
+	cvProfile := generateRandomCvProfile()
+	experiences := generateRandomCvExperienceRows()
+
+	type Query struct {
+		page     int32
+		pageSize int32
+	}
+
+	testCases := []struct {
+		name          string
+		id            int32
+		query         Query
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   cvProfile.ID,
+			query: Query{
+				page:     2,
+				pageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListCvExperiencesWithDetailsParams{
+					CvProfileID: cvProfile.ID,
+					Limit:       5,
+					Offset:      5,
+				}
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(experiences, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCvExperiences(t, recorder.Body, experiences)
+			},
+		},
+		{
+			name: "Invalid ID",
+			id:   0,
+			query: Query{
+				page:     1,
+				pageSize: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Page",
+			id:   cvProfile.ID,
+			query: Query{
+				page:     0,
+				pageSize: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Page Size",
+			id:   cvProfile.ID,
+			query: Query{
+				page:     1,
+				pageSize: 30,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   cvProfile.ID,
+			query: Query{
+				page:     1,
+				pageSize: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListCvExperiencesWithDetailsRow{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(false, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, CodeProfileNotFound, response.Code)
+			},
+		},
+		{
+			name: "Empty List",
+			id:   cvProfile.ID,
+			query: Query{
+				page:     1,
+				pageSize: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListCvExperiencesWithDetailsRow{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(true, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, "[]", recorder.Body.String())
+			},
+		},
+		{
+			name: "Internal Server Error",
+			id:   cvProfile.ID,
+			query: Query{
+				page:     1,
+				pageSize: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListCvExperiencesWithDetailsRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/cv-profiles/%d/experience", baseUrl, tc.id)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			// Add query params
+			q := req.URL.Query()
+			q.Add("page", fmt.Sprintf("%d", tc.query.page))
+			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
+			req.URL.RawQuery = q.Encode()
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+// generateRandomCvExperienceRows generates and returns a slice of random work experience rows,
+// the first one being the current job
+func generateRandomCvExperienceRows() []db.ListCvExperiencesWithDetailsRow {
+	var experiences []db.ListCvExperiencesWithDetailsRow
+
+	for i := 0; i < 5; i++ {
+		startDate := time.Now().Add(-time.Hour * 24 * 365 * time.Duration(i+1))
+		var endDate *time.Time
+		if i > 0 {
+			end := startDate.Add(time.Hour * 24 * 300)
+			endDate = &end
+		}
+
+		experiences = append(experiences, db.ListCvExperiencesWithDetailsRow{
+			ID:             int32(i + 1),
+			Company:        utils.RandomString(6),
+			Position:       utils.RandomString(8),
+			Location:       utils.RandomString(5),
+			EmploymentType: "full-time",
+			StartDate:      startDate,
+			EndDate:        endDate,
+			Achievements:   []string{utils.RandomString(10), utils.RandomString(12)},
+			Skills: []db.ListSkillsForCvExperienceRow{
+				{ID: utils.RandomInt(1, 1000), Name: utils.RandomString(5)},
+			},
+			TechnologiesUsed: []db.ListTechnologiesForCvExperienceRow{
+				{ID: utils.RandomInt(1, 1000), Name: utils.RandomString(5), Url: utils.RandomString(8)},
+			},
+		})
+	}
+
+	return experiences
+}
+
+// requireBodyMatchCvExperiences asserts that the response body matches the provided work experiences
+func requireBodyMatchCvExperiences(t *testing.T, body *bytes.Buffer, experiences []db.ListCvExperiencesWithDetailsRow) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotExperiences []db.ListCvExperiencesWithDetailsRow
+	err = json.Unmarshal(data, &gotExperiences)
+	require.NoError(t, err)
+
+	requireCvExperiencesMatch(t, experiences, gotExperiences)
+}
+
+// requireCvExperiencesMatch asserts that both slices contain the same work experiences in the same order
+func requireCvExperiencesMatch(t *testing.T, experiences, gotExperiences []db.ListCvExperiencesWithDetailsRow) {
+	require.Len(t, gotExperiences, len(experiences))
+
+	for i := 0; i < len(experiences); i++ {
+		experience := experiences[i]
+		gotExperience := gotExperiences[i]
+
+		require.Equal(t, experience.ID, gotExperience.ID)
+		require.Equal(t, experience.Company, gotExperience.Company)
+		require.Equal(t, experience.Position, gotExperience.Position)
+		require.Equal(t, experience.Location, gotExperience.Location)
+		require.Equal(t, experience.EmploymentType, gotExperience.EmploymentType)
+		require.WithinDuration(t, experience.StartDate, gotExperience.StartDate, time.Second)
+		if experience.EndDate == nil {
+			require.Nil(t, gotExperience.EndDate)
+		} else {
+			require.NotNil(t, gotExperience.EndDate)
+			require.WithinDuration(t, *experience.EndDate, *gotExperience.EndDate, time.Second)
+		}
+		require.Equal(t, experience.Achievements, gotExperience.Achievements)
+		require.Equal(t, experience.Skills, gotExperience.Skills)
+		require.Equal(t, experience.TechnologiesUsed, gotExperience.TechnologiesUsed)
+	}
+}
@@ -1,28 +1,30 @@
 package api
 
 import (
-	"database/sql"
-	"errors"
 	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
 	"github.com/gin-gonic/gin"
 	"net/http"
 )
 
+// experiencePageSize is the number of work experience entries read at once for the cv profile details
+const experiencePageSize int32 = 50
+
 type getCvProfileRequest struct {
 	ID int32 `uri:"id" binding:"required,min=1"`
 }
 
 type getCvProfileResponse struct {
-	CvProfileID    int32            `json:"cv_profile_id"`
-	Name           string           `json:"name"`
-	Email          string           `json:"email"`
-	Phone          string           `json:"phone"`
-	Address        string           `json:"address"`
-	LinkedinUrl    string           `json:"linkedin_url"`
-	GithubUrl      string           `json:"github_url"`
-	Bio            string           `json:"bio"`
-	ProfilePicture string           `json:"profile_picture"`
-	Education      []db.CvEducation `json:"education"`
+	CvProfileID    int32                                `json:"cv_profile_id"`
+	Name           string                               `json:"name"`
+	Email          string                               `json:"email"`
+	Phone          string                               `json:"phone"`
+	Address        string                               `json:"address"`
+	LinkedinUrl    string                               `json:"linkedin_url"`
+	GithubUrl      string                               `json:"github_url"`
+	Bio            string                               `json:"bio"`
+	ProfilePicture string                               `json:"profile_picture"`
+	Education      []db.CvEducation                     `json:"education"`
+	Experience     []db.ListCvExperiencesWithDetailsRow `json:"experience"`
 }
 
 // @Schemes
@@ -34,25 +36,22 @@ type getCvProfileResponse struct {
 // @Success 200 {object} getCvProfileResponse
 // @Failure 400 {object} ErrorResponse "Invalid ID"
 // @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
 // @Failure 500 {object} ErrorResponse "Any other server-side error"
 // @Router /cv-profiles/{id} [get]
 // getCvProfile handles getting cv profile details
 func (server *Server) getCvProfile(ctx *gin.Context) {
 	var request getCvProfileRequest
 	if err := ctx.ShouldBindUri(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, validationError(err))
 		return
 	}
+	setProfileID(ctx, request.ID)
 
 	// get cv profile
 	cvProfile, err := server.store.GetCvProfile(ctx, request.ID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return
-		}
-
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, storeError(err, CodeProfileNotFound))
 		return
 	}
 
@@ -64,7 +63,21 @@ func (server *Server) getCvProfile(ctx *gin.Context) {
 	}
 	cvEducation, err := server.store.ListCvEducations(ctx, params)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
+
+	// get all cv work experience
+	cvExperience, err := listAll(experiencePageSize, func(limit, offset int32) ([]db.ListCvExperiencesWithDetailsRow, error) {
+		params := db.ListCvExperiencesWithDetailsParams{
+			CvProfileID: cvProfile.ID,
+			Limit:       limit,
+			Offset:      offset,
+		}
+		return server.store.ListCvExperiencesWithDetails(ctx, params)
+	})
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProfileNotFound))
 		return
 	}
 
@@ -79,6 +92,7 @@ func (server *Server) getCvProfile(ctx *gin.Context) {
 		Bio:            cvProfile.Bio,
 		ProfilePicture: cvProfile.ProfilePicture,
 		Education:      cvEducation,
+		Experience:     cvExperience,
 	}
 
 	if cvProfile.LinkedinUrl.Valid {
@@ -87,3 +101,36 @@ func (server *Server) getCvProfile(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, cvProfileResponse)
 }
+
+// listAll calls list with increasing offsets until it returns a page shorter than size and returns all the items
+func listAll[T any](size int32, list func(limit, offset int32) ([]T, error)) ([]T, error) {
+	items := []T{}
+	for offset := int32(0); ; offset += size {
+		page, err := list(size, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page...)
+		if int32(len(page)) < size {
+			return items, nil
+		}
+	}
+}
+
+// cvProfileExists writes a PROFILE_NOT_FOUND error and returns false when the cv profile does not exist.
+// List endpoints only call it for empty results, as a non-empty list already proves that the profile exists.
+func (server *Server) cvProfileExists(ctx *gin.Context, cvProfileID int32) bool {
+	exists, err := server.store.CvProfileExists(ctx, cvProfileID)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return false
+	}
+
+	if !exists {
+		writeError(ctx, newAPIError(http.StatusNotFound, CodeProfileNotFound, notFoundMessage(CodeProfileNotFound), nil))
+		return false
+	}
+
+	return true
+}
@@ -26,10 +26,15 @@ func TestGetCvProfileAPI(t *testing.T) {
 			Institution: utils.RandomString(5),
 			Degree:      utils.RandomString(5),
 			StartDate:   time.Now().Add(-time.Hour * 24 * 3 * 365),
-			EndDate:     time.Now(),
 			CvProfileID: cvProfile.ID,
 		},
 	}
+	experience := generateRandomCvExperienceRows()
+	var fullExperiencePage []db.ListCvExperiencesWithDetailsRow
+	for int32(len(fullExperiencePage)) < experiencePageSize {
+		fullExperiencePage = append(fullExperiencePage, generateRandomCvExperienceRows()...)
+	}
+	fullExperiencePage = fullExperiencePage[:experiencePageSize]
 
 	testCases := []struct {
 		name          string
@@ -54,10 +59,55 @@ func TestGetCvProfileAPI(t *testing.T) {
 					ListCvEducations(gomock.Any(), gomock.Eq(params)).
 					Times(1).
 					Return(education, nil)
+				experienceParams := db.ListCvExperiencesWithDetailsParams{
+					CvProfileID: cvProfile.ID,
+					Limit:       experiencePageSize,
+					Offset:      0,
+				}
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Eq(experienceParams)).
+					Times(1).
+					Return(experience, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				requireBodyMatchCvProfile(t, recorder.Body, cvProfile, education)
+				requireBodyMatchCvProfile(t, recorder.Body, cvProfile, education, experience)
+			},
+		},
+		{
+			name: "OK Experience On Many Pages",
+			id:   cvProfile.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(cvProfile, nil)
+				store.EXPECT().
+					ListCvEducations(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(education, nil)
+				firstParams := db.ListCvExperiencesWithDetailsParams{
+					CvProfileID: cvProfile.ID,
+					Limit:       experiencePageSize,
+					Offset:      0,
+				}
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Eq(firstParams)).
+					Times(1).
+					Return(fullExperiencePage, nil)
+				secondParams := db.ListCvExperiencesWithDetailsParams{
+					CvProfileID: cvProfile.ID,
+					Limit:       experiencePageSize,
+					Offset:      experiencePageSize,
+				}
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Eq(secondParams)).
+					Times(1).
+					Return(experience, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchCvProfile(t, recorder.Body, cvProfile, education, append(fullExperiencePage, experience...))
 			},
 		},
 		{
@@ -119,6 +169,30 @@ func TestGetCvProfileAPI(t *testing.T) {
 					ListCvEducations(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return([]db.CvEducation{}, sql.ErrConnDone)
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error ListCvExperiencesWithDetails",
+			id:   cvProfile.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(cvProfile, nil)
+				store.EXPECT().
+					ListCvEducations(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(education, nil)
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListCvExperiencesWithDetailsRow{}, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -135,7 +209,7 @@ func TestGetCvProfileAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
-			server := newTestServer(store)
+			server := newTestServer(t, store)
 			recorder := httptest.NewRecorder()
 
 			url := fmt.Sprintf("%s/cv-profiles/%d", baseUrl, tc.id)
@@ -169,7 +243,7 @@ func generateRandomCvProfile() db.CvProfile {
 }
 
 // requireBodyMatchCvProfile asserts that the response body matches the provided cv profile
-func requireBodyMatchCvProfile(t *testing.T, body *bytes.Buffer, cvProfile db.CvProfile, education []db.CvEducation) {
+func requireBodyMatchCvProfile(t *testing.T, body *bytes.Buffer, cvProfile db.CvProfile, education []db.CvEducation, experience []db.ListCvExperiencesWithDetailsRow) {
 	data, err := io.ReadAll(body)
 	require.NoError(t, err)
 
@@ -198,8 +272,9 @@ func requireBodyMatchCvProfile(t *testing.T, body *bytes.Buffer, cvProfile db.Cv
 		require.Equal(t, education[i].Institution, gotCvProfile.Education[i].Institution)
 		require.Equal(t, education[i].Degree, gotCvProfile.Education[i].Degree)
 		require.WithinDuration(t, education[i].StartDate, gotCvProfile.Education[i].StartDate, 1*time.Second)
-		require.WithinDuration(t, education[i].EndDate, gotCvProfile.Education[i].EndDate, 1*time.Second)
+		require.Equal(t, education[i].EndDate, gotCvProfile.Education[i].EndDate)
 		require.Equal(t, education[i].CvProfileID, gotCvProfile.Education[i].CvProfileID)
 	}
 
+	requireCvExperiencesMatch(t, experience, gotCvProfile.Experience)
 }
@@ -0,0 +1,257 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/lib/pq"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Error codes are stable and machine-readable, clients should rely on them instead of the messages
+const (
+	CodeValidationFailed     = "VALIDATION_FAILED"
+	CodeUnauthorized         = "UNAUTHORIZED"
+	CodeInvalidCredentials   = "INVALID_CREDENTIALS"
+	CodeNotFound             = "NOT_FOUND"
+	CodeProfileNotFound      = "PROFILE_NOT_FOUND"
+	CodeProjectNotFound      = "PROJECT_NOT_FOUND"
+	CodeSkillNotFound        = "SKILL_NOT_FOUND"
+	CodeReferenceNotFound    = "REFERENCE_NOT_FOUND"
+	CodeConflict             = "CONFLICT"
+	CodeSkillNameTaken       = "SKILL_NAME_TAKEN"
+	CodeSkillImportanceTaken = "SKILL_IMPORTANCE_TAKEN"
+	CodeOrderMismatch        = "ORDER_MISMATCH"
+	CodeRateLimited          = "RATE_LIMITED"
+	CodeInternal             = "INTERNAL_ERROR"
+)
+
+// problemJSONContentType is the media type of RFC 7807 problem details,
+// clients that send it in the Accept header get errors in that format
+const problemJSONContentType = "application/problem+json"
+
+// APIError is an error returned to the client. Only the code and the message are sent,
+// Err is the internal cause that is logged server-side.
+type APIError struct {
+	Status  int
+	Code    string
+	Message string
+	Fields  []FieldError
+	Err     error
+}
+
+func (e *APIError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Err)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// FieldError describes a request field that failed validation
+type FieldError struct {
+	Field string `json:"field"`
+	Rule  string `json:"rule"`
+}
+
+type ErrorResponse struct {
+	Code      string       `json:"code" example:"PROFILE_NOT_FOUND"`
+	Error     string       `json:"error" example:"cv profile not found"`
+	RequestID string       `json:"request_id,omitempty"`
+	Fields    []FieldError `json:"fields,omitempty"`
+}
+
+// ProblemDetails is the RFC 7807 representation of an error, with the code and fields as extensions
+type ProblemDetails struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail"`
+	Instance  string       `json:"instance"`
+	Code      string       `json:"code"`
+	RequestID string       `json:"request_id,omitempty"`
+	Fields    []FieldError `json:"fields,omitempty"`
+}
+
+// constraintErrors maps the names of db constraints to the errors returned when they are violated
+var constraintErrors = map[string]struct {
+	code    string
+	message string
+}{
+	"unique_profile_skill_name":                {CodeSkillNameTaken, "a skill with this name already exists in the cv profile"},
+	"unique_profile_skill_slug":                {CodeSkillNameTaken, "a skill with the same name, ignoring case, accents and punctuation, already exists in the cv profile"},
+	"unique_profile_skill_category_importance": {CodeSkillImportanceTaken, "a skill with this importance already exists in the category of the cv profile"},
+}
+
+// newAPIError creates an error for the client, err is the internal cause and may be nil
+func newAPIError(status int, code, message string, err error) *APIError {
+	return &APIError{Status: status, Code: code, Message: message, Err: err}
+}
+
+// validationError converts an error returned by binding the request to a VALIDATION_FAILED error
+func validationError(err error) *APIError {
+	apiErr := newAPIError(http.StatusBadRequest, CodeValidationFailed, "request validation failed", err)
+
+	var validationErrs validator.ValidationErrors
+	var typeErr *json.UnmarshalTypeError
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.As(err, &validationErrs):
+		for _, fieldErr := range validationErrs {
+			apiErr.Fields = append(apiErr.Fields, FieldError{Field: fieldErr.Field(), Rule: fieldRule(fieldErr)})
+		}
+	case errors.As(err, &typeErr):
+		apiErr.Fields = append(apiErr.Fields, FieldError{Field: typeErr.Field, Rule: typeErr.Type.String()})
+	case errors.As(err, &syntaxErr), errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		apiErr.Message = "request body is not valid JSON"
+	}
+
+	return apiErr
+}
+
+// fieldRule returns the validation rule with its parameter, e.g. "max=15"
+func fieldRule(fieldErr validator.FieldError) string {
+	if fieldErr.Param() == "" {
+		return fieldErr.Tag()
+	}
+	return fieldErr.Tag() + "=" + fieldErr.Param()
+}
+
+// storeError converts an error returned by the store, sql.ErrNoRows becomes a 404 with notFoundCode
+func storeError(err error, notFoundCode string) *APIError {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	if errors.Is(err, db.ErrOrderMismatch) {
+		return newAPIError(http.StatusConflict, CodeOrderMismatch, "the order has to list every skill of the category, or every project of the cv profile, exactly once", err)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return newAPIError(http.StatusNotFound, notFoundCode, notFoundMessage(notFoundCode), err)
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		if constraintErr, ok := constraintErrors[pqErr.Constraint]; ok {
+			return newAPIError(http.StatusConflict, constraintErr.code, constraintErr.message, err)
+		}
+
+		switch pqErr.Code.Name() {
+		case "foreign_key_violation":
+			return newAPIError(http.StatusNotFound, CodeReferenceNotFound, "a referenced resource does not exist", err)
+		case "check_violation":
+			return newAPIError(http.StatusBadRequest, CodeValidationFailed, "a value is not allowed, e.g. an end date before the start date", err)
+		case "unique_violation":
+			return newAPIError(http.StatusConflict, CodeConflict, "the resource already exists", err)
+		}
+	}
+
+	return internalError(err)
+}
+
+// internalError hides err from the client behind a generic 500 error
+func internalError(err error) *APIError {
+	return newAPIError(http.StatusInternalServerError, CodeInternal, "internal server error", err)
+}
+
+// notFoundMessage returns the message of a not found error code
+func notFoundMessage(code string) string {
+	switch code {
+	case CodeProfileNotFound:
+		return "cv profile not found"
+	case CodeProjectNotFound:
+		return "project not found"
+	case CodeSkillNotFound:
+		return "skill not found"
+	}
+	return "resource not found"
+}
+
+// writeError aborts the request with the error. The internal cause is attached to the context,
+// so that it is logged, and it is never sent to the client.
+func writeError(ctx *gin.Context, err error) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		apiErr = storeError(err, CodeNotFound)
+	}
+
+	if apiErr.Err != nil {
+		ctx.Error(apiErr.Err)
+	}
+
+	requestID := ctx.GetString(requestIDKey)
+	if wantsProblemJSON(ctx) {
+		ctx.Header("Content-Type", problemJSONContentType)
+		ctx.AbortWithStatusJSON(apiErr.Status, ProblemDetails{
+			Type:      "about:blank",
+			Title:     http.StatusText(apiErr.Status),
+			Status:    apiErr.Status,
+			Detail:    apiErr.Message,
+			Instance:  ctx.Request.URL.Path,
+			Code:      apiErr.Code,
+			RequestID: requestID,
+			Fields:    apiErr.Fields,
+		})
+		return
+	}
+
+	ctx.AbortWithStatusJSON(apiErr.Status, ErrorResponse{
+		Code:      apiErr.Code,
+		Error:     apiErr.Message,
+		RequestID: requestID,
+		Fields:    apiErr.Fields,
+	})
+}
+
+// wantsProblemJSON reports whether the client accepts RFC 7807 problem details
+func wantsProblemJSON(ctx *gin.Context) bool {
+	for _, accept := range ctx.Request.Header.Values("Accept") {
+		for _, mediaType := range strings.Split(accept, ",") {
+			mediaType, _, _ = strings.Cut(mediaType, ";")
+			if strings.EqualFold(strings.TrimSpace(mediaType), problemJSONContentType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var registerFieldNamesOnce sync.Once
+
+// registerFieldNames makes the validator report the json, form or uri names of the fields,
+// as they are named in the request, instead of the names of the Go struct fields
+func registerFieldNames() {
+	registerFieldNamesOnce.Do(func() {
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			for _, tag := range []string{"json", "form", "uri"} {
+				name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+				if name == "-" {
+					return ""
+				}
+				if name != "" {
+					return name
+				}
+			}
+			return field.Name
+		})
+	})
+}
@@ -0,0 +1,262 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStoreError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:           "No Rows",
+			err:            sql.ErrNoRows,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   CodeProfileNotFound,
+		},
+		{
+			name:           "Wrapped No Rows",
+			err:            fmt.Errorf("cannot get profile: %w", sql.ErrNoRows),
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   CodeProfileNotFound,
+		},
+		{
+			name:           "Unique Skill Name",
+			err:            &pq.Error{Code: "23505", Constraint: "unique_profile_skill_name"},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   CodeSkillNameTaken,
+		},
+		{
+			name:           "Unique Category Importance",
+			err:            &pq.Error{Code: "23505", Constraint: "unique_profile_skill_category_importance"},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   CodeSkillImportanceTaken,
+		},
+		{
+			name:           "Other Unique Violation",
+			err:            &pq.Error{Code: "23505", Constraint: "users_username_key"},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   CodeConflict,
+		},
+		{
+			name:           "Foreign Key Violation",
+			err:            &pq.Error{Code: "23503", Constraint: "projects_cv_profile_id_fkey"},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   CodeReferenceNotFound,
+		},
+		{
+			name:           "Same Profile Link Violation",
+			err:            &pq.Error{Code: "23503", Constraint: "project_skills_cv_profile_fkey"},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   CodeReferenceNotFound,
+		},
+		{
+			name:           "Check Violation",
+			err:            &pq.Error{Code: "23514", Constraint: "cv_educations_dates_check"},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   CodeValidationFailed,
+		},
+		{
+			name:           "Order Mismatch",
+			err:            fmt.Errorf("reorder: %w", db.ErrOrderMismatch),
+			expectedStatus: http.StatusConflict,
+			expectedCode:   CodeOrderMismatch,
+		},
+		{
+			name:           "API Error",
+			err:            newAPIError(http.StatusUnauthorized, CodeUnauthorized, "unauthorized", nil),
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   CodeUnauthorized,
+		},
+		{
+			name:           "Internal",
+			err:            sql.ErrConnDone,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   CodeInternal,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			apiErr := storeError(tc.err, CodeProfileNotFound)
+			require.Equal(t, tc.expectedStatus, apiErr.Status)
+			require.Equal(t, tc.expectedCode, apiErr.Code)
+			require.NotContains(t, apiErr.Message, tc.err.Error())
+
+			// the cause is kept for logging
+			var pqErr *pq.Error
+			if errors.As(tc.err, &pqErr) {
+				require.ErrorIs(t, apiErr, tc.err)
+			}
+		})
+	}
+}
+
+func TestErrorResponses(t *testing.T) {
+	cvProfile := generateRandomCvProfile()
+
+	testCases := []struct {
+		name          string
+		url           string
+		accept        string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Not Found",
+			url:  fmt.Sprintf("%s/cv-profiles/%d", baseUrl, cvProfile.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(db.CvProfile{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, CodeProfileNotFound, response.Code)
+				require.NotEmpty(t, response.RequestID)
+				require.NotContains(t, recorder.Body.String(), "sql:")
+			},
+		},
+		{
+			name: "Internal Error Hidden",
+			url:  fmt.Sprintf("%s/cv-profiles/%d", baseUrl, cvProfile.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CvProfile{}, &pq.Error{Code: "42P01", Message: `relation "cv_profiles" does not exist`})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, CodeInternal, response.Code)
+				require.Equal(t, "internal server error", response.Error)
+				require.NotContains(t, recorder.Body.String(), "relation")
+			},
+		},
+		{
+			name: "Validation Failed",
+			url:  fmt.Sprintf("%s/search?profile=0&q=go&page_size=30", baseUrl),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchCvProfile(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, CodeValidationFailed, response.Code)
+				require.ElementsMatch(t, []FieldError{
+					{Field: "profile", Rule: "required"},
+					{Field: "page_size", Rule: "max=15"},
+				}, response.Fields)
+			},
+		},
+		{
+			name:   "Problem JSON",
+			url:    fmt.Sprintf("%s/cv-profiles/%d", baseUrl, cvProfile.ID),
+			accept: "application/json;q=0.9, application/problem+json",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(db.CvProfile{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Equal(t, problemJSONContentType, recorder.Header().Get("Content-Type"))
+
+				var problem ProblemDetails
+				err := json.Unmarshal(recorder.Body.Bytes(), &problem)
+				require.NoError(t, err)
+				require.Equal(t, "about:blank", problem.Type)
+				require.Equal(t, http.StatusText(http.StatusNotFound), problem.Title)
+				require.Equal(t, http.StatusNotFound, problem.Status)
+				require.Equal(t, CodeProfileNotFound, problem.Code)
+				require.Equal(t, fmt.Sprintf("%s/cv-profiles/%d", baseUrl, cvProfile.ID), problem.Instance)
+				require.NotEmpty(t, problem.Detail)
+			},
+		},
+		{
+			name:       "Unknown Route",
+			url:        "/api/v2/cv-profiles",
+			buildStubs: func(store *mockdb.MockStore) {},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, CodeNotFound, response.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+			if tc.accept != "" {
+				req.Header.Set("Accept", tc.accept)
+			}
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestValidationErrorInvalidJSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mockdb.NewMockStore(ctrl))
+	recorder := httptest.NewRecorder()
+
+	req, err := http.NewRequest(http.MethodPost, baseUrl+"/auth/login", bytes.NewBufferString(`{"username": 1`))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	response := requireBodyErrorResponse(t, recorder.Body)
+	require.Equal(t, CodeValidationFailed, response.Code)
+	require.Equal(t, "request body is not valid JSON", response.Error)
+}
+
+// requireBodyErrorResponse decodes the error response
+func requireBodyErrorResponse(t *testing.T, body *bytes.Buffer) ErrorResponse {
+	var response ErrorResponse
+	err := json.Unmarshal(body.Bytes(), &response)
+	require.NoError(t, err)
+	require.NotEmpty(t, response.Code)
+	require.NotEmpty(t, response.Error)
+	return response
+}
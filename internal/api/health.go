@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"github.com/aalug/cv-backend-go/internal/db/migrations"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+// readinessTimeout limits how long the readiness checks can wait for the database
+const readinessTimeout = 2 * time.Second
+
+type healthResponse struct {
+	Status string `json:"status"`
+}
+
+type readinessResponse struct {
+	Status           string `json:"status"`
+	MigrationVersion int64  `json:"migration_version"`
+	Error            string `json:"error,omitempty"`
+}
+
+// healthz reports that the process is alive, it does not check any dependencies
+func (server *Server) healthz(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, healthResponse{Status: "ok"})
+}
+
+// readyz reports whether the server can handle requests - the database is reachable
+// and the schema is migrated to the version the code expects
+func (server *Server) readyz(ctx *gin.Context) {
+	checkCtx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+
+	if err := server.store.Ping(checkCtx); err != nil {
+		ctx.JSON(http.StatusServiceUnavailable, readinessResponse{
+			Status: "unavailable",
+			Error:  fmt.Sprintf("cannot reach the database: %v", err),
+		})
+		return
+	}
+
+	schemaVersion := int64(migrations.LatestVersion())
+	version, dirty, err := server.store.MigrationVersion(checkCtx)
+	if err != nil {
+		ctx.JSON(http.StatusServiceUnavailable, readinessResponse{
+			Status: "unavailable",
+			Error:  fmt.Sprintf("cannot read the migration version: %v", err),
+		})
+		return
+	}
+
+	response := readinessResponse{Status: "ok", MigrationVersion: version}
+	switch {
+	case dirty:
+		response.Status = "unavailable"
+		response.Error = fmt.Sprintf("migration %d failed, the schema is dirty", version)
+	case version < schemaVersion:
+		response.Status = "unavailable"
+		response.Error = fmt.Sprintf("the schema is at version %d, %d is required", version, schemaVersion)
+	}
+
+	if response.Error != "" {
+		ctx.JSON(http.StatusServiceUnavailable, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,253 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"github.com/aalug/cv-backend-go/internal/db/migrations"
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHealthzAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// liveness must not depend on the database
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().Ping(gomock.Any()).Times(0)
+
+	server := newTestServer(t, store)
+	recorder := httptest.NewRecorder()
+
+	req, err := http.NewRequest(http.MethodGet, "/healthz", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `{"status":"ok"}`, recorder.Body.String())
+}
+
+func TestReadyzAPI(t *testing.T) {
+	schemaVersion := int64(migrations.LatestVersion())
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					Ping(gomock.Any()).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					MigrationVersion(gomock.Any()).
+					Times(1).
+					Return(schemaVersion, false, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				response := requireBodyReadiness(t, recorder)
+				require.Equal(t, "ok", response.Status)
+				require.Equal(t, schemaVersion, response.MigrationVersion)
+				require.Empty(t, response.Error)
+			},
+		},
+		{
+			name: "Database Unreachable",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					Ping(gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+				store.EXPECT().
+					MigrationVersion(gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+				response := requireBodyReadiness(t, recorder)
+				require.Equal(t, "unavailable", response.Status)
+				require.NotEmpty(t, response.Error)
+			},
+		},
+		{
+			name: "No Migrations",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					Ping(gomock.Any()).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					MigrationVersion(gomock.Any()).
+					Times(1).
+					Return(int64(0), false, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+			},
+		},
+		{
+			name: "Dirty Schema",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					Ping(gomock.Any()).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					MigrationVersion(gomock.Any()).
+					Times(1).
+					Return(schemaVersion, true, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+				response := requireBodyReadiness(t, recorder)
+				require.Equal(t, schemaVersion, response.MigrationVersion)
+				require.Contains(t, response.Error, "dirty")
+			},
+		},
+		{
+			name: "Outdated Schema",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					Ping(gomock.Any()).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					MigrationVersion(gomock.Any()).
+					Times(1).
+					Return(schemaVersion-1, false, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+				response := requireBodyReadiness(t, recorder)
+				require.Equal(t, schemaVersion-1, response.MigrationVersion)
+				require.NotEmpty(t, response.Error)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/readyz", nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestServer_Start(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mockdb.NewMockStore(ctrl))
+	server.config.ShutdownTimeout = time.Second
+
+	address := freeAddress(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Start(ctx, address)
+	}()
+
+	require.Eventually(t, func() bool {
+		resp, err := http.Get("http://" + address + "/healthz")
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		return resp.StatusCode == http.StatusOK
+	}, 2*time.Second, 20*time.Millisecond)
+
+	// cancelling the context stops the server without an error
+	cancel()
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("server did not shut down")
+	}
+
+	_, err := http.Get("http://" + address + "/healthz")
+	require.Error(t, err)
+}
+
+// requireBodyReadiness decodes the readiness response
+func requireBodyReadiness(t *testing.T, recorder *httptest.ResponseRecorder) readinessResponse {
+	var response readinessResponse
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+	require.NoError(t, err)
+	return response
+}
+
+func TestServer_StartWithMetricsAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mockdb.NewMockStore(ctrl))
+	server.config.ShutdownTimeout = time.Second
+	server.config.MetricsAddress = freeAddress(t)
+	// the metrics route is only registered on the API router when there is no separate address
+	server.setupRouter()
+	address := freeAddress(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Start(ctx, address)
+	}()
+
+	require.Eventually(t, func() bool {
+		resp, err := http.Get("http://" + server.config.MetricsAddress + "/metrics")
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		return resp.StatusCode == http.StatusOK
+	}, 2*time.Second, 20*time.Millisecond)
+
+	resp, err := http.Get("http://" + address + "/metrics")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	cancel()
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("server did not shut down")
+	}
+}
+
+// freeAddress returns a local address with a port that is free at the moment
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return address
+}
@@ -1,19 +1,51 @@
 package api
 
 import (
+	"fmt"
 	"github.com/aalug/cv-backend-go/internal/config"
 	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/internal/logger"
+	"github.com/aalug/cv-backend-go/internal/metrics"
+	"github.com/aalug/cv-backend-go/internal/token"
+	"github.com/aalug/cv-backend-go/pkg/utils"
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 )
 
-func newTestServer(store db.Store) *Server {
-	server := NewServer(config.Config{}, store)
+func newTestServer(t *testing.T, store db.Store) *Server {
+	return newTestServerWithConfig(t, store, testConfig())
+}
+
+// testConfig returns the config of the test servers, the response cache is disabled
+func testConfig() config.Config {
+	return config.Config{
+		TokenType:           token.TypePaseto,
+		TokenSymmetricKey:   utils.RandomString(32),
+		AccessTokenDuration: time.Minute,
+	}
+}
+
+func newTestServerWithConfig(t *testing.T, store db.Store, cfg config.Config) *Server {
+	server, err := NewServer(cfg, store, metrics.New(), logger.Discard())
+	require.NoError(t, err)
 
 	return server
 }
 
+// addAuthorization creates an access token for the username and adds it to the request
+func addAuthorization(t *testing.T, request *http.Request, tokenMaker token.Maker, authorizationType string, username string, duration time.Duration) {
+	accessToken, payload, err := tokenMaker.CreateToken(username, duration)
+	require.NoError(t, err)
+	require.NotEmpty(t, payload)
+
+	authorizationHeader := fmt.Sprintf("%s %s", authorizationType, accessToken)
+	request.Header.Set(authorizationHeaderKey, authorizationHeader)
+}
+
 func TestMain(m *testing.M) {
 	gin.SetMode(gin.TestMode)
 
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/aalug/cv-backend-go/internal/metrics"
+	"github.com/aalug/cv-backend-go/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	authorizationHeaderKey  = "authorization"
+	authorizationTypeBearer = "bearer"
+	authorizationPayloadKey = "authorization_payload"
+
+	requestIDHeaderKey = "X-Request-ID"
+	requestIDKey       = "request_id"
+	profileIDKey       = "profile_id"
+)
+
+// authMiddleware only lets through requests that carry a valid access token
+func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
+		if len(authorizationHeader) == 0 {
+			err := errors.New("authorization header is not provided")
+			writeError(ctx, newAPIError(http.StatusUnauthorized, CodeUnauthorized, err.Error(), nil))
+			return
+		}
+
+		fields := strings.Fields(authorizationHeader)
+		if len(fields) != 2 {
+			err := errors.New("invalid authorization header format")
+			writeError(ctx, newAPIError(http.StatusUnauthorized, CodeUnauthorized, err.Error(), nil))
+			return
+		}
+
+		authorizationType := strings.ToLower(fields[0])
+		if authorizationType != authorizationTypeBearer {
+			err := fmt.Errorf("unsupported authorization type %s", authorizationType)
+			writeError(ctx, newAPIError(http.StatusUnauthorized, CodeUnauthorized, err.Error(), nil))
+			return
+		}
+
+		payload, err := tokenMaker.VerifyToken(fields[1])
+		if err != nil {
+			writeError(ctx, newAPIError(http.StatusUnauthorized, CodeUnauthorized, err.Error(), nil))
+			return
+		}
+
+		ctx.Set(authorizationPayloadKey, payload)
+		ctx.Next()
+	}
+}
+
+// unmatchedRoute is the route label of requests that did not match any route,
+// the raw path is not used to keep the number of label values bounded
+const unmatchedRoute = "unmatched"
+
+// metricsMiddleware records the count, latency and response size of every request,
+// labeled by the route template (e.g. /api/v1/projects/:id) and the status code
+func metricsMiddleware(m *metrics.Metrics) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		size := ctx.Writer.Size()
+		if size < 0 {
+			size = 0
+		}
+
+		m.ObserveRequest(ctx.Request.Method, route, ctx.Writer.Status(), time.Since(start), size)
+	}
+}
+
+// validRequestID limits the request IDs accepted from clients, so they are safe to log and echo back
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// requestIDMiddleware propagates the X-Request-ID header of the request, or generates a new ID,
+// and sets it on the response
+func requestIDMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(requestIDHeaderKey)
+		if !validRequestID.MatchString(requestID) {
+			requestID = uuid.New().String()
+		}
+
+		ctx.Set(requestIDKey, requestID)
+		ctx.Header(requestIDHeaderKey, requestID)
+		ctx.Next()
+	}
+}
+
+// loggerMiddleware writes an access log line for every request and a separate line for every error
+// attached to the context with ctx.Error. Both include the request ID, route, profile ID and latency.
+func loggerMiddleware(log *slog.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+		latency := time.Since(start)
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		attrs := []any{
+			slog.String(requestIDKey, ctx.GetString(requestIDKey)),
+			slog.String("method", ctx.Request.Method),
+			slog.String("route", route),
+			slog.Float64("latency_ms", float64(latency.Microseconds())/1000),
+		}
+		if profileID, ok := ctx.Get(profileIDKey); ok {
+			attrs = append(attrs, slog.Any(profileIDKey, profileID))
+		}
+
+		status := ctx.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+
+		for _, err := range ctx.Errors {
+			log.Log(ctx, level, "request error", append(attrs, slog.Int("status", status), slog.String("error", err.Error()))...)
+		}
+
+		log.Log(ctx, level, "request",
+			append(attrs,
+				slog.String("path", ctx.Request.URL.Path),
+				slog.Int("status", status),
+				slog.Int("size", max(ctx.Writer.Size(), 0)),
+				slog.String("client_ip", ctx.ClientIP()),
+				slog.String("user_agent", ctx.Request.UserAgent()),
+			)...,
+		)
+	}
+}
+
+// setProfileID adds the ID of the cv profile that the request is about to the request logs
+func setProfileID(ctx *gin.Context, profileID int32) {
+	ctx.Set(profileIDKey, profileID)
+}
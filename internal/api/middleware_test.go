@@ -0,0 +1,272 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	"github.com/aalug/cv-backend-go/internal/logger"
+	"github.com/aalug/cv-backend-go/internal/token"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "No Authorization",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Unsupported Authorization",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, "unsupported", "user", time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Authorization Format",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, "", "user", time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Expired Token",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "user", -time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, nil)
+
+			authPath := "/auth"
+			server.router.GET(
+				authPath,
+				authMiddleware(server.tokenMaker),
+				func(ctx *gin.Context) {
+					ctx.JSON(http.StatusOK, gin.H{})
+				},
+			)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, authPath, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestAuthMiddlewareInvalidToken(t *testing.T) {
+	server := newTestServer(t, nil)
+
+	authPath := "/auth"
+	server.router.GET(authPath, authMiddleware(server.tokenMaker), func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{})
+	})
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, authPath, nil)
+	require.NoError(t, err)
+
+	request.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, "invalid-token"))
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mockdb.NewMockStore(ctrl))
+
+	for _, path := range []string{"/healthz", "/healthz", baseUrl + "/skills/abc", "/unknown/123"} {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+		server.router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+	server.router.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	body := recorder.Body.String()
+	require.Contains(t, body, `cv_backend_http_requests_total{method="GET",route="/healthz",status="200"} 2`)
+	// the route template is used instead of the raw path
+	require.Contains(t, body, `cv_backend_http_requests_total{method="GET",route="/api/v1/skills/:id",status="400"} 1`)
+	require.Contains(t, body, `cv_backend_http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	require.Contains(t, body, `cv_backend_http_request_duration_seconds_count{method="GET",route="/healthz",status="200"} 2`)
+	require.Contains(t, body, `cv_backend_http_response_size_bytes_count{method="GET",route="/healthz",status="200"} 2`)
+	require.NotContains(t, body, "/skills/abc")
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	testCases := []struct {
+		name          string
+		requestID     string
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "Propagated",
+			requestID: "abc-123",
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, "abc-123", recorder.Header().Get(requestIDHeaderKey))
+			},
+		},
+		{
+			name:      "Generated",
+			requestID: "",
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Len(t, recorder.Header().Get(requestIDHeaderKey), 36)
+			},
+		},
+		{
+			name:      "Invalid Replaced",
+			requestID: "abc\"} injected",
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				requestID := recorder.Header().Get(requestIDHeaderKey)
+				require.Len(t, requestID, 36)
+				require.NotContains(t, requestID, "injected")
+			},
+		},
+		{
+			name:      "Too Long Replaced",
+			requestID: strings.Repeat("a", 129),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Len(t, recorder.Header().Get(requestIDHeaderKey), 36)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := newTestServer(t, mockdb.NewMockStore(ctrl))
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/healthz", nil)
+			require.NoError(t, err)
+			if tc.requestID != "" {
+				req.Header.Set(requestIDHeaderKey, tc.requestID)
+			}
+
+			server.router.ServeHTTP(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Code)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestLoggerMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListSkills(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(nil, sql.ErrConnDone)
+
+	var buf bytes.Buffer
+	log, err := logger.New(&buf, "info")
+	require.NoError(t, err)
+
+	server := newTestServer(t, store)
+	server.logger = log
+	server.setupRouter()
+
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/skills/%d", baseUrl, 7), nil)
+	require.NoError(t, err)
+	req.Header.Set(requestIDHeaderKey, "request-1")
+
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+	var lines []map[string]any
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.Len(t, lines, 2)
+
+	// the store error, then the access log line
+	require.Equal(t, "request error", lines[0]["msg"])
+	require.Equal(t, sql.ErrConnDone.Error(), lines[0]["error"])
+	require.Equal(t, "request", lines[1]["msg"])
+	require.Equal(t, "/api/v1/skills/7", lines[1]["path"])
+	require.EqualValues(t, http.StatusInternalServerError, lines[1]["status"])
+
+	for _, line := range lines {
+		require.Equal(t, "ERROR", line["level"])
+		require.Equal(t, "request-1", line[requestIDKey])
+		require.Equal(t, "/api/v1/skills/:id", line["route"])
+		require.EqualValues(t, 7, line[profileIDKey])
+		require.Contains(t, line, "latency_ms")
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mockdb.NewMockStore(ctrl))
+	server.router.GET("/panic", func(ctx *gin.Context) {
+		panic("boom")
+	})
+
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/panic", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+	require.NotEmpty(t, recorder.Header().Get(requestIDHeaderKey))
+}
@@ -1,8 +1,6 @@
 package api
 
 import (
-	"database/sql"
-	"errors"
 	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
 	"github.com/gin-gonic/gin"
 	"net/http"
@@ -28,6 +26,7 @@ type listProjectsQueryRequest struct {
 // @Success 200 {object} []db.ListProjectsWithTechnologiesRow
 // @Failure 400 {object} ErrorResponse "Invalid ID, page or page size"
 // @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
 // @Failure 500 {object} ErrorResponse "Any other server-side error"
 // @Router /projects/{id} [get]
 // listProjects returns a list of projects for a profile cv
@@ -35,14 +34,15 @@ func (server *Server) listProjects(ctx *gin.Context) {
 	// get and validate the cv profile id
 	var request listProjectsRequest
 	if err := ctx.ShouldBindUri(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, validationError(err))
 		return
 	}
+	setProfileID(ctx, request.ID)
 
 	// get and validate the query params - page and page size
 	var queryRequest listProjectsQueryRequest
 	if err := ctx.ShouldBindQuery(&queryRequest); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, validationError(err))
 		return
 	}
 
@@ -55,12 +55,11 @@ func (server *Server) listProjects(ctx *gin.Context) {
 
 	projects, err := server.store.ListProjectsWithTechnologies(ctx, params)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return
-		}
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
 
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	if len(projects) == 0 && !server.cvProfileExists(ctx, request.ID) {
 		return
 	}
 
@@ -68,8 +67,9 @@ func (server *Server) listProjects(ctx *gin.Context) {
 }
 
 type listProjectsBySkillNameRequest struct {
-	ID    int32  `uri:"id" binding:"required,min=1"`
-	Skill string `uri:"skill" binding:"required,alpha"`
+	ID int32 `uri:"id" binding:"required,min=1"`
+	// Skill is the slug or the percent-encoded name of the skill, e.g. "c-plus-plus" or "C%2B%2B"
+	Skill string `uri:"skill" binding:"required,max=255"`
 }
 
 type listProjectsBySkillNameQueryRequest struct {
@@ -82,13 +82,14 @@ type listProjectsBySkillNameQueryRequest struct {
 // @Description List projects for a profile cv with provided ID and skill
 // @Tags projects
 // @Param id path integer true "CV profile ID"
-// @Param skill path string true "Skill name"
+// @Param skill path string true "Skill slug, e.g. c-plus-plus, or percent-encoded skill name, matched ignoring case and accents"
 // @Param page query integer true "Page number"
 // @Param page_size query integer true "Page size"
 // @Produce json
 // @Success 200 {object} []db.ListProjectsWithTechnologiesBySkillNameRow
-// @Failure 400 {object} ErrorResponse "Invalid ID, skill name, page or page size"
-// @Failure 404 {object} ErrorResponse "CV profile with given ID or skill with given nam,e does not exist"
+// @Failure 400 {object} ErrorResponse "Invalid ID, skill, page or page size"
+// @Failure 404 {object} ErrorResponse "CV profile with given ID or skill with given slug or name does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
 // @Failure 500 {object} ErrorResponse "Any other server-side error"
 // @Router /projects/skill/{id}/{skill} [get]
 // listProjectsBySkillName returns a list of projects for a profile cv and provided skill name
@@ -96,18 +97,19 @@ func (server *Server) listProjectsBySkillName(ctx *gin.Context) {
 	// get and validate the cv profile id and skill name
 	var request listProjectsBySkillNameRequest
 	if err := ctx.ShouldBindUri(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, validationError(err))
 		return
 	}
+	setProfileID(ctx, request.ID)
 
 	// get and validate the query params - page and page size
 	var queryRequest listProjectsBySkillNameQueryRequest
 	if err := ctx.ShouldBindQuery(&queryRequest); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, validationError(err))
 		return
 	}
 
-	// get all projects for a profile cv nad skill name
+	// get all projects for a profile cv and skill, the store matches the slug of the skill
 	params := db.ListProjectsWithTechnologiesBySkillNameParams{
 		SkillName:   request.Skill,
 		CvProfileID: request.ID,
@@ -117,12 +119,12 @@ func (server *Server) listProjectsBySkillName(ctx *gin.Context) {
 
 	projects, err := server.store.ListProjectsWithTechnologiesBySkillName(ctx, params)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return
-		}
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
 
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	// an empty list is a 404 when the profile or the skill does not exist
+	if len(projects) == 0 && (!server.cvProfileExists(ctx, request.ID) || !server.skillExists(ctx, request.ID, request.Skill)) {
 		return
 	}
 
@@ -13,6 +13,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"sort"
 	"testing"
 )
@@ -115,10 +116,38 @@ func TestListProjectsAPI(t *testing.T) {
 				store.EXPECT().
 					ListProjectsWithTechnologies(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return([]db.ListProjectsWithTechnologiesRow{}, sql.ErrNoRows)
+					Return([]db.ListProjectsWithTechnologiesRow{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(false, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, CodeProfileNotFound, response.Code)
+			},
+		},
+		{
+			name: "Empty List",
+			id:   cvProfile.ID,
+			query: Query{
+				page:     1,
+				pageSize: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListProjectsWithTechnologies(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListProjectsWithTechnologiesRow{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(true, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, "[]", recorder.Body.String())
 			},
 		},
 		{
@@ -149,7 +178,7 @@ func TestListProjectsAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
-			server := newTestServer(store)
+			server := newTestServer(t, store)
 			recorder := httptest.NewRecorder()
 
 			url := fmt.Sprintf("%s/projects/%d", baseUrl, tc.id)
@@ -212,6 +241,31 @@ func TestListProjectsBySkillNameAPI(t *testing.T) {
 				requireBodyMatchProjects(t, recorder.Body, projects)
 			},
 		},
+		{
+			name:      "OK Name With A Slash",
+			id:        cvProfile.ID,
+			skillName: "CI/CD",
+			query: Query{
+				page:     1,
+				pageSize: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListProjectsWithTechnologiesBySkillNameParams{
+					SkillName:   "CI/CD",
+					CvProfileID: cvProfile.ID,
+					Limit:       10,
+					Offset:      0,
+				}
+				store.EXPECT().
+					ListProjectsWithTechnologiesBySkillName(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(projects, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchProjects(t, recorder.Body, projects)
+			},
+		},
 		{
 			name:      "Invalid ID",
 			id:        0,
@@ -230,9 +284,35 @@ func TestListProjectsBySkillNameAPI(t *testing.T) {
 			},
 		},
 		{
-			name:      "Invalid Skill Name",
+			name:      "Skill Name With Symbols",
 			id:        cvProfile.ID,
-			skillName: "123",
+			skillName: "C# & Vue.js 3",
+			query: Query{
+				page:     1,
+				pageSize: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				// the name is percent-decoded, the store matches its slug
+				params := db.ListProjectsWithTechnologiesBySkillNameParams{
+					SkillName:   "C# & Vue.js 3",
+					CvProfileID: cvProfile.ID,
+					Limit:       10,
+					Offset:      0,
+				}
+				store.EXPECT().
+					ListProjectsWithTechnologiesBySkillName(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(projects, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchProjects(t, recorder.Body, projects)
+			},
+		},
+		{
+			name:      "Skill Too Long",
+			id:        cvProfile.ID,
+			skillName: utils.RandomString(256),
 			query: Query{
 				page:     1,
 				pageSize: 10,
@@ -292,10 +372,78 @@ func TestListProjectsBySkillNameAPI(t *testing.T) {
 				store.EXPECT().
 					ListProjectsWithTechnologiesBySkillName(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return([]db.ListProjectsWithTechnologiesBySkillNameRow{}, sql.ErrNoRows)
+					Return([]db.ListProjectsWithTechnologiesBySkillNameRow{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(false, nil)
+				store.EXPECT().
+					GetSkillBySlug(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, CodeProfileNotFound, response.Code)
+			},
+		},
+		{
+			name:      "Skill Not Found",
+			id:        cvProfile.ID,
+			skillName: skillName,
+			query: Query{
+				page:     1,
+				pageSize: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListProjectsWithTechnologiesBySkillName(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListProjectsWithTechnologiesBySkillNameRow{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(true, nil)
+				params := db.GetSkillBySlugParams{
+					CvProfileID: cvProfile.ID,
+					Slug:        utils.Slugify(skillName),
+				}
+				store.EXPECT().
+					GetSkillBySlug(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(db.Skill{}, sql.ErrNoRows)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, CodeSkillNotFound, response.Code)
+			},
+		},
+		{
+			name:      "Empty List",
+			id:        cvProfile.ID,
+			skillName: skillName,
+			query: Query{
+				page:     1,
+				pageSize: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListProjectsWithTechnologiesBySkillName(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListProjectsWithTechnologiesBySkillNameRow{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(true, nil)
+				store.EXPECT().
+					GetSkillBySlug(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Skill{ID: 1, CvProfileID: cvProfile.ID, Name: skillName, Slug: skillName}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, "[]", recorder.Body.String())
 			},
 		},
 		{
@@ -327,10 +475,10 @@ func TestListProjectsBySkillNameAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
-			server := newTestServer(store)
+			server := newTestServer(t, store)
 			recorder := httptest.NewRecorder()
 
-			url := fmt.Sprintf("%s/projects/skill/%d/%s", baseUrl, tc.id, tc.skillName)
+			url := fmt.Sprintf("%s/projects/skill/%d/%s", baseUrl, tc.id, url.PathEscape(tc.skillName))
 			req, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
 
@@ -367,7 +515,7 @@ func generateRandomProjectRows() []db.ListProjectsWithTechnologiesRow {
 			ShortDescription: utils.RandomString(5),
 			Description:      utils.RandomString(10),
 			Image:            utils.RandomString(6),
-			HexThemeColor:    utils.RandomString(6),
+			HexThemeColor:    utils.RandomHexColor(),
 			ProjectUrl:       utils.RandomString(6),
 			Significance:     utils.RandomInt(1, 50),
 			TechnologiesUsed: technologiesUsed,
@@ -397,7 +545,7 @@ func generateRandomProjectBySkillNameRows() []db.ListProjectsWithTechnologiesByS
 			ShortDescription: utils.RandomString(5),
 			Description:      utils.RandomString(10),
 			Image:            utils.RandomString(6),
-			HexThemeColor:    utils.RandomString(6),
+			HexThemeColor:    utils.RandomHexColor(),
 			ProjectUrl:       utils.RandomString(6),
 			Significance:     utils.RandomInt(1, 50),
 			TechnologiesUsed: technologiesUsed,
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the clients whose buckets are full again are forgotten
+const sweepInterval = time.Minute
+
+// rateLimit is the budget of a route group - a bucket of Requests tokens that refills completely every Period
+type rateLimit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// parseRateLimit parses limits like "300/1m", an empty string or "0" disables the limit
+func parseRateLimit(value string) (limit rateLimit, enabled bool, err error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "0" {
+		return rateLimit{}, false, nil
+	}
+
+	requests, period, ok := strings.Cut(value, "/")
+	if !ok {
+		return rateLimit{}, false, fmt.Errorf("rate limit %q is not in the REQUESTS/PERIOD format, e.g. 300/1m", value)
+	}
+
+	limit.Requests, err = strconv.Atoi(requests)
+	if err != nil || limit.Requests < 1 {
+		return rateLimit{}, false, fmt.Errorf("invalid number of requests in rate limit %q", value)
+	}
+	limit.Period, err = time.ParseDuration(period)
+	if err != nil || limit.Period <= 0 {
+		return rateLimit{}, false, fmt.Errorf("invalid period in rate limit %q", value)
+	}
+
+	return limit, true, nil
+}
+
+// rateLimiter keeps a token bucket per client IP in memory
+type rateLimiter struct {
+	limit rate.Limit
+	burst int
+	now   func() time.Time
+
+	mu        sync.Mutex
+	clients   map[string]*rateLimitClient
+	lastSweep time.Time
+}
+
+type rateLimitClient struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newRateLimiter(limit rateLimit) *rateLimiter {
+	return &rateLimiter{
+		limit:     rate.Limit(float64(limit.Requests) / limit.Period.Seconds()),
+		burst:     limit.Requests,
+		now:       time.Now,
+		clients:   make(map[string]*rateLimitClient),
+		lastSweep: time.Now(),
+	}
+}
+
+// allow takes a token from the bucket of the client, when the bucket is empty it returns
+// how long the client has to wait for the next token
+func (l *rateLimiter) allow(clientIP string) (ok bool, retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		l.sweep(now)
+	}
+
+	client, found := l.clients[clientIP]
+	if !found {
+		client = &rateLimitClient{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[clientIP] = client
+	}
+	client.lastSeen = now
+
+	reservation := client.limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		// the request is rejected, so it must not use up a future token
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// sweep forgets the clients whose buckets have refilled, a new bucket behaves the same way
+func (l *rateLimiter) sweep(now time.Time) {
+	refill := time.Duration(float64(l.burst) / float64(l.limit) * float64(time.Second))
+	for clientIP, client := range l.clients {
+		if now.Sub(client.lastSeen) >= refill {
+			delete(l.clients, clientIP)
+		}
+	}
+	l.lastSweep = now
+}
+
+// rateLimitMiddleware responds with 429 Too Many Requests and the Retry-After header when the client IP
+// has used up its budget. The IP is the one of ctx.ClientIP, which only reads X-Forwarded-For
+// when the request comes from a trusted proxy.
+func rateLimitMiddleware(limiter *rateLimiter) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ok, retryAfter := limiter.allow(ctx.ClientIP())
+		if !ok {
+			ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			writeError(ctx, newAPIError(http.StatusTooManyRequests, CodeRateLimited, "too many requests", nil))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// newRateLimitMiddleware creates the middleware of the limit set in the env variable name,
+// when the limit is disabled the middleware lets every request through
+func newRateLimitMiddleware(name, value string) (gin.HandlerFunc, error) {
+	limit, enabled, err := parseRateLimit(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	if !enabled {
+		return func(ctx *gin.Context) { ctx.Next() }, nil
+	}
+	return rateLimitMiddleware(newRateLimiter(limit)), nil
+}
@@ -0,0 +1,191 @@
+package api
+
+import (
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	"github.com/aalug/cv-backend-go/internal/logger"
+	"github.com/aalug/cv-backend-go/internal/metrics"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   string
+		limit   rateLimit
+		enabled bool
+		wantErr bool
+	}{
+		{name: "Minute", value: "300/1m", limit: rateLimit{Requests: 300, Period: time.Minute}, enabled: true},
+		{name: "Spaces", value: " 5/10s ", limit: rateLimit{Requests: 5, Period: 10 * time.Second}, enabled: true},
+		{name: "Empty", value: "", enabled: false},
+		{name: "Zero", value: "0", enabled: false},
+		{name: "NoPeriod", value: "300", wantErr: true},
+		{name: "ZeroRequests", value: "0/1m", wantErr: true},
+		{name: "InvalidRequests", value: "many/1m", wantErr: true},
+		{name: "InvalidPeriod", value: "300/minute", wantErr: true},
+		{name: "NegativePeriod", value: "300/-1m", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			limit, enabled, err := parseRateLimit(tc.value)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.enabled, enabled)
+			require.Equal(t, tc.limit, limit)
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(rateLimit{Requests: 3, Period: time.Minute})
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		ok, _ := limiter.allow("192.0.2.1")
+		require.True(t, ok)
+	}
+
+	// a token is added every 20 seconds
+	ok, retryAfter := limiter.allow("192.0.2.1")
+	require.False(t, ok)
+	require.Equal(t, 20*time.Second, retryAfter.Round(time.Second))
+
+	// rejected requests do not use up the next token
+	now = now.Add(5 * time.Second)
+	ok, retryAfter = limiter.allow("192.0.2.1")
+	require.False(t, ok)
+	require.Equal(t, 15*time.Second, retryAfter.Round(time.Second))
+
+	// other clients have their own buckets
+	ok, _ = limiter.allow("192.0.2.2")
+	require.True(t, ok)
+
+	now = now.Add(15 * time.Second)
+	ok, _ = limiter.allow("192.0.2.1")
+	require.True(t, ok)
+	ok, _ = limiter.allow("192.0.2.1")
+	require.False(t, ok)
+
+	// clients whose buckets have refilled are forgotten
+	now = now.Add(time.Minute)
+	ok, _ = limiter.allow("192.0.2.3")
+	require.True(t, ok)
+	require.Len(t, limiter.clients, 1)
+}
+
+// newRateLimitedTestServer creates a server that allows 2 reads and 1 write per minute
+func newRateLimitedTestServer(t *testing.T, trustedProxies ...string) *Server {
+	ctrl := gomock.NewController(t)
+	cfg := testConfig()
+	cfg.RateLimitRead = "2/1m"
+	cfg.RateLimitWrite = "1/1m"
+	cfg.TrustedProxies = trustedProxies
+	return newTestServerWithConfig(t, mockdb.NewMockStore(ctrl), cfg)
+}
+
+// sendFrom sends a request with the remote address and the X-Forwarded-For header, when it is not empty
+func sendFrom(t *testing.T, server *Server, method, url, remoteAddr, forwardedFor string) *httptest.ResponseRecorder {
+	request, err := http.NewRequest(method, url, strings.NewReader("{}"))
+	require.NoError(t, err)
+	request.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		request.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	server := newRateLimitedTestServer(t)
+	const client = "192.0.2.1:1234"
+
+	// invalid requests count as well, they do not need the store
+	readURL := baseUrl + "/skills/0"
+	for i := 0; i < 2; i++ {
+		recorder := sendFrom(t, server, http.MethodGet, readURL, client, "")
+		require.Equal(t, http.StatusBadRequest, recorder.Code)
+	}
+
+	recorder := sendFrom(t, server, http.MethodGet, readURL, client, "")
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	require.Equal(t, "30", recorder.Header().Get("Retry-After"))
+	response := requireBodyErrorResponse(t, recorder.Body)
+	require.Equal(t, CodeRateLimited, response.Code)
+
+	// writes have a separate, stricter budget
+	loginURL := baseUrl + "/auth/login"
+	recorder = sendFrom(t, server, http.MethodPost, loginURL, client, "")
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	recorder = sendFrom(t, server, http.MethodPost, loginURL, client, "")
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	require.Equal(t, "60", recorder.Header().Get("Retry-After"))
+
+	recorder = sendFrom(t, server, http.MethodDelete, baseUrl+"/admin/projects/1", client, "")
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+
+	// health checks are never limited
+	for i := 0; i < 3; i++ {
+		recorder = sendFrom(t, server, http.MethodGet, "/healthz", client, "")
+		require.Equal(t, http.StatusOK, recorder.Code)
+	}
+}
+
+func TestRateLimitMiddleware_ForwardedFor(t *testing.T) {
+	readURL := baseUrl + "/skills/0"
+
+	// without trusted proxies the header is ignored, so changing it does not reset the budget
+	server := newRateLimitedTestServer(t)
+	for i, forwardedFor := range []string{"198.51.100.1", "198.51.100.2", "198.51.100.3"} {
+		recorder := sendFrom(t, server, http.MethodGet, readURL, "192.0.2.1:1234", forwardedFor)
+		if i < 2 {
+			require.Equal(t, http.StatusBadRequest, recorder.Code)
+		} else {
+			require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		}
+	}
+
+	// behind a trusted proxy every forwarded client has its own budget
+	server = newRateLimitedTestServer(t, "192.0.2.0/24")
+	for _, forwardedFor := range []string{"198.51.100.1", "198.51.100.2", "198.51.100.3"} {
+		recorder := sendFrom(t, server, http.MethodGet, readURL, "192.0.2.1:1234", forwardedFor)
+		require.Equal(t, http.StatusBadRequest, recorder.Code)
+	}
+	recorder := sendFrom(t, server, http.MethodGet, readURL, "192.0.2.1:1234", "198.51.100.1")
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	recorder = sendFrom(t, server, http.MethodGet, readURL, "192.0.2.1:1234", "198.51.100.1")
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+
+	// the header of other clients is still ignored
+	for i := 0; i < 2; i++ {
+		recorder = sendFrom(t, server, http.MethodGet, readURL, "203.0.113.1:1234", "198.51.100.2")
+		require.Equal(t, http.StatusBadRequest, recorder.Code)
+	}
+}
+
+func TestNewServer_InvalidRateLimitConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	cfg := testConfig()
+	cfg.RateLimitWrite = "10 per minute"
+	_, err := NewServer(cfg, store, metrics.New(), logger.Discard())
+	require.ErrorContains(t, err, "RATE_LIMIT_WRITE")
+
+	cfg = testConfig()
+	cfg.TrustedProxies = []string{"not an ip"}
+	_, err = NewServer(cfg, store, metrics.New(), logger.Discard())
+	require.ErrorContains(t, err, "TRUSTED_PROXIES")
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/internal/resume"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+const (
+	// resumeTopProjects is the number of the most significant projects included in the PDF résumé
+	resumeTopProjects = 5
+	// resumeAllProjects includes every project in the full export
+	resumeAllProjects = 0
+	// resumePageSize is the number of rows of a section read at once
+	resumePageSize int32 = 50
+)
+
+type getResumeRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+type getResumeQueryRequest struct {
+	Template string `form:"template" binding:"omitempty,oneof=classic modern"`
+}
+
+// @Schemes
+// @Summary Get CV profile as a PDF résumé
+// @Description Render the CV profile with provided ID, its education, experience, skills grouped by category and top projects into a PDF
+// @Tags cv-profiles
+// @Param id path integer true "CV profile ID"
+// @Param template query string false "Built-in template, classic (default) or modern"
+// @Produce application/pdf
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse "Invalid ID or template"
+// @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /cv-profiles/{id}/resume.pdf [get]
+// getResume renders the cv profile as a PDF résumé
+func (server *Server) getResume(ctx *gin.Context) {
+	var request getResumeRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+	setProfileID(ctx, request.ID)
+
+	var queryRequest getResumeQueryRequest
+	if err := ctx.ShouldBindQuery(&queryRequest); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	data, err := server.loadResumeData(ctx, request.ID, resumeTopProjects)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
+
+	// render into a buffer first, so that a rendering error can still be returned as JSON
+	var pdf bytes.Buffer
+	if err := resume.Render(&pdf, queryRequest.Template, data); err != nil {
+		writeError(ctx, internalError(err))
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`inline; filename="resume-%d.pdf"`, request.ID))
+	ctx.Data(http.StatusOK, "application/pdf", pdf.Bytes())
+}
+
+// @Schemes
+// @Summary Export CV profile as JSON Resume
+// @Description Export the CV profile with provided ID, its education, work experience, skills and projects in the jsonresume.org schema
+// @Tags cv-profiles
+// @Param id path integer true "CV profile ID"
+// @Produce json
+// @Success 200 {object} resume.JSONResume
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /cv-profiles/{id}/resume.json [get]
+// exportJSONResume exports the cv profile in the JSON Resume schema
+func (server *Server) exportJSONResume(ctx *gin.Context) {
+	var request getResumeRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+	setProfileID(ctx, request.ID)
+
+	data, err := server.loadResumeData(ctx, request.ID, resumeAllProjects)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resume.ToJSONResume(data))
+}
+
+// loadResumeData gets the cv profile with everything that is shown in a résumé,
+// projects are ordered by significance, so projectsLimit returns the most significant ones and 0 returns all of them
+func (server *Server) loadResumeData(ctx *gin.Context, cvProfileID int32, projectsLimit int32) (resume.Data, error) {
+	cvProfile, err := server.store.GetCvProfile(ctx, cvProfileID)
+	if err != nil {
+		return resume.Data{}, err
+	}
+
+	data := resume.Data{Profile: cvProfile}
+
+	data.Education, err = listAll(resumePageSize, func(limit, offset int32) ([]db.CvEducation, error) {
+		return server.store.ListCvEducations(ctx, db.ListCvEducationsParams{
+			CvProfileID: cvProfile.ID,
+			Limit:       limit,
+			Offset:      offset,
+		})
+	})
+	if err != nil {
+		return resume.Data{}, err
+	}
+
+	data.Experience, err = listAll(resumePageSize, func(limit, offset int32) ([]db.ListCvExperiencesWithDetailsRow, error) {
+		return server.store.ListCvExperiencesWithDetails(ctx, db.ListCvExperiencesWithDetailsParams{
+			CvProfileID: cvProfile.ID,
+			Limit:       limit,
+			Offset:      offset,
+		})
+	})
+	if err != nil {
+		return resume.Data{}, err
+	}
+
+	data.Skills, err = listAll(resumePageSize, func(limit, offset int32) ([]db.Skill, error) {
+		return server.store.ListSkills(ctx, db.ListSkillsParams{
+			CvProfileID: cvProfile.ID,
+			Limit:       limit,
+			Offset:      offset,
+		})
+	})
+	if err != nil {
+		return resume.Data{}, err
+	}
+
+	listProjects := func(limit, offset int32) ([]db.ListProjectsWithTechnologiesRow, error) {
+		return server.store.ListProjectsWithTechnologies(ctx, db.ListProjectsWithTechnologiesParams{
+			CvProfileID: cvProfile.ID,
+			Limit:       limit,
+			Offset:      offset,
+		})
+	}
+	if projectsLimit > 0 {
+		data.Projects, err = listProjects(projectsLimit, 0)
+	} else {
+		data.Projects, err = listAll(resumePageSize, listProjects)
+	}
+	if err != nil {
+		return resume.Data{}, err
+	}
+
+	return data, nil
+}
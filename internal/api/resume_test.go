@@ -0,0 +1,329 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/internal/resume"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetResumeAPI(t *testing.T) {
+	cvProfile := generateRandomCvProfile()
+	experience := generateRandomCvExperienceRows()
+	skills := generateRandomSkills()
+	projects := generateRandomProjectRows()
+
+	buildResumeStubs := func(store *mockdb.MockStore) {
+		expectLoadResumeData(store, cvProfile, experience, skills, projects, resumeTopProjects)
+	}
+
+	testCases := []struct {
+		name          string
+		id            int32
+		template      string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			id:         cvProfile.ID,
+			buildStubs: buildResumeStubs,
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				requirePDFResponse(t, recorder)
+			},
+		},
+		{
+			name:       "OK Modern Template",
+			id:         cvProfile.ID,
+			template:   "modern",
+			buildStubs: buildResumeStubs,
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				requirePDFResponse(t, recorder)
+			},
+		},
+		{
+			name:     "Invalid Template",
+			id:       cvProfile.ID,
+			template: "fancy",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid ID",
+			id:   0,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   cvProfile.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(db.CvProfile{}, sql.ErrNoRows)
+				store.EXPECT().
+					ListSkills(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error ListSkills",
+			id:   cvProfile.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(cvProfile, nil)
+				store.EXPECT().
+					ListCvEducations(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.CvEducation{}, nil)
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(experience, nil)
+				store.EXPECT().
+					ListSkills(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Skill{}, sql.ErrConnDone)
+				store.EXPECT().
+					ListProjectsWithTechnologies(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/cv-profiles/%d/resume.pdf", baseUrl, tc.id)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			if tc.template != "" {
+				q := req.URL.Query()
+				q.Add("template", tc.template)
+				req.URL.RawQuery = q.Encode()
+			}
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestExportJSONResumeAPI(t *testing.T) {
+	cvProfile := generateRandomCvProfile()
+	experience := generateRandomCvExperienceRows()
+	skills := generateRandomSkills()
+	projects := generateRandomProjectRows()
+	var fullSkillPage []db.Skill
+	for int32(len(fullSkillPage)) < resumePageSize {
+		fullSkillPage = append(fullSkillPage, db.Skill{ID: int32(len(fullSkillPage) + 1000), Name: utils.RandomString(8), Category: utils.RandomString(6)})
+	}
+
+	testCases := []struct {
+		name          string
+		id            int32
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   cvProfile.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				expectLoadResumeData(store, cvProfile, experience, skills, projects, resumeAllProjects)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got resume.JSONResume
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, resume.JSONResumeSchema, got.Schema)
+				require.Equal(t, cvProfile.Name, got.Basics.Name)
+				require.Equal(t, cvProfile.Email, got.Basics.Email)
+				require.Len(t, got.Work, len(experience))
+				require.Len(t, got.Projects, len(projects))
+				require.NotEmpty(t, got.Skills)
+			},
+		},
+		{
+			name: "OK Skills On Many Pages",
+			id:   cvProfile.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(cvProfile, nil)
+				store.EXPECT().
+					ListCvEducations(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.CvEducation{}, nil)
+				store.EXPECT().
+					ListCvExperiencesWithDetails(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListCvExperiencesWithDetailsRow{}, nil)
+				store.EXPECT().
+					ListSkills(gomock.Any(), gomock.Eq(db.ListSkillsParams{CvProfileID: cvProfile.ID, Limit: resumePageSize})).
+					Times(1).
+					Return(fullSkillPage, nil)
+				store.EXPECT().
+					ListSkills(gomock.Any(), gomock.Eq(db.ListSkillsParams{CvProfileID: cvProfile.ID, Limit: resumePageSize, Offset: resumePageSize})).
+					Times(1).
+					Return(skills, nil)
+				store.EXPECT().
+					ListProjectsWithTechnologies(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListProjectsWithTechnologiesRow{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got resume.JSONResume
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+
+				keywords := 0
+				for _, skill := range got.Skills {
+					keywords += len(skill.Keywords)
+				}
+				require.Equal(t, len(fullSkillPage)+len(skills), keywords)
+			},
+		},
+		{
+			name: "Invalid ID",
+			id:   0,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			id:   cvProfile.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(db.CvProfile{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			id:   cvProfile.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCvProfile(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(db.CvProfile{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/cv-profiles/%d/resume.json", baseUrl, tc.id)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+// requirePDFResponse asserts that the response is a successfully rendered PDF
+func requirePDFResponse(t *testing.T, recorder *httptest.ResponseRecorder) {
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "application/pdf", recorder.Header().Get("Content-Type"))
+	require.Contains(t, recorder.Header().Get("Content-Disposition"), "inline")
+	require.True(t, bytes.HasPrefix(recorder.Body.Bytes(), []byte("%PDF-")))
+}
+
+// expectLoadResumeData expects every store call made by loadResumeData, every section fits on one page
+func expectLoadResumeData(store *mockdb.MockStore, cvProfile db.CvProfile, experience []db.ListCvExperiencesWithDetailsRow, skills []db.Skill, projects []db.ListProjectsWithTechnologiesRow, projectsLimit int32) {
+	if projectsLimit == resumeAllProjects {
+		projectsLimit = resumePageSize
+	}
+
+	store.EXPECT().
+		GetCvProfile(gomock.Any(), gomock.Eq(cvProfile.ID)).
+		Times(1).
+		Return(cvProfile, nil)
+	store.EXPECT().
+		ListCvEducations(gomock.Any(), gomock.Eq(db.ListCvEducationsParams{CvProfileID: cvProfile.ID, Limit: resumePageSize})).
+		Times(1).
+		Return([]db.CvEducation{}, nil)
+	store.EXPECT().
+		ListCvExperiencesWithDetails(gomock.Any(), gomock.Eq(db.ListCvExperiencesWithDetailsParams{CvProfileID: cvProfile.ID, Limit: resumePageSize})).
+		Times(1).
+		Return(experience, nil)
+	store.EXPECT().
+		ListSkills(gomock.Any(), gomock.Eq(db.ListSkillsParams{CvProfileID: cvProfile.ID, Limit: resumePageSize})).
+		Times(1).
+		Return(skills, nil)
+	store.EXPECT().
+		ListProjectsWithTechnologies(gomock.Any(), gomock.Eq(db.ListProjectsWithTechnologiesParams{CvProfileID: cvProfile.ID, Limit: projectsLimit})).
+		Times(1).
+		Return(projects, nil)
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type searchRequest struct {
+	Profile  int32  `form:"profile" binding:"required,min=1"`
+	Query    string `form:"q" binding:"required,max=200"`
+	Page     int32  `form:"page,default=1" binding:"min=1"`
+	PageSize int32  `form:"page_size,default=10" binding:"min=5,max=15"`
+}
+
+// @Schemes
+// @Summary Search a profile cv
+// @Description Full-text search across the projects, skills and bio of a profile cv. Results are ordered by rank and matches in the snippets are wrapped in <mark> tags.
+// @Tags search
+// @Param profile query integer true "CV profile ID"
+// @Param q query string true "Search query, supports quotes, OR and - like web search engines"
+// @Param page query integer false "Page number, 1 by default"
+// @Param page_size query integer false "Page size, 10 by default"
+// @Produce json
+// @Success 200 {object} []db.SearchCvProfileRow
+// @Failure 400 {object} ErrorResponse "Invalid profile ID, query, page or page size"
+// @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /search [get]
+// search returns ranked projects, skills and the profile matching the query
+func (server *Server) search(ctx *gin.Context) {
+	var request searchRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+	setProfileID(ctx, request.Profile)
+
+	params := db.SearchCvProfileParams{
+		CvProfileID: request.Profile,
+		Query:       request.Query,
+		Limit:       request.PageSize,
+		Offset:      (request.Page - 1) * request.PageSize,
+	}
+
+	results, err := server.store.SearchCvProfile(ctx, params)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
+
+	if len(results) == 0 && !server.cvProfileExists(ctx, request.Profile) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, results)
+}
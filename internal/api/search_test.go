@@ -0,0 +1,215 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	mockdb "github.com/aalug/cv-backend-go/internal/db/mock"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchAPI(t *testing.T) {
+	cvProfile := generateRandomCvProfile()
+	results := generateRandomSearchResults()
+	query := "golang api"
+
+	testCases := []struct {
+		name          string
+		query         map[string]string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: map[string]string{"profile": fmt.Sprint(cvProfile.ID), "q": query},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.SearchCvProfileParams{
+					CvProfileID: cvProfile.ID,
+					Query:       query,
+					Limit:       10,
+					Offset:      0,
+				}
+				store.EXPECT().
+					SearchCvProfile(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(results, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSearchResults(t, recorder.Body, results)
+			},
+		},
+		{
+			name: "OK With Pagination",
+			query: map[string]string{
+				"profile":   fmt.Sprint(cvProfile.ID),
+				"q":         query,
+				"page":      "3",
+				"page_size": "5",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.SearchCvProfileParams{
+					CvProfileID: cvProfile.ID,
+					Query:       query,
+					Limit:       5,
+					Offset:      10,
+				}
+				store.EXPECT().
+					SearchCvProfile(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return([]db.SearchCvProfileRow{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(true, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSearchResults(t, recorder.Body, []db.SearchCvProfileRow{})
+			},
+		},
+		{
+			name:  "Not Found",
+			query: map[string]string{"profile": fmt.Sprint(cvProfile.ID), "q": query},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchCvProfile(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.SearchCvProfileRow{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(false, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, CodeProfileNotFound, response.Code)
+			},
+		},
+		{
+			name:  "Missing Query",
+			query: map[string]string{"profile": fmt.Sprint(cvProfile.ID)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchCvProfile(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid Profile",
+			query: map[string]string{"profile": "0", "q": query},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchCvProfile(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid Page Size",
+			query: map[string]string{"profile": fmt.Sprint(cvProfile.ID), "q": query, "page_size": "30"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchCvProfile(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal Server Error",
+			query: map[string]string{"profile": fmt.Sprint(cvProfile.ID), "q": query},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchCvProfile(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.SearchCvProfileRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/search", baseUrl)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			// Add query params
+			q := req.URL.Query()
+			for key, value := range tc.query {
+				q.Add(key, value)
+			}
+			req.URL.RawQuery = q.Encode()
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+// generateRandomSearchResults generates and returns search results of every kind, ordered by rank
+func generateRandomSearchResults() []db.SearchCvProfileRow {
+	return []db.SearchCvProfileRow{
+		{
+			Kind:    "project",
+			ID:      utils.RandomInt(1, 1000),
+			Title:   utils.RandomString(6),
+			Snippet: "a <mark>golang</mark> " + utils.RandomString(10),
+			Rank:    0.9,
+		},
+		{
+			Kind:    "skill",
+			ID:      utils.RandomInt(1, 1000),
+			Title:   utils.RandomString(6),
+			Snippet: "<mark>api</mark> " + utils.RandomString(10),
+			Rank:    0.5,
+		},
+		{
+			Kind:    "profile",
+			ID:      utils.RandomInt(1, 1000),
+			Title:   utils.RandomString(6),
+			Snippet: utils.RandomString(10) + " <mark>golang</mark>",
+			Rank:    0.1,
+		},
+	}
+}
+
+// requireBodyMatchSearchResults asserts that the response body contains the same results in the same order
+func requireBodyMatchSearchResults(t *testing.T, body *bytes.Buffer, results []db.SearchCvProfileRow) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotResults []db.SearchCvProfileRow
+	err = json.Unmarshal(data, &gotResults)
+	require.NoError(t, err)
+	require.Equal(t, results, gotResults)
+}
@@ -1,71 +1,222 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"github.com/aalug/cv-backend-go/docs"
+	"github.com/aalug/cv-backend-go/internal/cache"
 	"github.com/aalug/cv-backend-go/internal/config"
 	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
-	"github.com/gin-contrib/cors"
+	"github.com/aalug/cv-backend-go/internal/metrics"
+	"github.com/aalug/cv-backend-go/internal/token"
 	"github.com/gin-gonic/gin"
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"golang.org/x/sync/errgroup"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
 )
 
 // Server serves HTTP  requests for the service
 type Server struct {
-	config config.Config
-	store  db.Store
-	router *gin.Engine
+	config     config.Config
+	store      db.Store
+	cache      *cache.Cache
+	tokenMaker token.Maker
+	metrics    *metrics.Metrics
+	logger     *slog.Logger
+	router     *gin.Engine
 }
 
-// NewServer creates a new HTTP server and setups routing
-func NewServer(cfg config.Config, store db.Store) *Server {
+// NewServer creates a new HTTP server and setups routing, requests are recorded in m and logged to logger.
+// The responses of the public endpoints are cached until the data of their cv profile is written through store.
+func NewServer(cfg config.Config, store db.Store, m *metrics.Metrics, logger *slog.Logger) (*Server, error) {
+	tokenMaker, err := token.NewMaker(cfg.TokenType, cfg.TokenSymmetricKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create token maker: %w", err)
+	}
+
+	responseCache := cache.New(cfg.CacheTTL)
 	server := &Server{
-		config: cfg,
-		store:  store,
+		config:     cfg,
+		store:      cache.NewStore(store, responseCache),
+		cache:      responseCache,
+		tokenMaker: tokenMaker,
+		metrics:    m,
+		logger:     logger,
 	}
 
-	server.setupRouter()
+	registerFieldNames()
+	if err := server.setupRouter(); err != nil {
+		return nil, err
+	}
 
-	return server
+	return server, nil
 }
 
 // setupRouter sets up the HTTP routing
-func (server *Server) setupRouter() {
-	router := gin.Default()
+func (server *Server) setupRouter() error {
+	router := gin.New()
+	// the routes match the escaped path, so a skill name like "CI/CD" can be a single path segment as CI%2FCD
+	router.UseRawPath = true
+	router.UnescapePathValues = true
+
+	// X-Forwarded-For is only read from requests of the trusted proxies, otherwise clients could choose their IP
+	trustedProxies := make([]string, 0, len(server.config.TrustedProxies))
+	for _, proxy := range server.config.TrustedProxies {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			trustedProxies = append(trustedProxies, proxy)
+		}
+	}
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		return fmt.Errorf("invalid TRUSTED_PROXIES: %w", err)
+	}
+
+	readLimit, err := newRateLimitMiddleware("RATE_LIMIT_READ", server.config.RateLimitRead)
+	if err != nil {
+		return err
+	}
+	writeLimit, err := newRateLimitMiddleware("RATE_LIMIT_WRITE", server.config.RateLimitWrite)
+	if err != nil {
+		return err
+	}
+
+	// the public endpoints are open to any allowed origin, login and the admin endpoints only to the dashboard
+	publicCORS, err := newCORSMiddleware(server.config, server.config.CORSAllowedOrigins, false)
+	if err != nil {
+		return fmt.Errorf("invalid CORS_ALLOWED_ORIGINS: %w", err)
+	}
+	dashboardCORS, err := newCORSMiddleware(server.config, server.config.CORSAdminOrigins, server.config.CORSAllowCredentials)
+	if err != nil {
+		return fmt.Errorf("invalid CORS_ADMIN_ORIGINS: %w", err)
+	}
+
+	router.Use(
+		requestIDMiddleware(),
+		loggerMiddleware(server.logger),
+		metricsMiddleware(server.metrics),
+		// panics are logged by the logger middleware with the request ID, instead of plain text to stderr
+		gin.CustomRecoveryWithWriter(io.Discard, func(ctx *gin.Context, recovered any) {
+			writeError(ctx, internalError(fmt.Errorf("panic: %v", recovered)))
+		}),
+	)
+
+	// Prometheus metrics, served by a separate server when the metrics address is set
+	if server.config.MetricsAddress == "" {
+		router.GET("/metrics", gin.WrapH(server.metrics.Handler()))
+	}
 
 	routerV1 := router.Group("/api/v1")
 
-	// CORS
-	corsConfig := cors.DefaultConfig()
-	corsConfig.AllowAllOrigins = true
-	routerV1.Use(cors.New(corsConfig))
+	router.NoRoute(func(ctx *gin.Context) {
+		writeError(ctx, newAPIError(http.StatusNotFound, CodeNotFound, "route not found", nil))
+	})
+
+	// Health checks, outside of the versioned API
+	router.GET("/healthz", server.healthz)
+	router.GET("/readyz", server.readyz)
 
 	// Swagger docs
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 	docs.SwaggerInfo.BasePath = "/api/v1"
 
+	// every client IP has a budget per route group, writes get the stricter one. CORS comes first,
+	// so preflight requests are answered without using up the budget.
+	readRoutes := routerV1.Group("", publicCORS, readLimit)
+	writeRoutes := routerV1.Group("", dashboardCORS, writeLimit)
+
+	// preflight requests only reach the CORS middleware of a group through an OPTIONS route
+	for _, path := range []string{"/cv-profiles/*path", "/skills/*path", "/projects/*path", "/search"} {
+		readRoutes.OPTIONS(path, noContent)
+	}
+	for _, path := range []string{"/auth/login", "/admin/*path"} {
+		writeRoutes.OPTIONS(path, noContent)
+	}
+
+	// --- auth ---
+	writeRoutes.POST("/auth/login", server.loginUser)
+
+	// responses cached per cv profile, :id is the ID of the profile
+	cached := cacheMiddleware(server.cache, server.config.CacheMaxAge)
+
 	// --- cv profiles ---
-	routerV1.GET("/cv-profiles/:id", server.getCvProfile)
+	readRoutes.GET("/cv-profiles/:id", cached, server.getCvProfile)
+	readRoutes.GET("/cv-profiles/:id/experience", server.listCvExperiences)
+	readRoutes.GET("/cv-profiles/:id/skills", cached, server.listCvProfileSkills)
+	readRoutes.GET("/cv-profiles/:id/resume.pdf", server.getResume)
+	readRoutes.GET("/cv-profiles/:id/resume.json", server.exportJSONResume)
 
 	// --- skills ---
-	routerV1.GET("/skills/:id", server.listSkills)
+	readRoutes.GET("/skills/:id", cached, server.listSkills)
 
 	// --- projects ---
-	routerV1.GET("/projects/skill/:id/:skill", server.listProjectsBySkillName)
-	routerV1.GET("/projects/:id", server.listProjects)
+	readRoutes.GET("/projects/skill/:id/:skill", cached, server.listProjectsBySkillName)
+	readRoutes.GET("/projects/:id", cached, server.listProjects)
+
+	// --- search ---
+	readRoutes.GET("/search", server.search)
+
+	// --- admin ---
+	adminRoutes := writeRoutes.Group("/admin").Use(authMiddleware(server.tokenMaker))
+	adminRoutes.POST("/cv-profiles/import", server.importJSONResume)
+	adminRoutes.PUT("/cv-profiles/:id/skills/order", server.reorderSkills)
+	adminRoutes.PUT("/cv-profiles/:id/skill-categories", server.upsertSkillCategory)
+	adminRoutes.PUT("/cv-profiles/:id/projects/order", server.reorderProjects)
+	adminRoutes.POST("/projects", server.createProject)
+	adminRoutes.POST("/skills", server.createSkill)
+	adminRoutes.PUT("/projects/:id", server.updateProject)
+	adminRoutes.PATCH("/projects/:id", server.patchProject)
+	adminRoutes.DELETE("/projects/:id", server.deleteProject)
 
 	server.router = router
+	return nil
 }
 
-// Start runs the HTTP server on a given address
-func (server *Server) Start(address string) error {
-	return server.router.Run(address)
-}
+// Start runs the HTTP server on a given address, and the metrics server if its address is configured,
+// until ctx is done, then it stops accepting connections and waits up to the shutdown timeout for in-flight requests
+func (server *Server) Start(ctx context.Context, address string) error {
+	servers := []*http.Server{{
+		Addr:              address,
+		Handler:           server.router,
+		ReadHeaderTimeout: 10 * time.Second,
+	}}
+	if server.config.MetricsAddress != "" {
+		servers = append(servers, &http.Server{
+			Addr:              server.config.MetricsAddress,
+			Handler:           server.metrics.Handler(),
+			ReadHeaderTimeout: 10 * time.Second,
+		})
+	}
 
-type ErrorResponse struct {
-	Error string `json:"error"`
-}
+	group, groupCtx := errgroup.WithContext(ctx)
+	for _, httpServer := range servers {
+		httpServer := httpServer
+		group.Go(func() error {
+			if err := httpServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		})
+	}
+
+	// stop all servers when ctx is done or when any of them fails to start
+	group.Go(func() error {
+		<-groupCtx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), server.config.ShutdownTimeout)
+		defer cancel()
+
+		for _, httpServer := range servers {
+			if err := httpServer.Shutdown(shutdownCtx); err != nil {
+				return fmt.Errorf("cannot shut down the server: %w", err)
+			}
+		}
+		return nil
+	})
 
-func errorResponse(err error) ErrorResponse {
-	return ErrorResponse{Error: err.Error()}
+	return group.Wait()
 }
@@ -1,9 +1,8 @@
 package api
 
 import (
-	"database/sql"
-	"errors"
 	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/pkg/utils"
 	"github.com/gin-gonic/gin"
 	"net/http"
 )
@@ -21,15 +20,17 @@ type listSkillsRequest struct {
 // @Success 200 {object} []db.Skill
 // @Failure 400 {object} ErrorResponse "Invalid ID"
 // @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
 // @Failure 500 {object} ErrorResponse "Any other server-side error"
 // @Router /skills/{id} [get]
 // listSkills returns all skills for a profile cv
 func (server *Server) listSkills(ctx *gin.Context) {
 	var request listSkillsRequest
 	if err := ctx.ShouldBindUri(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		writeError(ctx, validationError(err))
 		return
 	}
+	setProfileID(ctx, request.ID)
 
 	// get all skills for a profile cv
 	params := db.ListSkillsParams{
@@ -40,14 +41,79 @@ func (server *Server) listSkills(ctx *gin.Context) {
 
 	skills, err := server.store.ListSkills(ctx, params)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return
-		}
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
 
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	if len(skills) == 0 && !server.cvProfileExists(ctx, request.ID) {
 		return
 	}
 
 	ctx.JSON(http.StatusOK, skills)
 }
+
+type listCvProfileSkillsQueryRequest struct {
+	Group string `form:"group" binding:"omitempty,oneof=category"`
+}
+
+// @Schemes
+// @Summary List skills of a cv profile
+// @Description List the skills of a cv profile with provided ID. With group=category the skills are grouped by their categories,
+// @Description the categories are ordered by their display order and their skills by importance. Categories without
+// @Description display settings come last, ordered by name. Without group the response is the list of /skills/{id}.
+// @Tags skills
+// @Param id path integer true "CV profile ID"
+// @Param group query string false "Group the skills, only category is supported" Enums(category)
+// @Produce json
+// @Success 200 {object} []db.ListSkillCategoriesWithSkillsRow "Categories with their skills, or a list of db.Skill without group"
+// @Failure 400 {object} ErrorResponse "Invalid ID or group"
+// @Failure 404 {object} ErrorResponse "CV profile with given ID does not exist"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /cv-profiles/{id}/skills [get]
+// listCvProfileSkills returns the skills of a cv profile, grouped by category when requested
+func (server *Server) listCvProfileSkills(ctx *gin.Context) {
+	var queryRequest listCvProfileSkillsQueryRequest
+	if err := ctx.ShouldBindQuery(&queryRequest); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+	if queryRequest.Group == "" {
+		server.listSkills(ctx)
+		return
+	}
+
+	var request listSkillsRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+	setProfileID(ctx, request.ID)
+
+	categories, err := server.store.ListSkillCategoriesWithSkills(ctx, request.ID)
+	if err != nil {
+		writeError(ctx, storeError(err, CodeProfileNotFound))
+		return
+	}
+
+	if len(categories) == 0 && !server.cvProfileExists(ctx, request.ID) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, categories)
+}
+
+// skillExists writes a SKILL_NOT_FOUND error and returns false when the cv profile has no skill with the slug
+// of nameOrSlug. Like cvProfileExists, it is only called for empty results.
+func (server *Server) skillExists(ctx *gin.Context, cvProfileID int32, nameOrSlug string) bool {
+	_, err := server.store.GetSkillBySlug(ctx, db.GetSkillBySlugParams{
+		CvProfileID: cvProfileID,
+		Slug:        utils.Slugify(nameOrSlug),
+	})
+	if err != nil {
+		writeError(ctx, storeError(err, CodeSkillNotFound))
+		return false
+	}
+
+	return true
+}
@@ -64,18 +64,37 @@ func TestListSkillsAPI(t *testing.T) {
 			name: "Not Found",
 			id:   cvProfile.ID,
 			buildStubs: func(store *mockdb.MockStore) {
-				params := db.ListSkillsParams{
-					CvProfileID: cvProfile.ID,
-					Limit:       50,
-					Offset:      0,
-				}
 				store.EXPECT().
-					ListSkills(gomock.Any(), gomock.Eq(params)).
+					ListSkills(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return([]db.Skill{}, sql.ErrNoRows)
+					Return([]db.Skill{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(false, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, CodeProfileNotFound, response.Code)
+			},
+		},
+		{
+			name: "Empty List",
+			id:   cvProfile.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListSkills(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Skill{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(true, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, "[]", recorder.Body.String())
 			},
 		},
 		{
@@ -102,7 +121,7 @@ func TestListSkillsAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
-			server := newTestServer(store)
+			server := newTestServer(t, store)
 			recorder := httptest.NewRecorder()
 
 			url := fmt.Sprintf("%s/skills/%d", baseUrl, tc.id)
@@ -116,6 +135,149 @@ func TestListSkillsAPI(t *testing.T) {
 	}
 }
 
+func TestListCvProfileSkillsAPI(t *testing.T) {
+	cvProfile := generateRandomCvProfile()
+	skills := generateRandomSkills()
+	categories := []db.ListSkillCategoriesWithSkillsRow{
+		{
+			Name:          skills[0].Category,
+			Description:   utils.RandomString(20),
+			HexThemeColor: utils.RandomHexColor(),
+			DisplayOrder:  1,
+			Skills:        skills,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "Grouped By Category",
+			query: "?group=category",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListSkillCategoriesWithSkills(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(categories, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []db.ListSkillCategoriesWithSkillsRow
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, categories, got)
+			},
+		},
+		{
+			name:  "Not Grouped",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListSkills(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(skills, nil)
+				store.EXPECT().
+					ListSkillCategoriesWithSkills(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchSkills(t, recorder.Body, skills)
+			},
+		},
+		{
+			name:  "Invalid Group",
+			query: "?group=importance",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListSkillCategoriesWithSkills(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, []FieldError{{Field: "group", Rule: "oneof=category"}}, response.Fields)
+			},
+		},
+		{
+			name:  "Not Found",
+			query: "?group=category",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListSkillCategoriesWithSkills(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListSkillCategoriesWithSkillsRow{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(false, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+				response := requireBodyErrorResponse(t, recorder.Body)
+				require.Equal(t, CodeProfileNotFound, response.Code)
+			},
+		},
+		{
+			name:  "Empty List",
+			query: "?group=category",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListSkillCategoriesWithSkills(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListSkillCategoriesWithSkillsRow{}, nil)
+				store.EXPECT().
+					CvProfileExists(gomock.Any(), gomock.Eq(cvProfile.ID)).
+					Times(1).
+					Return(true, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, "[]", recorder.Body.String())
+			},
+		},
+		{
+			name:  "Internal Server Error",
+			query: "?group=category",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListSkillCategoriesWithSkills(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/cv-profiles/%d/skills%s", baseUrl, cvProfile.ID, tc.query)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
 // generateRandomSkills generates and returns a slice of random skills
 func generateRandomSkills() []db.Skill {
 	category := utils.RandomString(5)
@@ -127,7 +289,7 @@ func generateRandomSkills() []db.Skill {
 			Description:   utils.RandomString(10),
 			Category:      category,
 			Image:         utils.RandomString(6),
-			HexThemeColor: utils.RandomString(6),
+			HexThemeColor: utils.RandomHexColor(),
 			CvProfileID:   1,
 			Importance:    int32(i),
 		})
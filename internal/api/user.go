@@ -0,0 +1,85 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type loginUserRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+type userResponse struct {
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type loginUserResponse struct {
+	AccessToken          string       `json:"access_token"`
+	AccessTokenExpiresAt time.Time    `json:"access_token_expires_at"`
+	User                 userResponse `json:"user"`
+}
+
+// @Schemes
+// @Summary Login user
+// @Description Login with username and password to get an access token for the admin endpoints
+// @Tags auth
+// @Param request body loginUserRequest true "User credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} loginUserResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Invalid username or password"
+// @Failure 429 {object} ErrorResponse "Too many requests from the client IP, retry after the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other server-side error"
+// @Router /auth/login [post]
+// loginUser handles logging in and returns an access token
+func (server *Server) loginUser(ctx *gin.Context) {
+	var request loginUserRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		writeError(ctx, validationError(err))
+		return
+	}
+
+	// the same error for an unknown user and a wrong password
+	errInvalidCredentials := newAPIError(http.StatusUnauthorized, CodeInvalidCredentials, "invalid username or password", nil)
+
+	user, err := server.store.GetUser(ctx, request.Username)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(ctx, errInvalidCredentials)
+			return
+		}
+
+		writeError(ctx, internalError(err))
+		return
+	}
+
+	err = utils.CheckPassword(request.Password, user.HashedPassword)
+	if err != nil {
+		writeError(ctx, errInvalidCredentials)
+		return
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, server.config.AccessTokenDuration)
+	if err != nil {
+		writeError(ctx, internalError(err))
+		return
+	}
+
+	response := loginUserResponse{
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: accessPayload.ExpiredAt,
+		User: userResponse{
+			Username:  user.Username,
+			CreatedAt: user.CreatedAt,
+		},
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
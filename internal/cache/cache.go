@@ -0,0 +1,157 @@
+// Package cache keeps the responses of the public endpoints in memory. The entries are grouped by cv profile,
+// Store drops the entries of a profile whenever its data is written through the db.Store.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// maxEntries bounds the memory used by the cache, the keys include the query string that clients choose
+const maxEntries = 1000
+
+// Entry is a cached response
+type Entry struct {
+	Body        []byte
+	ContentType string
+	ETag        string
+	expiresAt   time.Time
+}
+
+// Generation identifies the state of the data of a profile, a response computed in one generation
+// is not stored after the data has been written
+type Generation struct {
+	all     uint64
+	profile uint64
+}
+
+// Cache is a thread-safe cache of responses, grouped by the cv profile they belong to
+type Cache struct {
+	mu  sync.Mutex
+	ttl time.Duration
+	now func() time.Time
+
+	entries map[int32]map[string]Entry
+	size    int
+	// generations are incremented on every invalidation, all for the whole cache and the map for single profiles
+	all         uint64
+	generations map[int32]uint64
+}
+
+// New creates a cache whose entries expire after ttl, so writes made by other processes (e.g. the seed
+// command) show up eventually. The cache is disabled when ttl is not positive.
+func New(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:         ttl,
+		now:         time.Now,
+		entries:     make(map[int32]map[string]Entry),
+		generations: make(map[int32]uint64),
+	}
+}
+
+// ETag returns a strong entity tag of the body
+func ETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// Get returns the entry of the profile stored under key
+func (c *Cache) Get(profileID int32, key string) (Entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[profileID][key]
+	if !ok {
+		return Entry{}, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		c.delete(profileID, key)
+		return Entry{}, false
+	}
+	return entry, true
+}
+
+// Generation returns the current generation of the profile, it has to be read before the data of the response
+func (c *Cache) Generation(profileID int32) Generation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return Generation{all: c.all, profile: c.generations[profileID]}
+}
+
+// Set stores the entry of the profile under key, unless the data of the profile has been written
+// since generation was read - the entry could already be stale
+func (c *Cache) Set(profileID int32, key string, generation Generation, entry Entry) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != (Generation{all: c.all, profile: c.generations[profileID]}) {
+		return
+	}
+
+	if _, ok := c.entries[profileID][key]; !ok {
+		if c.size >= maxEntries {
+			c.evict()
+		}
+		if c.entries[profileID] == nil {
+			c.entries[profileID] = make(map[string]Entry)
+		}
+		c.size++
+	}
+
+	entry.expiresAt = c.now().Add(c.ttl)
+	c.entries[profileID][key] = entry
+}
+
+// Invalidate drops all entries of the profile
+func (c *Cache) Invalidate(profileID int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[profileID]++
+	c.size -= len(c.entries[profileID])
+	delete(c.entries, profileID)
+}
+
+// InvalidateAll drops all entries, it is used for writes to data shared by the profiles
+// and for writes whose profile is not known
+func (c *Cache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.all++
+	c.size = 0
+	c.entries = make(map[int32]map[string]Entry)
+}
+
+// Len returns the number of cached entries
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.size
+}
+
+// evict drops an arbitrary entry, the cache only fills up when clients request many different pages
+func (c *Cache) evict() {
+	for profileID, entries := range c.entries {
+		for key := range entries {
+			c.delete(profileID, key)
+			return
+		}
+	}
+}
+
+func (c *Cache) delete(profileID int32, key string) {
+	delete(c.entries[profileID], key)
+	if len(c.entries[profileID]) == 0 {
+		delete(c.entries, profileID)
+	}
+	c.size--
+}
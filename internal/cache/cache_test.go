@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func newTestEntry(body string) Entry {
+	return Entry{Body: []byte(body), ContentType: "application/json", ETag: ETag([]byte(body))}
+}
+
+func TestCache_SetGet(t *testing.T) {
+	c := New(time.Minute)
+	entry := newTestEntry(`{"id":1}`)
+
+	_, ok := c.Get(1, "/skills/1")
+	require.False(t, ok)
+
+	c.Set(1, "/skills/1", c.Generation(1), entry)
+	cached, ok := c.Get(1, "/skills/1")
+	require.True(t, ok)
+	require.Equal(t, entry.Body, cached.Body)
+	require.Equal(t, entry.ETag, cached.ETag)
+
+	// entries of other profiles and keys are separate
+	_, ok = c.Get(2, "/skills/1")
+	require.False(t, ok)
+	_, ok = c.Get(1, "/projects/1")
+	require.False(t, ok)
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	c := New(time.Minute)
+	c.Set(1, "a", c.Generation(1), newTestEntry("1"))
+	c.Set(2, "a", c.Generation(2), newTestEntry("2"))
+
+	c.Invalidate(1)
+	_, ok := c.Get(1, "a")
+	require.False(t, ok)
+	_, ok = c.Get(2, "a")
+	require.True(t, ok)
+	require.Equal(t, 1, c.Len())
+
+	c.InvalidateAll()
+	_, ok = c.Get(2, "a")
+	require.False(t, ok)
+	require.Zero(t, c.Len())
+}
+
+func TestCache_StaleGeneration(t *testing.T) {
+	c := New(time.Minute)
+
+	// the data was written while the response was computed
+	generation := c.Generation(1)
+	c.Invalidate(1)
+	c.Set(1, "a", generation, newTestEntry("1"))
+	_, ok := c.Get(1, "a")
+	require.False(t, ok)
+
+	generation = c.Generation(1)
+	c.InvalidateAll()
+	c.Set(1, "a", generation, newTestEntry("1"))
+	_, ok = c.Get(1, "a")
+	require.False(t, ok)
+
+	// writes to other profiles do not matter
+	generation = c.Generation(1)
+	c.Invalidate(2)
+	c.Set(1, "a", generation, newTestEntry("1"))
+	_, ok = c.Get(1, "a")
+	require.True(t, ok)
+}
+
+func TestCache_Expiry(t *testing.T) {
+	now := time.Now()
+	c := New(time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Set(1, "a", c.Generation(1), newTestEntry("1"))
+	now = now.Add(59 * time.Second)
+	_, ok := c.Get(1, "a")
+	require.True(t, ok)
+
+	now = now.Add(time.Second)
+	_, ok = c.Get(1, "a")
+	require.False(t, ok)
+	require.Zero(t, c.Len())
+}
+
+func TestCache_Disabled(t *testing.T) {
+	c := New(0)
+	c.Set(1, "a", c.Generation(1), newTestEntry("1"))
+	_, ok := c.Get(1, "a")
+	require.False(t, ok)
+}
+
+func TestCache_MaxEntries(t *testing.T) {
+	c := New(time.Minute)
+	for i := 0; i < maxEntries+10; i++ {
+		c.Set(int32(i%3), fmt.Sprintf("/projects/1?page=%d", i), c.Generation(int32(i%3)), newTestEntry("1"))
+	}
+	require.Equal(t, maxEntries, c.Len())
+
+	// replacing an entry does not evict another one
+	c.Set(0, "/projects/1?page=0", c.Generation(0), newTestEntry("2"))
+	c.Set(0, "/projects/1?page=0", c.Generation(0), newTestEntry("3"))
+	require.Equal(t, maxEntries, c.Len())
+}
+
+func TestETag(t *testing.T) {
+	etag := ETag([]byte(`{"id":1}`))
+	require.Regexp(t, `^"[0-9a-f]{32}"$`, etag)
+	require.Equal(t, etag, ETag([]byte(`{"id":1}`)))
+	require.NotEqual(t, etag, ETag([]byte(`{"id":2}`)))
+}
@@ -0,0 +1,318 @@
+package cache
+
+import (
+	"context"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+)
+
+// Store invalidates the cache after every successful write to a db.Store. The entries of a single profile
+// are dropped when the profile is known from the arguments or the returned row, the whole cache otherwise -
+// e.g. for technologies, which are shared by all profiles, and for links that are written by their IDs.
+type Store struct {
+	db.Store
+	cache *Cache
+}
+
+var _ db.Store = (*Store)(nil)
+
+// NewStore wraps store so that its writes invalidate cache
+func NewStore(store db.Store, cache *Cache) *Store {
+	return &Store{Store: store, cache: cache}
+}
+
+// invalidate drops the entries of the profile when the write succeeded
+func (s *Store) invalidate(profileID int32, err error) {
+	if err == nil {
+		s.cache.Invalidate(profileID)
+	}
+}
+
+// invalidateAll drops all entries when the write succeeded
+func (s *Store) invalidateAll(err error) {
+	if err == nil {
+		s.cache.InvalidateAll()
+	}
+}
+
+func (s *Store) CreateCvEducation(ctx context.Context, arg db.CreateCvEducationParams) (db.CvEducation, error) {
+	education, err := s.Store.CreateCvEducation(ctx, arg)
+	s.invalidate(arg.CvProfileID, err)
+	return education, err
+}
+
+func (s *Store) UpdateCvEducation(ctx context.Context, arg db.UpdateCvEducationParams) (db.CvEducation, error) {
+	education, err := s.Store.UpdateCvEducation(ctx, arg)
+	s.invalidate(education.CvProfileID, err)
+	return education, err
+}
+
+func (s *Store) DeleteCvEducationsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	err := s.Store.DeleteCvEducationsByCvProfile(ctx, cvProfileID)
+	s.invalidate(cvProfileID, err)
+	return err
+}
+
+func (s *Store) CreateCvExperience(ctx context.Context, arg db.CreateCvExperienceParams) (db.CvExperience, error) {
+	experience, err := s.Store.CreateCvExperience(ctx, arg)
+	s.invalidate(arg.CvProfileID, err)
+	return experience, err
+}
+
+func (s *Store) CreateCvExperienceTx(ctx context.Context, arg db.CreateCvExperienceTxParams) (db.ListCvExperiencesWithDetailsRow, error) {
+	experience, err := s.Store.CreateCvExperienceTx(ctx, arg)
+	s.invalidate(arg.CvProfileID, err)
+	return experience, err
+}
+
+func (s *Store) UpdateCvExperience(ctx context.Context, arg db.UpdateCvExperienceParams) (db.CvExperience, error) {
+	experience, err := s.Store.UpdateCvExperience(ctx, arg)
+	s.invalidate(experience.CvProfileID, err)
+	return experience, err
+}
+
+func (s *Store) DeleteCvExperiencesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	err := s.Store.DeleteCvExperiencesByCvProfile(ctx, cvProfileID)
+	s.invalidate(cvProfileID, err)
+	return err
+}
+
+func (s *Store) CreateCvExperienceSkill(ctx context.Context, arg db.CreateCvExperienceSkillParams) (db.CvExperienceSkill, error) {
+	link, err := s.Store.CreateCvExperienceSkill(ctx, arg)
+	s.invalidateAll(err)
+	return link, err
+}
+
+func (s *Store) DeleteCvExperienceSkills(ctx context.Context, cvExperienceID int32) error {
+	err := s.Store.DeleteCvExperienceSkills(ctx, cvExperienceID)
+	s.invalidateAll(err)
+	return err
+}
+
+func (s *Store) DeleteCvExperienceSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	err := s.Store.DeleteCvExperienceSkillsByCvProfile(ctx, cvProfileID)
+	s.invalidate(cvProfileID, err)
+	return err
+}
+
+func (s *Store) CreateCvExperienceTechnology(ctx context.Context, arg db.CreateCvExperienceTechnologyParams) (db.CvExperienceTechnology, error) {
+	link, err := s.Store.CreateCvExperienceTechnology(ctx, arg)
+	s.invalidateAll(err)
+	return link, err
+}
+
+func (s *Store) DeleteCvExperienceTechnologies(ctx context.Context, cvExperienceID int32) error {
+	err := s.Store.DeleteCvExperienceTechnologies(ctx, cvExperienceID)
+	s.invalidateAll(err)
+	return err
+}
+
+func (s *Store) DeleteCvExperienceTechnologiesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	err := s.Store.DeleteCvExperienceTechnologiesByCvProfile(ctx, cvProfileID)
+	s.invalidate(cvProfileID, err)
+	return err
+}
+
+func (s *Store) CreateCvProfile(ctx context.Context, arg db.CreateCvProfileParams) (db.CvProfile, error) {
+	cvProfile, err := s.Store.CreateCvProfile(ctx, arg)
+	s.invalidate(cvProfile.ID, err)
+	return cvProfile, err
+}
+
+func (s *Store) UpdateCvProfile(ctx context.Context, arg db.UpdateCvProfileParams) (db.CvProfile, error) {
+	cvProfile, err := s.Store.UpdateCvProfile(ctx, arg)
+	s.invalidate(arg.ID, err)
+	return cvProfile, err
+}
+
+func (s *Store) DeleteCvProfile(ctx context.Context, id int32) (db.CvProfile, error) {
+	cvProfile, err := s.Store.DeleteCvProfile(ctx, id)
+	s.invalidate(id, err)
+	return cvProfile, err
+}
+
+func (s *Store) DeleteCvProfileTx(ctx context.Context, cvProfileID int32) error {
+	err := s.Store.DeleteCvProfileTx(ctx, cvProfileID)
+	s.invalidate(cvProfileID, err)
+	return err
+}
+
+func (s *Store) ImportCvProfileTx(ctx context.Context, arg db.ImportCvProfileTxParams) (db.CvProfile, error) {
+	cvProfile, err := s.Store.ImportCvProfileTx(ctx, arg)
+	s.invalidate(cvProfile.ID, err)
+	return cvProfile, err
+}
+
+// SeedCvProfileTx can update existing technologies, so it invalidates the whole cache
+func (s *Store) SeedCvProfileTx(ctx context.Context, arg db.SeedCvProfileTxParams) (db.CvProfile, error) {
+	cvProfile, err := s.Store.SeedCvProfileTx(ctx, arg)
+	s.invalidateAll(err)
+	return cvProfile, err
+}
+
+func (s *Store) CreateProject(ctx context.Context, arg db.CreateProjectParams) (db.Project, error) {
+	project, err := s.Store.CreateProject(ctx, arg)
+	s.invalidate(arg.CvProfileID, err)
+	return project, err
+}
+
+func (s *Store) CreateProjectTx(ctx context.Context, arg db.CreateProjectTxParams) (db.ListProjectsWithTechnologiesRow, error) {
+	project, err := s.Store.CreateProjectTx(ctx, arg)
+	s.invalidate(arg.CvProfileID, err)
+	return project, err
+}
+
+func (s *Store) UpdateProject(ctx context.Context, arg db.UpdateProjectParams) (db.Project, error) {
+	project, err := s.Store.UpdateProject(ctx, arg)
+	s.invalidate(project.CvProfileID, err)
+	return project, err
+}
+
+func (s *Store) UpdateProjectTx(ctx context.Context, arg db.UpdateProjectTxParams) (db.ListProjectsWithTechnologiesRow, error) {
+	project, err := s.Store.UpdateProjectTx(ctx, arg)
+	s.invalidateAll(err)
+	return project, err
+}
+
+func (s *Store) PatchProject(ctx context.Context, arg db.PatchProjectParams) (db.Project, error) {
+	project, err := s.Store.PatchProject(ctx, arg)
+	s.invalidate(project.CvProfileID, err)
+	return project, err
+}
+
+func (s *Store) PatchProjectTx(ctx context.Context, arg db.PatchProjectTxParams) (db.ListProjectsWithTechnologiesRow, error) {
+	project, err := s.Store.PatchProjectTx(ctx, arg)
+	s.invalidateAll(err)
+	return project, err
+}
+
+func (s *Store) DeleteProject(ctx context.Context, id int32) (db.Project, error) {
+	project, err := s.Store.DeleteProject(ctx, id)
+	s.invalidate(project.CvProfileID, err)
+	return project, err
+}
+
+func (s *Store) DeleteProjectTx(ctx context.Context, projectID int32) error {
+	err := s.Store.DeleteProjectTx(ctx, projectID)
+	s.invalidateAll(err)
+	return err
+}
+
+func (s *Store) DeleteProjectsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	err := s.Store.DeleteProjectsByCvProfile(ctx, cvProfileID)
+	s.invalidate(cvProfileID, err)
+	return err
+}
+
+func (s *Store) ReorderProjects(ctx context.Context, arg db.ReorderProjectsParams) error {
+	err := s.Store.ReorderProjects(ctx, arg)
+	s.invalidate(arg.CvProfileID, err)
+	return err
+}
+
+func (s *Store) ReorderProjectsTx(ctx context.Context, arg db.ReorderProjectsTxParams) ([]db.ListProjectsWithTechnologiesRow, error) {
+	projects, err := s.Store.ReorderProjectsTx(ctx, arg)
+	s.invalidate(arg.CvProfileID, err)
+	return projects, err
+}
+
+func (s *Store) CreateProjectSkill(ctx context.Context, arg db.CreateProjectSkillParams) (db.ProjectSkill, error) {
+	link, err := s.Store.CreateProjectSkill(ctx, arg)
+	s.invalidateAll(err)
+	return link, err
+}
+
+func (s *Store) ReplaceProjectSkillsTx(ctx context.Context, arg db.ReplaceProjectSkillsTxParams) ([]db.ProjectSkill, error) {
+	links, err := s.Store.ReplaceProjectSkillsTx(ctx, arg)
+	s.invalidateAll(err)
+	return links, err
+}
+
+func (s *Store) DeleteProjectSkills(ctx context.Context, projectID int32) error {
+	err := s.Store.DeleteProjectSkills(ctx, projectID)
+	s.invalidateAll(err)
+	return err
+}
+
+func (s *Store) DeleteProjectSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	err := s.Store.DeleteProjectSkillsByCvProfile(ctx, cvProfileID)
+	s.invalidate(cvProfileID, err)
+	return err
+}
+
+func (s *Store) CreateProjectTechnology(ctx context.Context, arg db.CreateProjectTechnologyParams) (db.ProjectTechnology, error) {
+	link, err := s.Store.CreateProjectTechnology(ctx, arg)
+	s.invalidateAll(err)
+	return link, err
+}
+
+func (s *Store) ReplaceProjectTechnologiesTx(ctx context.Context, arg db.ReplaceProjectTechnologiesTxParams) ([]db.ListTechnologiesForProjectRow, error) {
+	technologies, err := s.Store.ReplaceProjectTechnologiesTx(ctx, arg)
+	s.invalidateAll(err)
+	return technologies, err
+}
+
+func (s *Store) DeleteProjectTechnologies(ctx context.Context, projectID int32) error {
+	err := s.Store.DeleteProjectTechnologies(ctx, projectID)
+	s.invalidateAll(err)
+	return err
+}
+
+func (s *Store) DeleteProjectTechnologiesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	err := s.Store.DeleteProjectTechnologiesByCvProfile(ctx, cvProfileID)
+	s.invalidate(cvProfileID, err)
+	return err
+}
+
+func (s *Store) CreateSkill(ctx context.Context, arg db.CreateSkillParams) (db.Skill, error) {
+	skill, err := s.Store.CreateSkill(ctx, arg)
+	s.invalidate(arg.CvProfileID, err)
+	return skill, err
+}
+
+func (s *Store) UpdateSkill(ctx context.Context, arg db.UpdateSkillParams) (db.Skill, error) {
+	skill, err := s.Store.UpdateSkill(ctx, arg)
+	s.invalidate(skill.CvProfileID, err)
+	return skill, err
+}
+
+func (s *Store) ReleaseSkillImportance(ctx context.Context, id int32) error {
+	err := s.Store.ReleaseSkillImportance(ctx, id)
+	s.invalidateAll(err)
+	return err
+}
+
+func (s *Store) ReorderSkills(ctx context.Context, arg db.ReorderSkillsParams) error {
+	err := s.Store.ReorderSkills(ctx, arg)
+	s.invalidate(arg.CvProfileID, err)
+	return err
+}
+
+func (s *Store) ReorderSkillsTx(ctx context.Context, arg db.ReorderSkillsTxParams) ([]db.Skill, error) {
+	skills, err := s.Store.ReorderSkillsTx(ctx, arg)
+	s.invalidate(arg.CvProfileID, err)
+	return skills, err
+}
+
+func (s *Store) DeleteSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	err := s.Store.DeleteSkillsByCvProfile(ctx, cvProfileID)
+	s.invalidate(cvProfileID, err)
+	return err
+}
+
+func (s *Store) UpsertSkillCategory(ctx context.Context, arg db.UpsertSkillCategoryParams) (db.SkillCategory, error) {
+	category, err := s.Store.UpsertSkillCategory(ctx, arg)
+	s.invalidate(arg.CvProfileID, err)
+	return category, err
+}
+
+func (s *Store) CreateTechnology(ctx context.Context, arg db.CreateTechnologyParams) (db.Technology, error) {
+	technology, err := s.Store.CreateTechnology(ctx, arg)
+	s.invalidateAll(err)
+	return technology, err
+}
+
+func (s *Store) UpdateTechnology(ctx context.Context, arg db.UpdateTechnologyParams) (db.Technology, error) {
+	technology, err := s.Store.UpdateTechnology(ctx, arg)
+	s.invalidateAll(err)
+	return technology, err
+}
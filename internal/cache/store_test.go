@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+	memdb "github.com/aalug/cv-backend-go/internal/db/memory"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestStore_WritesInvalidate makes sure that new write methods of db.Store are not forgotten,
+// a method that is only promoted from the embedded store would never invalidate the cache
+func TestStore_WritesInvalidate(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "store.go", nil, 0)
+	require.NoError(t, err)
+
+	declared := map[string]bool{}
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil {
+			declared[fn.Name.Name] = true
+		}
+	}
+
+	storeType := reflect.TypeOf((*db.Store)(nil)).Elem()
+	for i := 0; i < storeType.NumMethod(); i++ {
+		name := storeType.Method(i).Name
+		// users are not part of any cv profile
+		if name == "CreateUser" {
+			continue
+		}
+		for _, prefix := range []string{"Create", "Update", "Patch", "Upsert", "Delete", "Replace", "Release", "Reorder", "Import", "Seed"} {
+			if strings.HasPrefix(name, prefix) {
+				require.True(t, declared[name], "%s does not invalidate the cache", name)
+			}
+		}
+	}
+}
+
+func TestStore_Invalidation(t *testing.T) {
+	c := New(time.Minute)
+	store := NewStore(memdb.NewStore(), c)
+	ctx := context.Background()
+
+	cvProfile1, err := store.CreateCvProfile(ctx, db.CreateCvProfileParams{Name: utils.RandomString(6), Email: utils.RandomEmail()})
+	require.NoError(t, err)
+	cvProfile2, err := store.CreateCvProfile(ctx, db.CreateCvProfileParams{Name: utils.RandomString(6), Email: utils.RandomEmail()})
+	require.NoError(t, err)
+
+	fill := func() {
+		c.Set(cvProfile1.ID, "a", c.Generation(cvProfile1.ID), newTestEntry("1"))
+		c.Set(cvProfile2.ID, "a", c.Generation(cvProfile2.ID), newTestEntry("2"))
+	}
+	cached := func(profileID int32) bool {
+		_, ok := c.Get(profileID, "a")
+		return ok
+	}
+
+	// writes of a profile only drop its entries
+	fill()
+	_, err = store.CreateSkill(ctx, db.CreateSkillParams{Name: utils.RandomString(8), CvProfileID: cvProfile1.ID})
+	require.NoError(t, err)
+	require.False(t, cached(cvProfile1.ID))
+	require.True(t, cached(cvProfile2.ID))
+
+	// failed writes change nothing
+	fill()
+	_, err = store.CreateProject(ctx, db.CreateProjectParams{Title: utils.RandomString(8), CvProfileID: -1})
+	require.Error(t, err)
+	require.True(t, cached(cvProfile1.ID))
+	require.True(t, cached(cvProfile2.ID))
+
+	// technologies are shared by all profiles
+	fill()
+	_, err = store.CreateTechnology(ctx, db.CreateTechnologyParams{Name: utils.RandomString(8)})
+	require.NoError(t, err)
+	require.False(t, cached(cvProfile1.ID))
+	require.False(t, cached(cvProfile2.ID))
+
+	// the profile of a project written by its ID is not known
+	project, err := store.CreateProjectTx(ctx, db.CreateProjectTxParams{
+		CreateProjectParams: db.CreateProjectParams{Title: utils.RandomString(8), CvProfileID: cvProfile2.ID},
+	})
+	require.NoError(t, err)
+	fill()
+	require.NoError(t, store.DeleteProjectTx(ctx, project.ID))
+	require.False(t, cached(cvProfile1.ID))
+	require.False(t, cached(cvProfile2.ID))
+}
@@ -1,8 +1,68 @@
 package config
 
+import "time"
+
+// store backends, memory keeps all data in the process and is meant for local development and demos
+const (
+	StoreBackendPostgres = "postgres"
+	StoreBackendMemory   = "memory"
+)
+
+// database drivers of the postgres store backend, sqlite is meant for small single-server deployments
+const (
+	DBDriverPostgres = "postgres"
+	DBDriverSQLite   = "sqlite"
+)
+
 // Config stores configuration of the application
 type Config struct {
-	DBDriver      string `mapstructure:"DB_DRIVER"`
-	DBSource      string `mapstructure:"DB_SOURCE"`
-	ServerAddress string `mapstructure:"SERVER_ADDRESS"`
+	StoreBackend         string        `mapstructure:"STORE_BACKEND"`
+	SeedFile             string        `mapstructure:"SEED_FILE"`
+	DBDriver             string        `mapstructure:"DB_DRIVER"`
+	DBSource             string        `mapstructure:"DB_SOURCE"`
+	DBMaxOpenConns       int           `mapstructure:"DB_MAX_OPEN_CONNS"`
+	DBMaxIdleConns       int           `mapstructure:"DB_MAX_IDLE_CONNS"`
+	DBConnMaxLifetime    time.Duration `mapstructure:"DB_CONN_MAX_LIFETIME"`
+	DBConnMaxIdleTime    time.Duration `mapstructure:"DB_CONN_MAX_IDLE_TIME"`
+	AutoMigrate          bool          `mapstructure:"AUTO_MIGRATE"`
+	ServerAddress        string        `mapstructure:"SERVER_ADDRESS"`
+	ShutdownTimeout      time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
+	MetricsAddress       string        `mapstructure:"METRICS_ADDRESS"`
+	CacheMaxAge          time.Duration `mapstructure:"CACHE_MAX_AGE"`
+	CacheTTL             time.Duration `mapstructure:"CACHE_TTL"`
+	RateLimitRead        string        `mapstructure:"RATE_LIMIT_READ"`
+	RateLimitWrite       string        `mapstructure:"RATE_LIMIT_WRITE"`
+	TrustedProxies       []string      `mapstructure:"TRUSTED_PROXIES"`
+	CORSAllowedOrigins   []string      `mapstructure:"CORS_ALLOWED_ORIGINS"`
+	CORSAdminOrigins     []string      `mapstructure:"CORS_ADMIN_ORIGINS"`
+	CORSAllowedMethods   []string      `mapstructure:"CORS_ALLOWED_METHODS"`
+	CORSAllowedHeaders   []string      `mapstructure:"CORS_ALLOWED_HEADERS"`
+	CORSAllowCredentials bool          `mapstructure:"CORS_ALLOW_CREDENTIALS"`
+	CORSMaxAge           time.Duration `mapstructure:"CORS_MAX_AGE"`
+	LogLevel             string        `mapstructure:"LOG_LEVEL"`
+	TokenType            string        `mapstructure:"TOKEN_TYPE"`
+	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+}
+
+// defaults are used for the optional settings that are not set in the env file or the environment
+var defaults = map[string]string{
+	"STORE_BACKEND":          StoreBackendPostgres,
+	"DB_MAX_OPEN_CONNS":      "25",
+	"DB_MAX_IDLE_CONNS":      "25",
+	"DB_CONN_MAX_LIFETIME":   "30m",
+	"DB_CONN_MAX_IDLE_TIME":  "5m",
+	"AUTO_MIGRATE":           "false",
+	"SHUTDOWN_TIMEOUT":       "15s",
+	"CACHE_MAX_AGE":          "1m",
+	"CACHE_TTL":              "10m",
+	"RATE_LIMIT_READ":        "300/1m",
+	"RATE_LIMIT_WRITE":       "10/1m",
+	"CORS_ALLOWED_ORIGINS":   "*",
+	"CORS_ALLOWED_METHODS":   "GET,POST,PUT,PATCH,DELETE",
+	"CORS_ALLOWED_HEADERS":   "Origin,Content-Type,Accept,Authorization,If-None-Match,X-Request-ID",
+	"CORS_ALLOW_CREDENTIALS": "false",
+	"CORS_MAX_AGE":           "12h",
+	"LOG_LEVEL":              "info",
+	"ACCESS_TOKEN_DURATION":  "15m",
 }
@@ -2,8 +2,12 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"github.com/spf13/viper"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func LoadConfigFromFile(path string) (cfg Config, err error) {
@@ -11,6 +15,10 @@ func LoadConfigFromFile(path string) (cfg Config, err error) {
 	viper.SetConfigName("app")
 	viper.SetConfigType("env")
 
+	for key, value := range defaults {
+		viper.SetDefault(key, value)
+	}
+
 	viper.AutomaticEnv()
 
 	err = viper.ReadInConfig()
@@ -19,6 +27,11 @@ func LoadConfigFromFile(path string) (cfg Config, err error) {
 	}
 
 	err = viper.Unmarshal(&cfg)
+	if err != nil {
+		return
+	}
+
+	err = validateAccessTokenDuration(cfg.AccessTokenDuration)
 	return
 }
 
@@ -27,13 +40,123 @@ func LoadConfigFromEnv() (cfg Config, err error) {
 	serverAddress := os.Getenv("SERVER_ADDRESS")
 	dbSource := os.Getenv("DB_SOURCE")
 	dbDriver := os.Getenv("DB_DRIVER")
+	tokenSymmetricKey := os.Getenv("TOKEN_SYMMETRIC_KEY")
 
-	if serverAddress == "" || dbSource == "" || dbDriver == "" {
+	storeBackend := envOrDefault("STORE_BACKEND")
+
+	// the db settings are not needed when the data is kept in memory
+	needsDB := storeBackend != StoreBackendMemory
+	if serverAddress == "" || (needsDB && (dbSource == "" || dbDriver == "")) || tokenSymmetricKey == "" {
 		return Config{}, errors.New("missing required environment variable")
 	}
 
+	if cfg.AccessTokenDuration, err = durationFromEnv("ACCESS_TOKEN_DURATION"); err != nil {
+		return Config{}, err
+	}
+	if err = validateAccessTokenDuration(cfg.AccessTokenDuration); err != nil {
+		return Config{}, err
+	}
+
+	if cfg.DBMaxOpenConns, err = intFromEnv("DB_MAX_OPEN_CONNS"); err != nil {
+		return Config{}, err
+	}
+	if cfg.DBMaxIdleConns, err = intFromEnv("DB_MAX_IDLE_CONNS"); err != nil {
+		return Config{}, err
+	}
+	if cfg.DBConnMaxLifetime, err = durationFromEnv("DB_CONN_MAX_LIFETIME"); err != nil {
+		return Config{}, err
+	}
+	if cfg.DBConnMaxIdleTime, err = durationFromEnv("DB_CONN_MAX_IDLE_TIME"); err != nil {
+		return Config{}, err
+	}
+	if cfg.ShutdownTimeout, err = durationFromEnv("SHUTDOWN_TIMEOUT"); err != nil {
+		return Config{}, err
+	}
+	if cfg.AutoMigrate, err = boolFromEnv("AUTO_MIGRATE"); err != nil {
+		return Config{}, err
+	}
+	if cfg.CacheMaxAge, err = durationFromEnv("CACHE_MAX_AGE"); err != nil {
+		return Config{}, err
+	}
+	if cfg.CacheTTL, err = durationFromEnv("CACHE_TTL"); err != nil {
+		return Config{}, err
+	}
+	if cfg.CORSAllowCredentials, err = boolFromEnv("CORS_ALLOW_CREDENTIALS"); err != nil {
+		return Config{}, err
+	}
+	if cfg.CORSMaxAge, err = durationFromEnv("CORS_MAX_AGE"); err != nil {
+		return Config{}, err
+	}
+
+	cfg.StoreBackend = storeBackend
+	cfg.SeedFile = os.Getenv("SEED_FILE")
 	cfg.ServerAddress = serverAddress
+	cfg.MetricsAddress = os.Getenv("METRICS_ADDRESS")
+	cfg.RateLimitRead = envOrDefault("RATE_LIMIT_READ")
+	cfg.RateLimitWrite = envOrDefault("RATE_LIMIT_WRITE")
+	cfg.TrustedProxies = listFromEnv("TRUSTED_PROXIES")
+	cfg.CORSAllowedOrigins = listFromEnv("CORS_ALLOWED_ORIGINS")
+	cfg.CORSAdminOrigins = listFromEnv("CORS_ADMIN_ORIGINS")
+	cfg.CORSAllowedMethods = listFromEnv("CORS_ALLOWED_METHODS")
+	cfg.CORSAllowedHeaders = listFromEnv("CORS_ALLOWED_HEADERS")
+	cfg.LogLevel = envOrDefault("LOG_LEVEL")
 	cfg.DBSource = dbSource
 	cfg.DBDriver = dbDriver
+	cfg.TokenType = os.Getenv("TOKEN_TYPE")
+	cfg.TokenSymmetricKey = tokenSymmetricKey
 	return cfg, nil
 }
+
+// validateAccessTokenDuration rejects durations that would make every new token already expired
+func validateAccessTokenDuration(duration time.Duration) error {
+	if duration <= 0 {
+		return fmt.Errorf("invalid ACCESS_TOKEN_DURATION: %s, must be positive", duration)
+	}
+	return nil
+}
+
+// envOrDefault returns the environment variable or its default value when it is not set
+func envOrDefault(key string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaults[key]
+}
+
+// intFromEnv parses an optional integer environment variable
+func intFromEnv(key string) (int, error) {
+	value, err := strconv.Atoi(envOrDefault(key))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return value, nil
+}
+
+// durationFromEnv parses an optional duration environment variable
+func durationFromEnv(key string) (time.Duration, error) {
+	value, err := time.ParseDuration(envOrDefault(key))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return value, nil
+}
+
+// listFromEnv splits an optional comma-separated environment variable
+func listFromEnv(key string) []string {
+	var values []string
+	for _, value := range strings.Split(envOrDefault(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// boolFromEnv parses an optional boolean environment variable
+func boolFromEnv(key string) (bool, error) {
+	value, err := strconv.ParseBool(envOrDefault(key))
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,106 @@
+package memdb
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"sort"
+	"time"
+)
+
+func (t *tables) CreateCvEducation(ctx context.Context, arg db.CreateCvEducationParams) (db.CvEducation, error) {
+	if err := t.checkCvProfile("cv_educations", arg.CvProfileID); err != nil {
+		return db.CvEducation{}, err
+	}
+	if arg.EndDate != nil && date(*arg.EndDate).Before(date(arg.StartDate)) {
+		return db.CvEducation{}, checkViolation("cv_educations", "cv_educations_dates_check")
+	}
+
+	education := db.CvEducation{
+		ID:          t.nextID("cv_educations"),
+		Institution: arg.Institution,
+		Degree:      arg.Degree,
+		StartDate:   date(arg.StartDate),
+		EndDate:     datePointer(arg.EndDate),
+		CvProfileID: arg.CvProfileID,
+	}
+	t.cvEducations[education.ID] = education
+	return education, nil
+}
+
+func (t *tables) GetCvEducation(ctx context.Context, id int32) (db.CvEducation, error) {
+	education, ok := t.cvEducations[id]
+	if !ok {
+		return db.CvEducation{}, sql.ErrNoRows
+	}
+	return education, nil
+}
+
+func (t *tables) GetCvEducationByInstitution(ctx context.Context, arg db.GetCvEducationByInstitutionParams) (db.CvEducation, error) {
+	items := t.listCvEducations(arg.CvProfileID, func(education db.CvEducation) bool {
+		return education.Institution == arg.Institution && education.Degree == arg.Degree
+	})
+	if len(items) == 0 {
+		return db.CvEducation{}, sql.ErrNoRows
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	return items[0], nil
+}
+
+func (t *tables) ListCvEducations(ctx context.Context, arg db.ListCvEducationsParams) ([]db.CvEducation, error) {
+	items := t.listCvEducations(arg.CvProfileID, nil)
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].StartDate.Equal(items[j].StartDate) {
+			return items[i].StartDate.Before(items[j].StartDate)
+		}
+		return items[i].ID < items[j].ID
+	})
+	return page(items, arg.Limit, arg.Offset), nil
+}
+
+func (t *tables) UpdateCvEducation(ctx context.Context, arg db.UpdateCvEducationParams) (db.CvEducation, error) {
+	education, ok := t.cvEducations[arg.ID]
+	if !ok {
+		return db.CvEducation{}, sql.ErrNoRows
+	}
+
+	education.Institution = arg.Institution
+	education.Degree = arg.Degree
+	education.StartDate = date(arg.StartDate)
+	education.EndDate = datePointer(arg.EndDate)
+	if education.EndDate != nil && education.EndDate.Before(education.StartDate) {
+		return db.CvEducation{}, checkViolation("cv_educations", "cv_educations_dates_check")
+	}
+	t.cvEducations[education.ID] = education
+	return education, nil
+}
+
+func (t *tables) DeleteCvEducationsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	for id, education := range t.cvEducations {
+		if education.CvProfileID == cvProfileID {
+			delete(t.cvEducations, id)
+		}
+	}
+	return nil
+}
+
+// listCvEducations returns the educations of the profile for which match returns true, a nil match returns all
+func (t *tables) listCvEducations(cvProfileID int32, match func(education db.CvEducation) bool) []db.CvEducation {
+	items := []db.CvEducation{}
+	for _, education := range t.cvEducations {
+		if education.CvProfileID == cvProfileID && (match == nil || match(education)) {
+			items = append(items, education)
+		}
+	}
+	return items
+}
+
+// datePointer is date for a nullable DATE column, nil stays NULL
+func datePointer(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	d := date(*t)
+	return &d
+}
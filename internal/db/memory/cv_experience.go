@@ -0,0 +1,300 @@
+package memdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"sort"
+)
+
+func (t *tables) CreateCvExperience(ctx context.Context, arg db.CreateCvExperienceParams) (db.CvExperience, error) {
+	if err := t.checkCvProfile("cv_experiences", arg.CvProfileID); err != nil {
+		return db.CvExperience{}, err
+	}
+
+	experience := db.CvExperience{
+		ID:             t.nextID("cv_experiences"),
+		Company:        arg.Company,
+		Position:       arg.Position,
+		Location:       arg.Location,
+		EmploymentType: arg.EmploymentType,
+		StartDate:      date(arg.StartDate),
+		EndDate:        nullDate(arg.EndDate),
+		Achievements:   achievements(arg.Achievements),
+		CvProfileID:    arg.CvProfileID,
+	}
+	if err := checkCvExperienceDates(experience); err != nil {
+		return db.CvExperience{}, err
+	}
+	t.cvExperiences[experience.ID] = experience
+	return copyCvExperience(experience), nil
+}
+
+func (t *tables) GetCvExperience(ctx context.Context, id int32) (db.CvExperience, error) {
+	experience, ok := t.cvExperiences[id]
+	if !ok {
+		return db.CvExperience{}, sql.ErrNoRows
+	}
+	return copyCvExperience(experience), nil
+}
+
+func (t *tables) GetCvExperienceByCompany(ctx context.Context, arg db.GetCvExperienceByCompanyParams) (db.CvExperience, error) {
+	startDate := date(arg.StartDate)
+	items := t.listCvExperiences(arg.CvProfileID, func(experience db.CvExperience) bool {
+		return experience.Company == arg.Company && experience.Position == arg.Position && experience.StartDate.Equal(startDate)
+	})
+	if len(items) == 0 {
+		return db.CvExperience{}, sql.ErrNoRows
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	return items[0], nil
+}
+
+func (t *tables) ListCvExperiences(ctx context.Context, arg db.ListCvExperiencesParams) ([]db.CvExperience, error) {
+	items := t.listCvExperiences(arg.CvProfileID, nil)
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].StartDate.Equal(items[j].StartDate) {
+			return items[i].StartDate.After(items[j].StartDate)
+		}
+		return items[i].ID < items[j].ID
+	})
+	return page(items, arg.Limit, arg.Offset), nil
+}
+
+func (t *tables) ListCvExperiencesWithJSON(ctx context.Context, arg db.ListCvExperiencesWithJSONParams) ([]db.ListCvExperiencesWithJSONRow, error) {
+	experiences, err := t.ListCvExperiences(ctx, db.ListCvExperiencesParams{
+		CvProfileID: arg.CvProfileID,
+		Limit:       arg.Limit,
+		Offset:      arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := []db.ListCvExperiencesWithJSONRow{}
+	for _, experience := range experiences {
+		skills, err := t.ListSkillsForCvExperience(ctx, experience.ID)
+		if err != nil {
+			return nil, err
+		}
+		skillsJSON, err := json.Marshal(skills)
+		if err != nil {
+			return nil, err
+		}
+
+		technologies, err := t.ListTechnologiesForCvExperience(ctx, experience.ID)
+		if err != nil {
+			return nil, err
+		}
+		technologiesJSON, err := json.Marshal(technologies)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, db.ListCvExperiencesWithJSONRow{
+			ID:               experience.ID,
+			Company:          experience.Company,
+			Position:         experience.Position,
+			Location:         experience.Location,
+			EmploymentType:   experience.EmploymentType,
+			StartDate:        experience.StartDate,
+			EndDate:          experience.EndDate,
+			Achievements:     experience.Achievements,
+			CvProfileID:      experience.CvProfileID,
+			Skills:           skillsJSON,
+			TechnologiesUsed: technologiesJSON,
+		})
+	}
+	return items, nil
+}
+
+func (t *tables) UpdateCvExperience(ctx context.Context, arg db.UpdateCvExperienceParams) (db.CvExperience, error) {
+	experience, ok := t.cvExperiences[arg.ID]
+	if !ok {
+		return db.CvExperience{}, sql.ErrNoRows
+	}
+
+	experience.Company = arg.Company
+	experience.Position = arg.Position
+	experience.Location = arg.Location
+	experience.EmploymentType = arg.EmploymentType
+	experience.StartDate = date(arg.StartDate)
+	experience.EndDate = nullDate(arg.EndDate)
+	experience.Achievements = achievements(arg.Achievements)
+	if err := checkCvExperienceDates(experience); err != nil {
+		return db.CvExperience{}, err
+	}
+	t.cvExperiences[experience.ID] = experience
+	return copyCvExperience(experience), nil
+}
+
+func (t *tables) DeleteCvExperiencesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	for id, experience := range t.cvExperiences {
+		if experience.CvProfileID == cvProfileID {
+			t.deleteCvExperience(id)
+		}
+	}
+	return nil
+}
+
+// deleteCvExperience deletes the experience with its skill and technology links, like the cascades do
+func (t *tables) deleteCvExperience(id int32) {
+	for link := range t.cvExperienceSkills {
+		if link.CvExperienceID == id {
+			delete(t.cvExperienceSkills, link)
+		}
+	}
+	for link := range t.cvExperienceTechnologies {
+		if link.CvExperienceID == id {
+			delete(t.cvExperienceTechnologies, link)
+		}
+	}
+	delete(t.cvExperiences, id)
+}
+
+// checkCvExperienceDates returns the check error when the experience ends before it starts
+func checkCvExperienceDates(experience db.CvExperience) error {
+	if experience.EndDate.Valid && experience.EndDate.Time.Before(experience.StartDate) {
+		return checkViolation("cv_experiences", "cv_experiences_dates_check")
+	}
+	return nil
+}
+
+func (t *tables) CreateCvExperienceSkill(ctx context.Context, arg db.CreateCvExperienceSkillParams) (db.CvExperienceSkill, error) {
+	if _, ok := t.cvExperiences[arg.CvExperienceID]; !ok {
+		return db.CvExperienceSkill{}, foreignKeyViolation("cv_experience_skills", "cv_experience_skills_cv_experience_id_fkey")
+	}
+	if _, ok := t.skills[arg.SkillID]; !ok {
+		return db.CvExperienceSkill{}, foreignKeyViolation("cv_experience_skills", "cv_experience_skills_skill_id_fkey")
+	}
+	if t.cvExperiences[arg.CvExperienceID].CvProfileID != t.skills[arg.SkillID].CvProfileID {
+		return db.CvExperienceSkill{}, foreignKeyViolation("cv_experience_skills", "cv_experience_skills_cv_profile_fkey")
+	}
+
+	link := db.CvExperienceSkill{CvExperienceID: arg.CvExperienceID, SkillID: arg.SkillID}
+	if t.cvExperienceSkills[link] {
+		return db.CvExperienceSkill{}, uniqueViolation("cv_experience_skills", "cv_experience_skills_pkey")
+	}
+	t.cvExperienceSkills[link] = true
+	return link, nil
+}
+
+func (t *tables) ListSkillsForCvExperience(ctx context.Context, cvExperienceID int32) ([]db.ListSkillsForCvExperienceRow, error) {
+	skills := []db.Skill{}
+	for link := range t.cvExperienceSkills {
+		if link.CvExperienceID == cvExperienceID {
+			skills = append(skills, t.skills[link.SkillID])
+		}
+	}
+	sortSkillsByImportance(skills)
+
+	items := []db.ListSkillsForCvExperienceRow{}
+	for _, skill := range skills {
+		items = append(items, db.ListSkillsForCvExperienceRow{ID: skill.ID, Name: skill.Name})
+	}
+	return items, nil
+}
+
+func (t *tables) DeleteCvExperienceSkills(ctx context.Context, cvExperienceID int32) error {
+	for link := range t.cvExperienceSkills {
+		if link.CvExperienceID == cvExperienceID {
+			delete(t.cvExperienceSkills, link)
+		}
+	}
+	return nil
+}
+
+func (t *tables) DeleteCvExperienceSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	for link := range t.cvExperienceSkills {
+		if t.cvExperiences[link.CvExperienceID].CvProfileID == cvProfileID {
+			delete(t.cvExperienceSkills, link)
+		}
+	}
+	return nil
+}
+
+func (t *tables) CreateCvExperienceTechnology(ctx context.Context, arg db.CreateCvExperienceTechnologyParams) (db.CvExperienceTechnology, error) {
+	if _, ok := t.cvExperiences[arg.CvExperienceID]; !ok {
+		return db.CvExperienceTechnology{}, foreignKeyViolation("cv_experience_technologies", "cv_experience_technologies_cv_experience_id_fkey")
+	}
+	if _, ok := t.technologies[arg.TechnologyID]; !ok {
+		return db.CvExperienceTechnology{}, foreignKeyViolation("cv_experience_technologies", "cv_experience_technologies_technology_id_fkey")
+	}
+
+	link := db.CvExperienceTechnology{CvExperienceID: arg.CvExperienceID, TechnologyID: arg.TechnologyID}
+	if t.cvExperienceTechnologies[link] {
+		return db.CvExperienceTechnology{}, uniqueViolation("cv_experience_technologies", "cv_experience_technologies_pkey")
+	}
+	t.cvExperienceTechnologies[link] = true
+	return link, nil
+}
+
+func (t *tables) ListTechnologiesForCvExperience(ctx context.Context, cvExperienceID int32) ([]db.ListTechnologiesForCvExperienceRow, error) {
+	technologies := []db.Technology{}
+	for link := range t.cvExperienceTechnologies {
+		if link.CvExperienceID == cvExperienceID {
+			technologies = append(technologies, t.technologies[link.TechnologyID])
+		}
+	}
+	sortTechnologies(technologies)
+
+	items := []db.ListTechnologiesForCvExperienceRow{}
+	for _, technology := range technologies {
+		items = append(items, db.ListTechnologiesForCvExperienceRow{ID: technology.ID, Name: technology.Name, Url: technology.Url})
+	}
+	return items, nil
+}
+
+func (t *tables) DeleteCvExperienceTechnologies(ctx context.Context, cvExperienceID int32) error {
+	for link := range t.cvExperienceTechnologies {
+		if link.CvExperienceID == cvExperienceID {
+			delete(t.cvExperienceTechnologies, link)
+		}
+	}
+	return nil
+}
+
+func (t *tables) DeleteCvExperienceTechnologiesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	for link := range t.cvExperienceTechnologies {
+		if t.cvExperiences[link.CvExperienceID].CvProfileID == cvProfileID {
+			delete(t.cvExperienceTechnologies, link)
+		}
+	}
+	return nil
+}
+
+// listCvExperiences returns copies of the experiences of the profile for which match returns true,
+// a nil match returns all
+func (t *tables) listCvExperiences(cvProfileID int32, match func(experience db.CvExperience) bool) []db.CvExperience {
+	items := []db.CvExperience{}
+	for _, experience := range t.cvExperiences {
+		if experience.CvProfileID == cvProfileID && (match == nil || match(experience)) {
+			items = append(items, copyCvExperience(experience))
+		}
+	}
+	return items
+}
+
+// copyCvExperience returns the experience with its own copy of the achievements
+func copyCvExperience(experience db.CvExperience) db.CvExperience {
+	experience.Achievements = cloneStrings(experience.Achievements)
+	return experience
+}
+
+// achievements copies the achievements, a nil slice is stored as an empty array like the column default
+func achievements(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return cloneStrings(values)
+}
+
+// nullDate drops the time of day of a nullable DATE column
+func nullDate(t sql.NullTime) sql.NullTime {
+	if !t.Valid {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: date(t.Time), Valid: true}
+}
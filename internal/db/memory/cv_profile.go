@@ -0,0 +1,117 @@
+package memdb
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"sort"
+	"time"
+)
+
+func (t *tables) CreateCvProfile(ctx context.Context, arg db.CreateCvProfileParams) (db.CvProfile, error) {
+	profile := db.CvProfile{
+		ID:             t.nextID("cv_profiles"),
+		Name:           arg.Name,
+		Email:          arg.Email,
+		Phone:          arg.Phone,
+		Address:        arg.Address,
+		LinkedinUrl:    arg.LinkedinUrl,
+		GithubUrl:      arg.GithubUrl,
+		Bio:            arg.Bio,
+		CreatedAt:      time.Now(),
+		ProfilePicture: arg.ProfilePicture,
+	}
+	t.cvProfiles[profile.ID] = profile
+	return profile, nil
+}
+
+func (t *tables) GetCvProfile(ctx context.Context, id int32) (db.CvProfile, error) {
+	profile, ok := t.cvProfiles[id]
+	if !ok {
+		return db.CvProfile{}, sql.ErrNoRows
+	}
+	return profile, nil
+}
+
+func (t *tables) GetCvProfileByEmail(ctx context.Context, email string) (db.CvProfile, error) {
+	var ids []int32
+	for id, profile := range t.cvProfiles {
+		if profile.Email == email {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return db.CvProfile{}, sql.ErrNoRows
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return t.cvProfiles[ids[0]], nil
+}
+
+func (t *tables) CvProfileExists(ctx context.Context, id int32) (bool, error) {
+	_, ok := t.cvProfiles[id]
+	return ok, nil
+}
+
+func (t *tables) UpdateCvProfile(ctx context.Context, arg db.UpdateCvProfileParams) (db.CvProfile, error) {
+	profile, ok := t.cvProfiles[arg.ID]
+	if !ok {
+		return db.CvProfile{}, sql.ErrNoRows
+	}
+
+	profile.Name = arg.Name
+	profile.Email = arg.Email
+	profile.Phone = arg.Phone
+	profile.Address = arg.Address
+	profile.LinkedinUrl = arg.LinkedinUrl
+	profile.GithubUrl = arg.GithubUrl
+	profile.Bio = arg.Bio
+	profile.ProfilePicture = arg.ProfilePicture
+	t.cvProfiles[profile.ID] = profile
+	return profile, nil
+}
+
+func (t *tables) DeleteCvProfile(ctx context.Context, id int32) (db.CvProfile, error) {
+	profile, ok := t.cvProfiles[id]
+	if !ok {
+		return db.CvProfile{}, sql.ErrNoRows
+	}
+
+	// everything of the profile is deleted with it, like the cascades do
+	for educationID, education := range t.cvEducations {
+		if education.CvProfileID == id {
+			delete(t.cvEducations, educationID)
+		}
+	}
+	for experienceID, experience := range t.cvExperiences {
+		if experience.CvProfileID == id {
+			t.deleteCvExperience(experienceID)
+		}
+	}
+	for skillID, skill := range t.skills {
+		if skill.CvProfileID == id {
+			t.deleteSkill(skillID)
+		}
+	}
+	for categoryID, category := range t.skillCategories {
+		if category.CvProfileID == id {
+			delete(t.skillCategories, categoryID)
+		}
+	}
+	for projectID, project := range t.projects {
+		if project.CvProfileID == id {
+			t.deleteProject(projectID)
+		}
+	}
+
+	delete(t.cvProfiles, id)
+	return profile, nil
+}
+
+// checkCvProfile returns the foreign key error of the table when the cv profile does not exist
+func (t *tables) checkCvProfile(table string, id int32) error {
+	if _, ok := t.cvProfiles[id]; !ok {
+		return foreignKeyViolation(table, table+"_cv_profile_id_fkey")
+	}
+	return nil
+}
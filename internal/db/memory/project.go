@@ -0,0 +1,325 @@
+package memdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"sort"
+)
+
+func (t *tables) CreateProject(ctx context.Context, arg db.CreateProjectParams) (db.Project, error) {
+	if err := t.checkCvProfile("projects", arg.CvProfileID); err != nil {
+		return db.Project{}, err
+	}
+	if err := checkHexThemeColor("projects", arg.HexThemeColor); err != nil {
+		return db.Project{}, err
+	}
+
+	project := db.Project{
+		ID:               t.nextID("projects"),
+		Title:            arg.Title,
+		ShortDescription: arg.ShortDescription,
+		Description:      arg.Description,
+		Image:            arg.Image,
+		HexThemeColor:    arg.HexThemeColor,
+		ProjectUrl:       arg.ProjectUrl,
+		CvProfileID:      arg.CvProfileID,
+		Significance:     arg.Significance,
+	}
+	t.projects[project.ID] = project
+	return project, nil
+}
+
+func (t *tables) GetProject(ctx context.Context, id int32) (db.Project, error) {
+	project, ok := t.projects[id]
+	if !ok {
+		return db.Project{}, sql.ErrNoRows
+	}
+	return project, nil
+}
+
+func (t *tables) GetProjectByTitle(ctx context.Context, arg db.GetProjectByTitleParams) (db.Project, error) {
+	found := false
+	var result db.Project
+	for _, project := range t.projects {
+		if project.CvProfileID == arg.CvProfileID && project.Title == arg.Title && (!found || project.ID < result.ID) {
+			result = project
+			found = true
+		}
+	}
+	if !found {
+		return db.Project{}, sql.ErrNoRows
+	}
+	return result, nil
+}
+
+func (t *tables) ListProjects(ctx context.Context, arg db.ListProjectsParams) ([]db.ListProjectsRow, error) {
+	items := []db.ListProjectsRow{}
+	for _, project := range t.listProjects(arg.CvProfileID, "", arg.Limit, arg.Offset) {
+		items = append(items, db.ListProjectsRow{
+			ID:               project.ID,
+			Title:            project.Title,
+			ShortDescription: project.ShortDescription,
+			Description:      project.Description,
+			Image:            project.Image,
+			HexThemeColor:    project.HexThemeColor,
+			ProjectUrl:       project.ProjectUrl,
+			Significance:     project.Significance,
+		})
+	}
+	return items, nil
+}
+
+func (t *tables) ListProjectsByCvProfile(ctx context.Context, cvProfileID int32) ([]db.Project, error) {
+	return t.listProjects(cvProfileID, "", -1, 0), nil
+}
+
+func (t *tables) ListProjectsBySkillName(ctx context.Context, arg db.ListProjectsBySkillNameParams) ([]db.ListProjectsBySkillNameRow, error) {
+	items := []db.ListProjectsBySkillNameRow{}
+	for _, project := range t.listProjects(arg.CvProfileID, arg.SkillSlug, arg.Limit, arg.Offset) {
+		items = append(items, db.ListProjectsBySkillNameRow{
+			ID:               project.ID,
+			Title:            project.Title,
+			ShortDescription: project.ShortDescription,
+			Description:      project.Description,
+			Image:            project.Image,
+			HexThemeColor:    project.HexThemeColor,
+			ProjectUrl:       project.ProjectUrl,
+			Significance:     project.Significance,
+		})
+	}
+	return items, nil
+}
+
+func (t *tables) ListProjectsWithTechnologyJSON(ctx context.Context, arg db.ListProjectsWithTechnologyJSONParams) ([]db.ListProjectsWithTechnologyJSONRow, error) {
+	items := []db.ListProjectsWithTechnologyJSONRow{}
+	for _, project := range t.listProjects(arg.CvProfileID, "", arg.Limit, arg.Offset) {
+		technologies, err := t.technologiesJSON(ctx, project.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, db.ListProjectsWithTechnologyJSONRow{
+			ID:               project.ID,
+			Title:            project.Title,
+			ShortDescription: project.ShortDescription,
+			Description:      project.Description,
+			Image:            project.Image,
+			HexThemeColor:    project.HexThemeColor,
+			ProjectUrl:       project.ProjectUrl,
+			Significance:     project.Significance,
+			TechnologiesUsed: technologies,
+		})
+	}
+	return items, nil
+}
+
+func (t *tables) ListProjectsWithTechnologyJSONBySkillName(ctx context.Context, arg db.ListProjectsWithTechnologyJSONBySkillNameParams) ([]db.ListProjectsWithTechnologyJSONBySkillNameRow, error) {
+	items := []db.ListProjectsWithTechnologyJSONBySkillNameRow{}
+	for _, project := range t.listProjects(arg.CvProfileID, arg.SkillSlug, arg.Limit, arg.Offset) {
+		technologies, err := t.technologiesJSON(ctx, project.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, db.ListProjectsWithTechnologyJSONBySkillNameRow{
+			ID:               project.ID,
+			Title:            project.Title,
+			ShortDescription: project.ShortDescription,
+			Description:      project.Description,
+			Image:            project.Image,
+			HexThemeColor:    project.HexThemeColor,
+			ProjectUrl:       project.ProjectUrl,
+			Significance:     project.Significance,
+			TechnologiesUsed: technologies,
+		})
+	}
+	return items, nil
+}
+
+func (t *tables) UpdateProject(ctx context.Context, arg db.UpdateProjectParams) (db.Project, error) {
+	project, ok := t.projects[arg.ID]
+	if !ok {
+		return db.Project{}, sql.ErrNoRows
+	}
+	if err := checkHexThemeColor("projects", arg.HexThemeColor); err != nil {
+		return db.Project{}, err
+	}
+
+	project.Title = arg.Title
+	project.ShortDescription = arg.ShortDescription
+	project.Description = arg.Description
+	project.Image = arg.Image
+	project.HexThemeColor = arg.HexThemeColor
+	project.ProjectUrl = arg.ProjectUrl
+	project.Significance = arg.Significance
+	t.projects[project.ID] = project
+	return project, nil
+}
+
+func (t *tables) PatchProject(ctx context.Context, arg db.PatchProjectParams) (db.Project, error) {
+	project, ok := t.projects[arg.ID]
+	if !ok {
+		return db.Project{}, sql.ErrNoRows
+	}
+
+	if arg.Title.Valid {
+		project.Title = arg.Title.String
+	}
+	if arg.ShortDescription.Valid {
+		project.ShortDescription = arg.ShortDescription.String
+	}
+	if arg.Description.Valid {
+		project.Description = arg.Description.String
+	}
+	if arg.Image.Valid {
+		project.Image = arg.Image.String
+	}
+	if arg.HexThemeColor.Valid {
+		if err := checkHexThemeColor("projects", arg.HexThemeColor.String); err != nil {
+			return db.Project{}, err
+		}
+		project.HexThemeColor = arg.HexThemeColor.String
+	}
+	if arg.ProjectUrl.Valid {
+		project.ProjectUrl = arg.ProjectUrl.String
+	}
+	if arg.Significance.Valid {
+		project.Significance = arg.Significance.Int32
+	}
+	t.projects[project.ID] = project
+	return project, nil
+}
+
+func (t *tables) ReorderProjects(ctx context.Context, arg db.ReorderProjectsParams) error {
+	for i, id := range arg.ProjectIds {
+		project, ok := t.projects[id]
+		if !ok || project.CvProfileID != arg.CvProfileID {
+			continue
+		}
+		project.Significance = int32(i + 1)
+		t.projects[id] = project
+	}
+	return nil
+}
+
+func (t *tables) DeleteProject(ctx context.Context, id int32) (db.Project, error) {
+	project, ok := t.projects[id]
+	if !ok {
+		return db.Project{}, sql.ErrNoRows
+	}
+
+	t.deleteProject(id)
+	return project, nil
+}
+
+func (t *tables) DeleteProjectsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	for id, project := range t.projects {
+		if project.CvProfileID == cvProfileID {
+			t.deleteProject(id)
+		}
+	}
+	return nil
+}
+
+// deleteProject deletes the project with its skill and technology links, like the cascades do
+func (t *tables) deleteProject(id int32) {
+	for link := range t.projectSkills {
+		if link.ProjectID == id {
+			delete(t.projectSkills, link)
+		}
+	}
+	for link := range t.projectTechnologies {
+		if link.ProjectID == id {
+			delete(t.projectTechnologies, link)
+		}
+	}
+	delete(t.projects, id)
+}
+
+func (t *tables) CreateProjectSkill(ctx context.Context, arg db.CreateProjectSkillParams) (db.ProjectSkill, error) {
+	if _, ok := t.projects[arg.ProjectID]; !ok {
+		return db.ProjectSkill{}, foreignKeyViolation("project_skills", "project_skills_project_id_fkey")
+	}
+	if _, ok := t.skills[arg.SkillID]; !ok {
+		return db.ProjectSkill{}, foreignKeyViolation("project_skills", "project_skills_skill_id_fkey")
+	}
+	if t.projects[arg.ProjectID].CvProfileID != t.skills[arg.SkillID].CvProfileID {
+		return db.ProjectSkill{}, foreignKeyViolation("project_skills", "project_skills_cv_profile_fkey")
+	}
+
+	link := db.ProjectSkill{ProjectID: arg.ProjectID, SkillID: arg.SkillID}
+	if t.projectSkills[link] {
+		return db.ProjectSkill{}, uniqueViolation("project_skills", "project_skills_pkey")
+	}
+	t.projectSkills[link] = true
+	return link, nil
+}
+
+func (t *tables) ListProjectSkills(ctx context.Context, projectID int32) ([]db.ProjectSkill, error) {
+	items := []db.ProjectSkill{}
+	for link := range t.projectSkills {
+		if link.ProjectID == projectID {
+			items = append(items, link)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].SkillID < items[j].SkillID })
+	return items, nil
+}
+
+func (t *tables) DeleteProjectSkills(ctx context.Context, projectID int32) error {
+	for link := range t.projectSkills {
+		if link.ProjectID == projectID {
+			delete(t.projectSkills, link)
+		}
+	}
+	return nil
+}
+
+func (t *tables) DeleteProjectSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	for link := range t.projectSkills {
+		if t.projects[link.ProjectID].CvProfileID == cvProfileID || t.skills[link.SkillID].CvProfileID == cvProfileID {
+			delete(t.projectSkills, link)
+		}
+	}
+	return nil
+}
+
+// listProjects returns a page of the projects of the profile ordered by significance,
+// a non-empty skillSlug returns only the projects linked to a skill with that slug
+func (t *tables) listProjects(cvProfileID int32, skillSlug string, limit, offset int32) []db.Project {
+	items := []db.Project{}
+	for _, project := range t.projects {
+		if project.CvProfileID == cvProfileID && (skillSlug == "" || t.projectHasSkill(project.ID, skillSlug)) {
+			items = append(items, project)
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Significance != items[j].Significance {
+			return items[i].Significance < items[j].Significance
+		}
+		return items[i].ID < items[j].ID
+	})
+	return page(items, limit, offset)
+}
+
+// projectHasSkill reports whether the project is linked to a skill with the slug
+func (t *tables) projectHasSkill(projectID int32, slug string) bool {
+	for link := range t.projectSkills {
+		if link.ProjectID == projectID && t.skills[link.SkillID].Slug == slug {
+			return true
+		}
+	}
+	return false
+}
+
+// technologiesJSON returns the technologies of the project as the JSON array built by the aggregated queries
+func (t *tables) technologiesJSON(ctx context.Context, projectID int32) (json.RawMessage, error) {
+	technologies, err := t.ListTechnologiesForProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(technologies)
+}
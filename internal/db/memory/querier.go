@@ -0,0 +1,404 @@
+package memdb
+
+import (
+	"context"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+)
+
+// The queries of db.Querier, each one runs under the lock of the store
+
+func (s *Store) CreateCvEducation(ctx context.Context, arg db.CreateCvEducationParams) (db.CvEducation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.CreateCvEducation(ctx, arg)
+}
+
+func (s *Store) CreateCvExperience(ctx context.Context, arg db.CreateCvExperienceParams) (db.CvExperience, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.CreateCvExperience(ctx, arg)
+}
+
+func (s *Store) CreateCvExperienceSkill(ctx context.Context, arg db.CreateCvExperienceSkillParams) (db.CvExperienceSkill, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.CreateCvExperienceSkill(ctx, arg)
+}
+
+func (s *Store) CreateCvExperienceTechnology(ctx context.Context, arg db.CreateCvExperienceTechnologyParams) (db.CvExperienceTechnology, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.CreateCvExperienceTechnology(ctx, arg)
+}
+
+func (s *Store) CreateCvProfile(ctx context.Context, arg db.CreateCvProfileParams) (db.CvProfile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.CreateCvProfile(ctx, arg)
+}
+
+func (s *Store) CreateProject(ctx context.Context, arg db.CreateProjectParams) (db.Project, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.CreateProject(ctx, arg)
+}
+
+func (s *Store) CreateProjectSkill(ctx context.Context, arg db.CreateProjectSkillParams) (db.ProjectSkill, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.CreateProjectSkill(ctx, arg)
+}
+
+func (s *Store) CreateProjectTechnology(ctx context.Context, arg db.CreateProjectTechnologyParams) (db.ProjectTechnology, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.CreateProjectTechnology(ctx, arg)
+}
+
+func (s *Store) CreateSkill(ctx context.Context, arg db.CreateSkillParams) (db.Skill, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.CreateSkill(ctx, arg)
+}
+
+func (s *Store) CreateTechnology(ctx context.Context, arg db.CreateTechnologyParams) (db.Technology, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.CreateTechnology(ctx, arg)
+}
+
+func (s *Store) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.CreateUser(ctx, arg)
+}
+
+func (s *Store) CvProfileExists(ctx context.Context, id int32) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.CvProfileExists(ctx, id)
+}
+
+func (s *Store) DeleteCvEducationsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteCvEducationsByCvProfile(ctx, cvProfileID)
+}
+
+func (s *Store) DeleteCvExperienceSkills(ctx context.Context, cvExperienceID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteCvExperienceSkills(ctx, cvExperienceID)
+}
+
+func (s *Store) DeleteCvExperienceSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteCvExperienceSkillsByCvProfile(ctx, cvProfileID)
+}
+
+func (s *Store) DeleteCvExperienceTechnologies(ctx context.Context, cvExperienceID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteCvExperienceTechnologies(ctx, cvExperienceID)
+}
+
+func (s *Store) DeleteCvExperienceTechnologiesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteCvExperienceTechnologiesByCvProfile(ctx, cvProfileID)
+}
+
+func (s *Store) DeleteCvExperiencesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteCvExperiencesByCvProfile(ctx, cvProfileID)
+}
+
+func (s *Store) DeleteCvProfile(ctx context.Context, id int32) (db.CvProfile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteCvProfile(ctx, id)
+}
+
+func (s *Store) DeleteProject(ctx context.Context, id int32) (db.Project, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteProject(ctx, id)
+}
+
+func (s *Store) DeleteProjectSkills(ctx context.Context, projectID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteProjectSkills(ctx, projectID)
+}
+
+func (s *Store) DeleteProjectSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteProjectSkillsByCvProfile(ctx, cvProfileID)
+}
+
+func (s *Store) DeleteProjectTechnologies(ctx context.Context, projectID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteProjectTechnologies(ctx, projectID)
+}
+
+func (s *Store) DeleteProjectTechnologiesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteProjectTechnologiesByCvProfile(ctx, cvProfileID)
+}
+
+func (s *Store) DeleteProjectsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteProjectsByCvProfile(ctx, cvProfileID)
+}
+
+func (s *Store) DeleteSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.DeleteSkillsByCvProfile(ctx, cvProfileID)
+}
+
+func (s *Store) GetCvEducation(ctx context.Context, id int32) (db.CvEducation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetCvEducation(ctx, id)
+}
+
+func (s *Store) GetCvEducationByInstitution(ctx context.Context, arg db.GetCvEducationByInstitutionParams) (db.CvEducation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetCvEducationByInstitution(ctx, arg)
+}
+
+func (s *Store) GetCvExperience(ctx context.Context, id int32) (db.CvExperience, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetCvExperience(ctx, id)
+}
+
+func (s *Store) GetCvExperienceByCompany(ctx context.Context, arg db.GetCvExperienceByCompanyParams) (db.CvExperience, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetCvExperienceByCompany(ctx, arg)
+}
+
+func (s *Store) GetCvProfile(ctx context.Context, id int32) (db.CvProfile, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetCvProfile(ctx, id)
+}
+
+func (s *Store) GetCvProfileByEmail(ctx context.Context, email string) (db.CvProfile, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetCvProfileByEmail(ctx, email)
+}
+
+func (s *Store) GetProject(ctx context.Context, id int32) (db.Project, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetProject(ctx, id)
+}
+
+func (s *Store) GetProjectByTitle(ctx context.Context, arg db.GetProjectByTitleParams) (db.Project, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetProjectByTitle(ctx, arg)
+}
+
+func (s *Store) GetSkill(ctx context.Context, id int32) (db.Skill, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetSkill(ctx, id)
+}
+
+func (s *Store) GetSkillByName(ctx context.Context, arg db.GetSkillByNameParams) (db.Skill, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetSkillByName(ctx, arg)
+}
+
+func (s *Store) GetSkillBySlug(ctx context.Context, arg db.GetSkillBySlugParams) (db.Skill, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetSkillBySlug(ctx, arg)
+}
+
+func (s *Store) GetTechnologyByName(ctx context.Context, name string) (db.Technology, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetTechnologyByName(ctx, name)
+}
+
+func (s *Store) GetUser(ctx context.Context, username string) (db.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.GetUser(ctx, username)
+}
+
+func (s *Store) ListCvEducations(ctx context.Context, arg db.ListCvEducationsParams) ([]db.CvEducation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListCvEducations(ctx, arg)
+}
+
+func (s *Store) ListCvExperiences(ctx context.Context, arg db.ListCvExperiencesParams) ([]db.CvExperience, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListCvExperiences(ctx, arg)
+}
+
+func (s *Store) ListCvExperiencesWithJSON(ctx context.Context, arg db.ListCvExperiencesWithJSONParams) ([]db.ListCvExperiencesWithJSONRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListCvExperiencesWithJSON(ctx, arg)
+}
+
+func (s *Store) ListProjectSkills(ctx context.Context, projectID int32) ([]db.ProjectSkill, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListProjectSkills(ctx, projectID)
+}
+
+func (s *Store) ListProjects(ctx context.Context, arg db.ListProjectsParams) ([]db.ListProjectsRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListProjects(ctx, arg)
+}
+
+func (s *Store) ListProjectsByCvProfile(ctx context.Context, cvProfileID int32) ([]db.Project, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListProjectsByCvProfile(ctx, cvProfileID)
+}
+
+func (s *Store) ListProjectsBySkillName(ctx context.Context, arg db.ListProjectsBySkillNameParams) ([]db.ListProjectsBySkillNameRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListProjectsBySkillName(ctx, arg)
+}
+
+func (s *Store) ListProjectsWithTechnologyJSON(ctx context.Context, arg db.ListProjectsWithTechnologyJSONParams) ([]db.ListProjectsWithTechnologyJSONRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListProjectsWithTechnologyJSON(ctx, arg)
+}
+
+func (s *Store) ListProjectsWithTechnologyJSONBySkillName(ctx context.Context, arg db.ListProjectsWithTechnologyJSONBySkillNameParams) ([]db.ListProjectsWithTechnologyJSONBySkillNameRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListProjectsWithTechnologyJSONBySkillName(ctx, arg)
+}
+
+func (s *Store) ListSkills(ctx context.Context, arg db.ListSkillsParams) ([]db.Skill, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListSkills(ctx, arg)
+}
+
+func (s *Store) ListSkillsByCategory(ctx context.Context, arg db.ListSkillsByCategoryParams) ([]db.Skill, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListSkillsByCategory(ctx, arg)
+}
+
+func (s *Store) ListSkillsForCvExperience(ctx context.Context, cvExperienceID int32) ([]db.ListSkillsForCvExperienceRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListSkillsForCvExperience(ctx, cvExperienceID)
+}
+
+func (s *Store) ListSkillsWithCategories(ctx context.Context, cvProfileID int32) ([]db.ListSkillsWithCategoriesRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListSkillsWithCategories(ctx, cvProfileID)
+}
+
+func (s *Store) ListTechnologiesForCvExperience(ctx context.Context, cvExperienceID int32) ([]db.ListTechnologiesForCvExperienceRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListTechnologiesForCvExperience(ctx, cvExperienceID)
+}
+
+func (s *Store) ListTechnologiesForProject(ctx context.Context, projectID int32) ([]db.ListTechnologiesForProjectRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.ListTechnologiesForProject(ctx, projectID)
+}
+
+func (s *Store) PatchProject(ctx context.Context, arg db.PatchProjectParams) (db.Project, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.PatchProject(ctx, arg)
+}
+
+func (s *Store) ReleaseSkillImportance(ctx context.Context, id int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.ReleaseSkillImportance(ctx, id)
+}
+
+func (s *Store) ReorderProjects(ctx context.Context, arg db.ReorderProjectsParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.ReorderProjects(ctx, arg)
+}
+
+func (s *Store) ReorderSkills(ctx context.Context, arg db.ReorderSkillsParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.ReorderSkills(ctx, arg)
+}
+
+func (s *Store) SearchCvProfile(ctx context.Context, arg db.SearchCvProfileParams) ([]db.SearchCvProfileRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.SearchCvProfile(ctx, arg)
+}
+
+func (s *Store) UpdateCvEducation(ctx context.Context, arg db.UpdateCvEducationParams) (db.CvEducation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.UpdateCvEducation(ctx, arg)
+}
+
+func (s *Store) UpdateCvExperience(ctx context.Context, arg db.UpdateCvExperienceParams) (db.CvExperience, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.UpdateCvExperience(ctx, arg)
+}
+
+func (s *Store) UpdateCvProfile(ctx context.Context, arg db.UpdateCvProfileParams) (db.CvProfile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.UpdateCvProfile(ctx, arg)
+}
+
+func (s *Store) UpdateProject(ctx context.Context, arg db.UpdateProjectParams) (db.Project, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.UpdateProject(ctx, arg)
+}
+
+func (s *Store) UpdateSkill(ctx context.Context, arg db.UpdateSkillParams) (db.Skill, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.UpdateSkill(ctx, arg)
+}
+
+func (s *Store) UpdateTechnology(ctx context.Context, arg db.UpdateTechnologyParams) (db.Technology, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.UpdateTechnology(ctx, arg)
+}
+
+func (s *Store) UpsertSkillCategory(ctx context.Context, arg db.UpsertSkillCategoryParams) (db.SkillCategory, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.UpsertSkillCategory(ctx, arg)
+}
@@ -0,0 +1,148 @@
+package memdb
+
+import (
+	"context"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// snippetWords is the maximum number of words in a search snippet, like MaxWords of ts_headline
+const snippetWords = 30
+
+// searchField is a weighted part of a searched document, the weights are the defaults of ts_rank
+type searchField struct {
+	text   string
+	weight float32
+}
+
+const (
+	weightA float32 = 1.0
+	weightB float32 = 0.4
+	weightC float32 = 0.2
+)
+
+// SearchCvProfile approximates the full-text search of Postgres - every word of the query has to appear
+// in the document, case-insensitively, and there is no stemming or stop words
+func (t *tables) SearchCvProfile(ctx context.Context, arg db.SearchCvProfileParams) ([]db.SearchCvProfileRow, error) {
+	terms := searchTerms(arg.Query)
+	items := []db.SearchCvProfileRow{}
+	if len(terms) == 0 {
+		return items, nil
+	}
+
+	add := func(kind string, id int32, title, snippetText string, fields ...searchField) {
+		if rank, ok := searchRank(terms, fields); ok {
+			items = append(items, db.SearchCvProfileRow{
+				Kind:    kind,
+				ID:      id,
+				Title:   title,
+				Snippet: searchSnippet(terms, snippetText),
+				Rank:    rank,
+			})
+		}
+	}
+
+	for _, project := range t.projects {
+		if project.CvProfileID == arg.CvProfileID {
+			add("project", project.ID, project.Title, project.ShortDescription+" "+project.Description,
+				searchField{project.Title, weightA},
+				searchField{project.ShortDescription, weightB},
+				searchField{project.Description, weightC})
+		}
+	}
+	for _, skill := range t.skills {
+		if skill.CvProfileID == arg.CvProfileID {
+			add("skill", skill.ID, skill.Name, skill.Description,
+				searchField{skill.Name, weightA},
+				searchField{skill.Description, weightB})
+		}
+	}
+	if profile, ok := t.cvProfiles[arg.CvProfileID]; ok {
+		add("profile", profile.ID, profile.Name, profile.Bio, searchField{profile.Bio, weightB})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Rank != items[j].Rank {
+			return items[i].Rank > items[j].Rank
+		}
+		if items[i].Kind != items[j].Kind {
+			return items[i].Kind < items[j].Kind
+		}
+		return items[i].ID < items[j].ID
+	})
+	return page(items, arg.Limit, arg.Offset), nil
+}
+
+// searchTerms returns the lower-case words of the query, without the operators of websearch_to_tsquery
+func searchTerms(query string) []string {
+	var terms []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(query), isNotWordRune) {
+		if word != "or" {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+// searchRank returns the sum of the weights of the fields in which each term appears,
+// ok is false when any term does not appear in the document at all
+func searchRank(terms []string, fields []searchField) (rank float32, ok bool) {
+	for _, term := range terms {
+		found := false
+		for _, field := range fields {
+			if strings.Contains(strings.ToLower(field.text), term) {
+				rank += field.weight
+				found = true
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return rank / float32(len(terms)), true
+}
+
+// snippetEscaper escapes the text of a snippet, so that the <mark> tags are its only markup
+var snippetEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// searchSnippet returns up to snippetWords words of text starting a few words before the first match,
+// with the words escaped and the matched ones wrapped in <mark> tags
+func searchSnippet(terms []string, text string) string {
+	words := strings.Fields(text)
+
+	start := 0
+	for i, word := range words {
+		if matchesTerm(terms, word) {
+			start = max(i-5, 0)
+			break
+		}
+	}
+
+	end := min(start+snippetWords, len(words))
+	snippet := make([]string, 0, end-start)
+	for _, word := range words[start:end] {
+		escaped := snippetEscaper.Replace(word)
+		if matchesTerm(terms, word) {
+			escaped = "<mark>" + escaped + "</mark>"
+		}
+		snippet = append(snippet, escaped)
+	}
+	return strings.Join(snippet, " ")
+}
+
+// matchesTerm reports whether the word contains any of the terms
+func matchesTerm(terms []string, word string) bool {
+	word = strings.ToLower(word)
+	for _, term := range terms {
+		if strings.Contains(word, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func isNotWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
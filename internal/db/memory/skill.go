@@ -0,0 +1,196 @@
+package memdb
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"maps"
+	"sort"
+)
+
+func (t *tables) CreateSkill(ctx context.Context, arg db.CreateSkillParams) (db.Skill, error) {
+	if err := t.checkCvProfile("skills", arg.CvProfileID); err != nil {
+		return db.Skill{}, err
+	}
+	if err := checkHexThemeColor("skills", arg.HexThemeColor); err != nil {
+		return db.Skill{}, err
+	}
+
+	skill := db.Skill{
+		Name:          arg.Name,
+		Slug:          arg.Slug,
+		Description:   arg.Description,
+		Category:      arg.Category,
+		Image:         arg.Image,
+		HexThemeColor: arg.HexThemeColor,
+		CvProfileID:   arg.CvProfileID,
+		Importance:    arg.Importance,
+	}
+	if err := t.checkSkillUnique(skill); err != nil {
+		return db.Skill{}, err
+	}
+
+	skill.ID = t.nextID("skills")
+	t.skills[skill.ID] = skill
+	return skill, nil
+}
+
+func (t *tables) GetSkill(ctx context.Context, id int32) (db.Skill, error) {
+	skill, ok := t.skills[id]
+	if !ok {
+		return db.Skill{}, sql.ErrNoRows
+	}
+	return skill, nil
+}
+
+func (t *tables) GetSkillByName(ctx context.Context, arg db.GetSkillByNameParams) (db.Skill, error) {
+	for _, skill := range t.skills {
+		if skill.CvProfileID == arg.CvProfileID && skill.Name == arg.Name {
+			return skill, nil
+		}
+	}
+	return db.Skill{}, sql.ErrNoRows
+}
+
+func (t *tables) GetSkillBySlug(ctx context.Context, arg db.GetSkillBySlugParams) (db.Skill, error) {
+	for _, skill := range t.skills {
+		if skill.CvProfileID == arg.CvProfileID && skill.Slug == arg.Slug {
+			return skill, nil
+		}
+	}
+	return db.Skill{}, sql.ErrNoRows
+}
+
+func (t *tables) ListSkills(ctx context.Context, arg db.ListSkillsParams) ([]db.Skill, error) {
+	items := []db.Skill{}
+	for _, skill := range t.skills {
+		if skill.CvProfileID == arg.CvProfileID {
+			items = append(items, skill)
+		}
+	}
+	sortSkillsByImportance(items)
+	return page(items, arg.Limit, arg.Offset), nil
+}
+
+func (t *tables) ListSkillsByCategory(ctx context.Context, arg db.ListSkillsByCategoryParams) ([]db.Skill, error) {
+	items := []db.Skill{}
+	for _, skill := range t.skills {
+		if skill.CvProfileID == arg.CvProfileID && skill.Category == arg.Category {
+			items = append(items, skill)
+		}
+	}
+	sortSkillsByImportance(items)
+	return items, nil
+}
+
+func (t *tables) UpdateSkill(ctx context.Context, arg db.UpdateSkillParams) (db.Skill, error) {
+	skill, ok := t.skills[arg.ID]
+	if !ok {
+		return db.Skill{}, sql.ErrNoRows
+	}
+
+	skill.Name = arg.Name
+	skill.Slug = arg.Slug
+	skill.Description = arg.Description
+	skill.Category = arg.Category
+	skill.Importance = arg.Importance
+	skill.Image = arg.Image
+	skill.HexThemeColor = arg.HexThemeColor
+	if err := checkHexThemeColor("skills", skill.HexThemeColor); err != nil {
+		return db.Skill{}, err
+	}
+	if err := t.checkSkillUnique(skill); err != nil {
+		return db.Skill{}, err
+	}
+
+	t.skills[skill.ID] = skill
+	return skill, nil
+}
+
+func (t *tables) ReleaseSkillImportance(ctx context.Context, id int32) error {
+	if skill, ok := t.skills[id]; ok {
+		skill.Importance = -skill.ID
+		t.skills[id] = skill
+	}
+	return nil
+}
+
+// ReorderSkills checks the unique constraints once every skill has its new importance,
+// like the deferrable constraint of Postgres
+func (t *tables) ReorderSkills(ctx context.Context, arg db.ReorderSkillsParams) error {
+	previous := make(map[int32]db.Skill)
+	for i, id := range arg.SkillIds {
+		skill, ok := t.skills[id]
+		if !ok || skill.CvProfileID != arg.CvProfileID || skill.Category != arg.Category {
+			continue
+		}
+		previous[id] = skill
+		skill.Importance = int32(i + 1)
+		t.skills[id] = skill
+	}
+
+	for id := range previous {
+		if err := t.checkSkillUnique(t.skills[id]); err != nil {
+			maps.Copy(t.skills, previous)
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *tables) DeleteSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	for id, skill := range t.skills {
+		if skill.CvProfileID == cvProfileID {
+			t.deleteSkill(id)
+		}
+	}
+	return nil
+}
+
+// deleteSkill deletes the skill with its project and experience links, like the cascades do
+func (t *tables) deleteSkill(id int32) {
+	for link := range t.projectSkills {
+		if link.SkillID == id {
+			delete(t.projectSkills, link)
+		}
+	}
+	for link := range t.cvExperienceSkills {
+		if link.SkillID == id {
+			delete(t.cvExperienceSkills, link)
+		}
+	}
+	delete(t.skills, id)
+}
+
+// checkSkillUnique checks the unique constraints of the skills table, ignoring the skill itself.
+// All of them are per cv profile.
+func (t *tables) checkSkillUnique(skill db.Skill) error {
+	for _, other := range t.skills {
+		if other.ID == skill.ID || other.CvProfileID != skill.CvProfileID {
+			continue
+		}
+		if other.Name == skill.Name {
+			return uniqueViolation("skills", "unique_profile_skill_name")
+		}
+		if other.Slug == skill.Slug {
+			return uniqueViolation("skills", "unique_profile_skill_slug")
+		}
+		if other.Category == skill.Category && other.Importance == skill.Importance {
+			return uniqueViolation("skills", "unique_profile_skill_category_importance")
+		}
+	}
+	return nil
+}
+
+// sortSkillsByImportance sorts the skills by importance, then by category and ID to keep the order stable
+func sortSkillsByImportance(skills []db.Skill) {
+	sort.Slice(skills, func(i, j int) bool {
+		if skills[i].Importance != skills[j].Importance {
+			return skills[i].Importance < skills[j].Importance
+		}
+		if skills[i].Category != skills[j].Category {
+			return skills[i].Category < skills[j].Category
+		}
+		return skills[i].ID < skills[j].ID
+	})
+}
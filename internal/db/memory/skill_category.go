@@ -0,0 +1,107 @@
+package memdb
+
+import (
+	"context"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"sort"
+)
+
+// UpsertSkillCategory creates the category, or replaces the description, theme color and display order
+// of the category with the same name, like INSERT ... ON CONFLICT DO UPDATE
+func (t *tables) UpsertSkillCategory(ctx context.Context, arg db.UpsertSkillCategoryParams) (db.SkillCategory, error) {
+	if err := t.checkCvProfile("skill_categories", arg.CvProfileID); err != nil {
+		return db.SkillCategory{}, err
+	}
+	if err := checkHexThemeColor("skill_categories", arg.HexThemeColor); err != nil {
+		return db.SkillCategory{}, err
+	}
+
+	category, ok := t.skillCategory(arg.CvProfileID, arg.Name)
+	if !ok {
+		category = db.SkillCategory{
+			ID:          t.nextID("skill_categories"),
+			CvProfileID: arg.CvProfileID,
+			Name:        arg.Name,
+		}
+	}
+	category.Description = arg.Description
+	category.HexThemeColor = arg.HexThemeColor
+	category.DisplayOrder = arg.DisplayOrder
+
+	t.skillCategories[category.ID] = category
+	return category, nil
+}
+
+// ListSkillsWithCategories sorts the skills by the display order of their categories, the categories
+// without a row come last by name, and then by importance
+func (t *tables) ListSkillsWithCategories(ctx context.Context, cvProfileID int32) ([]db.ListSkillsWithCategoriesRow, error) {
+	items := []db.ListSkillsWithCategoriesRow{}
+	// defined tells whether the category of the item at the same index has a row
+	var defined []bool
+	for _, skill := range t.skills {
+		if skill.CvProfileID != cvProfileID {
+			continue
+		}
+		category, ok := t.skillCategory(cvProfileID, skill.Category)
+		items = append(items, db.ListSkillsWithCategoriesRow{
+			ID:                    skill.ID,
+			Name:                  skill.Name,
+			Description:           skill.Description,
+			Category:              skill.Category,
+			Image:                 skill.Image,
+			HexThemeColor:         skill.HexThemeColor,
+			CvProfileID:           skill.CvProfileID,
+			Importance:            skill.Importance,
+			Slug:                  skill.Slug,
+			CategoryDescription:   category.Description,
+			CategoryHexThemeColor: category.HexThemeColor,
+			CategoryDisplayOrder:  category.DisplayOrder,
+		})
+		defined = append(defined, ok)
+	}
+
+	sort.Sort(skillsWithCategories{items: items, defined: defined})
+	return items, nil
+}
+
+// skillCategory returns the category of the cv profile with the name
+func (t *tables) skillCategory(cvProfileID int32, name string) (db.SkillCategory, bool) {
+	for _, category := range t.skillCategories {
+		if category.CvProfileID == cvProfileID && category.Name == name {
+			return category, true
+		}
+	}
+	return db.SkillCategory{}, false
+}
+
+// skillsWithCategories sorts the rows of ListSkillsWithCategories together with whether their categories have rows
+type skillsWithCategories struct {
+	items   []db.ListSkillsWithCategoriesRow
+	defined []bool
+}
+
+func (s skillsWithCategories) Len() int {
+	return len(s.items)
+}
+
+func (s skillsWithCategories) Less(i, j int) bool {
+	a, b := s.items[i], s.items[j]
+	if s.defined[i] != s.defined[j] {
+		return s.defined[i]
+	}
+	if a.CategoryDisplayOrder != b.CategoryDisplayOrder {
+		return a.CategoryDisplayOrder < b.CategoryDisplayOrder
+	}
+	if a.Category != b.Category {
+		return a.Category < b.Category
+	}
+	if a.Importance != b.Importance {
+		return a.Importance < b.Importance
+	}
+	return a.ID < b.ID
+}
+
+func (s skillsWithCategories) Swap(i, j int) {
+	s.items[i], s.items[j] = s.items[j], s.items[i]
+	s.defined[i], s.defined[j] = s.defined[j], s.defined[i]
+}
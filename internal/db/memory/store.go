@@ -0,0 +1,185 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+	"github.com/aalug/cv-backend-go/internal/db/migrations"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/lib/pq"
+	"maps"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// Store is a thread-safe, in-memory implementation of db.Store. It enforces the same unique, foreign key
+// and check constraints as the Postgres schema, deletes with the same cascades and returns the same *pq.Error
+// values when a constraint is violated, so the API maps its errors to the same responses.
+// All data is lost when the process exits.
+type Store struct {
+	mu   sync.RWMutex
+	data *tables
+}
+
+var _ db.Store = (*Store)(nil)
+
+// NewStore creates a new, empty Store
+func NewStore() *Store {
+	return &Store{data: newTables()}
+}
+
+// tables holds the rows of every table, the methods of tables are not safe for concurrent use
+type tables struct {
+	cvProfiles               map[int32]db.CvProfile
+	cvEducations             map[int32]db.CvEducation
+	cvExperiences            map[int32]db.CvExperience
+	cvExperienceSkills       map[db.CvExperienceSkill]bool
+	cvExperienceTechnologies map[db.CvExperienceTechnology]bool
+	skills                   map[int32]db.Skill
+	skillCategories          map[int32]db.SkillCategory
+	projects                 map[int32]db.Project
+	projectSkills            map[db.ProjectSkill]bool
+	projectTechnologies      map[db.ProjectTechnology]bool
+	technologies             map[int32]db.Technology
+	users                    map[int32]db.User
+	// sequences holds the last ID of every table, like SERIAL columns IDs are never reused
+	sequences map[string]int32
+}
+
+func newTables() *tables {
+	return &tables{
+		cvProfiles:               make(map[int32]db.CvProfile),
+		cvEducations:             make(map[int32]db.CvEducation),
+		cvExperiences:            make(map[int32]db.CvExperience),
+		cvExperienceSkills:       make(map[db.CvExperienceSkill]bool),
+		cvExperienceTechnologies: make(map[db.CvExperienceTechnology]bool),
+		skills:                   make(map[int32]db.Skill),
+		skillCategories:          make(map[int32]db.SkillCategory),
+		projects:                 make(map[int32]db.Project),
+		projectSkills:            make(map[db.ProjectSkill]bool),
+		projectTechnologies:      make(map[db.ProjectTechnology]bool),
+		technologies:             make(map[int32]db.Technology),
+		users:                    make(map[int32]db.User),
+		sequences:                make(map[string]int32),
+	}
+}
+
+// clone returns a copy of the tables, rows are values and their slices are never modified in place,
+// so copying the maps is enough
+func (t *tables) clone() *tables {
+	return &tables{
+		cvProfiles:               maps.Clone(t.cvProfiles),
+		cvEducations:             maps.Clone(t.cvEducations),
+		cvExperiences:            maps.Clone(t.cvExperiences),
+		cvExperienceSkills:       maps.Clone(t.cvExperienceSkills),
+		cvExperienceTechnologies: maps.Clone(t.cvExperienceTechnologies),
+		skills:                   maps.Clone(t.skills),
+		skillCategories:          maps.Clone(t.skillCategories),
+		projects:                 maps.Clone(t.projects),
+		projectSkills:            maps.Clone(t.projectSkills),
+		projectTechnologies:      maps.Clone(t.projectTechnologies),
+		technologies:             maps.Clone(t.technologies),
+		users:                    maps.Clone(t.users),
+		sequences:                maps.Clone(t.sequences),
+	}
+}
+
+// nextID returns the next ID of the table
+func (t *tables) nextID(table string) int32 {
+	t.sequences[table]++
+	return t.sequences[table]
+}
+
+// execTx runs fn on a copy of the data, which replaces the data only when fn succeeds
+func (s *Store) execTx(fn func(t *tables) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx := s.data.clone()
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	s.data = tx
+	return nil
+}
+
+// Ping always succeeds, there is no connection to check
+func (s *Store) Ping(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// MigrationVersion reports the latest migration, the in-memory schema always matches the code
+func (s *Store) MigrationVersion(ctx context.Context) (version int64, dirty bool, err error) {
+	return int64(migrations.LatestVersion()), false, nil
+}
+
+// uniqueViolation returns the error that Postgres returns when the constraint is violated
+func uniqueViolation(table, constraint string) error {
+	return &pq.Error{
+		Code:       "23505",
+		Message:    fmt.Sprintf("duplicate key value violates unique constraint %q", constraint),
+		Table:      table,
+		Constraint: constraint,
+	}
+}
+
+// foreignKeyViolation returns the error that Postgres returns when the constraint is violated
+func foreignKeyViolation(table, constraint string) error {
+	return &pq.Error{
+		Code:       "23503",
+		Message:    fmt.Sprintf("insert or update on table %q violates foreign key constraint %q", table, constraint),
+		Table:      table,
+		Constraint: constraint,
+	}
+}
+
+// checkViolation returns the error that Postgres returns when the check constraint is violated
+func checkViolation(table, constraint string) error {
+	return &pq.Error{
+		Code:       "23514",
+		Message:    fmt.Sprintf("new row for relation %q violates check constraint %q", table, constraint),
+		Table:      table,
+		Constraint: constraint,
+	}
+}
+
+// hexThemeColorPattern matches the theme colors that the check constraints of the theme colors accept,
+// "#rgb", "#rrggbb" or an empty string for the default color
+var hexThemeColorPattern = regexp.MustCompile(`^(#[0-9A-Fa-f]{3}|#[0-9A-Fa-f]{6})?$`)
+
+// checkHexThemeColor returns the check error of the table when the theme color is not valid
+func checkHexThemeColor(table, color string) error {
+	if !hexThemeColorPattern.MatchString(color) {
+		return checkViolation(table, table+"_hex_theme_color_check")
+	}
+	return nil
+}
+
+// date drops the time of day, like a DATE column does
+func date(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// page returns the part of items selected by LIMIT and OFFSET
+func page[T any](items []T, limit, offset int32) []T {
+	if offset < 0 {
+		offset = 0
+	}
+	if int(offset) >= len(items) {
+		return items[:0]
+	}
+	items = items[offset:]
+	if limit >= 0 && int(limit) < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+// cloneStrings copies the slice, so that the stored rows do not share it with the caller
+func cloneStrings(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	return append([]string{}, values...)
+}
@@ -0,0 +1,65 @@
+package memdb
+
+import (
+	"context"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/internal/db/storetest"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+)
+
+func TestStore_Contract(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) db.Store {
+		return NewStore()
+	})
+}
+
+func TestStore_Concurrent(t *testing.T) {
+	store := NewStore()
+	cvProfile, err := store.CreateCvProfile(context.Background(), db.CreateCvProfileParams{
+		Name:  utils.RandomString(6),
+		Email: utils.RandomEmail(),
+	})
+	require.NoError(t, err)
+
+	// require must not be called outside of the test goroutine, so the errors are checked after the goroutines finish
+	const n = 20
+	errCh := make(chan error, 2*n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := store.CreateProjectTx(context.Background(), db.CreateProjectTxParams{
+				CreateProjectParams: db.CreateProjectParams{Title: utils.RandomString(12), CvProfileID: cvProfile.ID},
+			})
+			errCh <- err
+
+			_, err = store.ListProjectsWithTechnologies(context.Background(), db.ListProjectsWithTechnologiesParams{
+				CvProfileID: cvProfile.ID,
+				Limit:       10,
+			})
+			errCh <- err
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+
+	for err := range errCh {
+		require.NoError(t, err)
+	}
+
+	projects, err := store.ListProjects(context.Background(), db.ListProjectsParams{CvProfileID: cvProfile.ID, Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, projects, n)
+
+	// IDs are unique even when the rows are created concurrently
+	ids := make(map[int32]bool)
+	for _, project := range projects {
+		ids[project.ID] = true
+	}
+	require.Len(t, ids, n)
+}
@@ -0,0 +1,106 @@
+package memdb
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"sort"
+)
+
+func (t *tables) CreateTechnology(ctx context.Context, arg db.CreateTechnologyParams) (db.Technology, error) {
+	technology := db.Technology{
+		ID:         t.nextID("technologies"),
+		Name:       arg.Name,
+		Url:        arg.Url,
+		OrderField: arg.OrderField,
+	}
+	t.technologies[technology.ID] = technology
+	return technology, nil
+}
+
+func (t *tables) GetTechnologyByName(ctx context.Context, name string) (db.Technology, error) {
+	found := false
+	var result db.Technology
+	for _, technology := range t.technologies {
+		if technology.Name == name && (!found || technology.ID < result.ID) {
+			result = technology
+			found = true
+		}
+	}
+	if !found {
+		return db.Technology{}, sql.ErrNoRows
+	}
+	return result, nil
+}
+
+func (t *tables) UpdateTechnology(ctx context.Context, arg db.UpdateTechnologyParams) (db.Technology, error) {
+	technology, ok := t.technologies[arg.ID]
+	if !ok {
+		return db.Technology{}, sql.ErrNoRows
+	}
+
+	technology.Url = arg.Url
+	technology.OrderField = arg.OrderField
+	t.technologies[technology.ID] = technology
+	return technology, nil
+}
+
+func (t *tables) CreateProjectTechnology(ctx context.Context, arg db.CreateProjectTechnologyParams) (db.ProjectTechnology, error) {
+	if _, ok := t.projects[arg.ProjectID]; !ok {
+		return db.ProjectTechnology{}, foreignKeyViolation("project_technologies", "project_technologies_project_id_fkey")
+	}
+	if _, ok := t.technologies[arg.TechnologyID]; !ok {
+		return db.ProjectTechnology{}, foreignKeyViolation("project_technologies", "project_technologies_technology_id_fkey")
+	}
+
+	link := db.ProjectTechnology{ProjectID: arg.ProjectID, TechnologyID: arg.TechnologyID}
+	if t.projectTechnologies[link] {
+		return db.ProjectTechnology{}, uniqueViolation("project_technologies", "project_technologies_pkey")
+	}
+	t.projectTechnologies[link] = true
+	return link, nil
+}
+
+func (t *tables) ListTechnologiesForProject(ctx context.Context, projectID int32) ([]db.ListTechnologiesForProjectRow, error) {
+	technologies := []db.Technology{}
+	for link := range t.projectTechnologies {
+		if link.ProjectID == projectID {
+			technologies = append(technologies, t.technologies[link.TechnologyID])
+		}
+	}
+	sortTechnologies(technologies)
+
+	items := []db.ListTechnologiesForProjectRow{}
+	for _, technology := range technologies {
+		items = append(items, db.ListTechnologiesForProjectRow{ID: technology.ID, Name: technology.Name, Url: technology.Url})
+	}
+	return items, nil
+}
+
+func (t *tables) DeleteProjectTechnologies(ctx context.Context, projectID int32) error {
+	for link := range t.projectTechnologies {
+		if link.ProjectID == projectID {
+			delete(t.projectTechnologies, link)
+		}
+	}
+	return nil
+}
+
+func (t *tables) DeleteProjectTechnologiesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	for link := range t.projectTechnologies {
+		if t.projects[link.ProjectID].CvProfileID == cvProfileID {
+			delete(t.projectTechnologies, link)
+		}
+	}
+	return nil
+}
+
+// sortTechnologies sorts the technologies by their order field, then by ID to keep the order stable
+func sortTechnologies(technologies []db.Technology) {
+	sort.Slice(technologies, func(i, j int) bool {
+		if technologies[i].OrderField != technologies[j].OrderField {
+			return technologies[i].OrderField < technologies[j].OrderField
+		}
+		return technologies[i].ID < technologies[j].ID
+	})
+}
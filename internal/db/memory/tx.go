@@ -0,0 +1,839 @@
+package memdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"time"
+)
+
+// ListProjectsWithTechnologies returns a list of projects with technologies
+func (s *Store) ListProjectsWithTechnologies(ctx context.Context, arg db.ListProjectsWithTechnologiesParams) ([]db.ListProjectsWithTechnologiesRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rows := []db.ListProjectsWithTechnologiesRow{}
+	for _, project := range s.data.listProjects(arg.CvProfileID, "", arg.Limit, arg.Offset) {
+		row, err := s.data.projectWithTechnologies(ctx, project)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// ListProjectsWithTechnologiesBySkillName returns a list of projects with technologies that used given skill
+func (s *Store) ListProjectsWithTechnologiesBySkillName(ctx context.Context, arg db.ListProjectsWithTechnologiesBySkillNameParams) ([]db.ListProjectsWithTechnologiesBySkillNameRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rows := []db.ListProjectsWithTechnologiesBySkillNameRow{}
+	for _, project := range s.data.listProjects(arg.CvProfileID, utils.Slugify(arg.SkillName), arg.Limit, arg.Offset) {
+		row, err := s.data.projectWithTechnologies(ctx, project)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, db.ListProjectsWithTechnologiesBySkillNameRow(row))
+	}
+	return rows, nil
+}
+
+// ListSkillCategoriesWithSkills returns the categories of the skills of a cv profile in their display order,
+// each with its skills ordered by importance
+func (s *Store) ListSkillCategoriesWithSkills(ctx context.Context, cvProfileID int32) ([]db.ListSkillCategoriesWithSkillsRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	skills, err := s.data.ListSkillsWithCategories(ctx, cvProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []db.ListSkillCategoriesWithSkillsRow{}
+	for _, skill := range skills {
+		if len(rows) == 0 || rows[len(rows)-1].Name != skill.Category {
+			rows = append(rows, db.ListSkillCategoriesWithSkillsRow{
+				Name:          skill.Category,
+				Description:   skill.CategoryDescription,
+				HexThemeColor: skill.CategoryHexThemeColor,
+				DisplayOrder:  skill.CategoryDisplayOrder,
+				Skills:        []db.Skill{},
+			})
+		}
+
+		category := &rows[len(rows)-1]
+		category.Skills = append(category.Skills, s.data.skills[skill.ID])
+	}
+	return rows, nil
+}
+
+// CreateProjectTx creates a project together with its skill and technology links in one transaction
+func (s *Store) CreateProjectTx(ctx context.Context, arg db.CreateProjectTxParams) (db.ListProjectsWithTechnologiesRow, error) {
+	var result db.ListProjectsWithTechnologiesRow
+
+	err := s.execTx(func(t *tables) error {
+		project, err := t.CreateProject(ctx, arg.CreateProjectParams)
+		if err != nil {
+			return err
+		}
+
+		err = t.linkProjectSkills(ctx, project.ID, arg.SkillIDs)
+		if err != nil {
+			return err
+		}
+
+		err = t.linkProjectTechnologies(ctx, project.ID, arg.TechnologyIDs)
+		if err != nil {
+			return err
+		}
+
+		result, err = t.projectWithTechnologies(ctx, project)
+		return err
+	})
+
+	return result, err
+}
+
+// UpdateProjectTx updates a project and replaces its skill and technology links in one transaction
+func (s *Store) UpdateProjectTx(ctx context.Context, arg db.UpdateProjectTxParams) (db.ListProjectsWithTechnologiesRow, error) {
+	var result db.ListProjectsWithTechnologiesRow
+
+	err := s.execTx(func(t *tables) error {
+		project, err := t.UpdateProject(ctx, arg.UpdateProjectParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.SkillIDs != nil {
+			err = t.replaceProjectSkills(ctx, project.ID, arg.SkillIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		if arg.TechnologyIDs != nil {
+			err = t.replaceProjectTechnologies(ctx, project.ID, arg.TechnologyIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err = t.projectWithTechnologies(ctx, project)
+		return err
+	})
+
+	return result, err
+}
+
+// PatchProjectTx updates only the provided fields of a project and replaces its skill and technology links in one transaction
+func (s *Store) PatchProjectTx(ctx context.Context, arg db.PatchProjectTxParams) (db.ListProjectsWithTechnologiesRow, error) {
+	var result db.ListProjectsWithTechnologiesRow
+
+	err := s.execTx(func(t *tables) error {
+		project, err := t.PatchProject(ctx, arg.PatchProjectParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.SkillIDs != nil {
+			err = t.replaceProjectSkills(ctx, project.ID, arg.SkillIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		if arg.TechnologyIDs != nil {
+			err = t.replaceProjectTechnologies(ctx, project.ID, arg.TechnologyIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err = t.projectWithTechnologies(ctx, project)
+		return err
+	})
+
+	return result, err
+}
+
+// DeleteProjectTx deletes a project together with its skill and technology links in one transaction
+func (s *Store) DeleteProjectTx(ctx context.Context, projectID int32) error {
+	return s.execTx(func(t *tables) error {
+		err := t.DeleteProjectSkills(ctx, projectID)
+		if err != nil {
+			return err
+		}
+
+		err = t.DeleteProjectTechnologies(ctx, projectID)
+		if err != nil {
+			return err
+		}
+
+		_, err = t.DeleteProject(ctx, projectID)
+		return err
+	})
+}
+
+// ReplaceProjectSkillsTx replaces all skills of a project in one transaction
+func (s *Store) ReplaceProjectSkillsTx(ctx context.Context, arg db.ReplaceProjectSkillsTxParams) ([]db.ProjectSkill, error) {
+	var result []db.ProjectSkill
+
+	err := s.execTx(func(t *tables) error {
+		_, err := t.GetProject(ctx, arg.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		err = t.replaceProjectSkills(ctx, arg.ProjectID, arg.SkillIDs)
+		if err != nil {
+			return err
+		}
+
+		result, err = t.ListProjectSkills(ctx, arg.ProjectID)
+		return err
+	})
+
+	return result, err
+}
+
+// ReplaceProjectTechnologiesTx replaces all technologies of a project in one transaction
+func (s *Store) ReplaceProjectTechnologiesTx(ctx context.Context, arg db.ReplaceProjectTechnologiesTxParams) ([]db.ListTechnologiesForProjectRow, error) {
+	var result []db.ListTechnologiesForProjectRow
+
+	err := s.execTx(func(t *tables) error {
+		_, err := t.GetProject(ctx, arg.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		err = t.replaceProjectTechnologies(ctx, arg.ProjectID, arg.TechnologyIDs)
+		if err != nil {
+			return err
+		}
+
+		result, err = t.ListTechnologiesForProject(ctx, arg.ProjectID)
+		return err
+	})
+
+	return result, err
+}
+
+// ReorderSkillsTx gives the skills of a category the importances of their positions in one transaction
+func (s *Store) ReorderSkillsTx(ctx context.Context, arg db.ReorderSkillsTxParams) ([]db.Skill, error) {
+	var result []db.Skill
+
+	err := s.execTx(func(t *tables) error {
+		if err := t.checkCvProfileExists(arg.CvProfileID); err != nil {
+			return err
+		}
+
+		params := db.ListSkillsByCategoryParams{
+			CvProfileID: arg.CvProfileID,
+			Category:    arg.Category,
+		}
+		skills, err := t.ListSkillsByCategory(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		current := make([]int32, 0, len(skills))
+		for _, skill := range skills {
+			current = append(current, skill.ID)
+		}
+		if !isPermutation(arg.SkillIDs, current) {
+			return db.ErrOrderMismatch
+		}
+
+		err = t.ReorderSkills(ctx, db.ReorderSkillsParams{
+			SkillIds:    arg.SkillIDs,
+			CvProfileID: arg.CvProfileID,
+			Category:    arg.Category,
+		})
+		if err != nil {
+			return err
+		}
+
+		result, err = t.ListSkillsByCategory(ctx, params)
+		return err
+	})
+
+	return result, err
+}
+
+// ReorderProjectsTx gives the projects of a cv profile the significances of their positions in one transaction
+func (s *Store) ReorderProjectsTx(ctx context.Context, arg db.ReorderProjectsTxParams) ([]db.ListProjectsWithTechnologiesRow, error) {
+	var result []db.ListProjectsWithTechnologiesRow
+
+	err := s.execTx(func(t *tables) error {
+		if err := t.checkCvProfileExists(arg.CvProfileID); err != nil {
+			return err
+		}
+
+		projects, err := t.ListProjectsByCvProfile(ctx, arg.CvProfileID)
+		if err != nil {
+			return err
+		}
+
+		current := make([]int32, 0, len(projects))
+		for _, project := range projects {
+			current = append(current, project.ID)
+		}
+		if !isPermutation(arg.ProjectIDs, current) {
+			return db.ErrOrderMismatch
+		}
+
+		err = t.ReorderProjects(ctx, db.ReorderProjectsParams{
+			ProjectIds:  arg.ProjectIDs,
+			CvProfileID: arg.CvProfileID,
+		})
+		if err != nil {
+			return err
+		}
+
+		projects, err = t.ListProjectsByCvProfile(ctx, arg.CvProfileID)
+		if err != nil {
+			return err
+		}
+
+		result = make([]db.ListProjectsWithTechnologiesRow, 0, len(projects))
+		for _, project := range projects {
+			row, err := t.projectWithTechnologies(ctx, project)
+			if err != nil {
+				return err
+			}
+			result = append(result, row)
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// DeleteCvProfileTx deletes a cv profile together with everything that belongs to it in one transaction
+func (s *Store) DeleteCvProfileTx(ctx context.Context, cvProfileID int32) error {
+	return s.execTx(func(t *tables) error {
+		steps := []func(ctx context.Context, cvProfileID int32) error{
+			t.DeleteProjectSkillsByCvProfile,
+			t.DeleteProjectTechnologiesByCvProfile,
+			t.DeleteCvExperienceSkillsByCvProfile,
+			t.DeleteCvExperienceTechnologiesByCvProfile,
+			t.DeleteProjectsByCvProfile,
+			t.DeleteSkillsByCvProfile,
+			t.DeleteCvExperiencesByCvProfile,
+			t.DeleteCvEducationsByCvProfile,
+		}
+		for _, step := range steps {
+			if err := step(ctx, cvProfileID); err != nil {
+				return err
+			}
+		}
+
+		_, err := t.DeleteCvProfile(ctx, cvProfileID)
+		return err
+	})
+}
+
+// ListCvExperiencesWithDetails returns a list of work experiences with their skills and technologies
+func (s *Store) ListCvExperiencesWithDetails(ctx context.Context, arg db.ListCvExperiencesWithDetailsParams) ([]db.ListCvExperiencesWithDetailsRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	experiences, err := s.data.ListCvExperiences(ctx, db.ListCvExperiencesParams{
+		CvProfileID: arg.CvProfileID,
+		Limit:       arg.Limit,
+		Offset:      arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []db.ListCvExperiencesWithDetailsRow{}
+	for _, experience := range experiences {
+		row, err := s.data.cvExperienceWithDetails(ctx, experience)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// CreateCvExperienceTx creates a work experience together with its skill and technology links in one transaction
+func (s *Store) CreateCvExperienceTx(ctx context.Context, arg db.CreateCvExperienceTxParams) (db.ListCvExperiencesWithDetailsRow, error) {
+	var result db.ListCvExperiencesWithDetailsRow
+
+	err := s.execTx(func(t *tables) error {
+		experience, err := t.CreateCvExperience(ctx, arg.CreateCvExperienceParams)
+		if err != nil {
+			return err
+		}
+
+		err = t.linkCvExperience(ctx, experience.ID, arg.SkillIDs, arg.TechnologyIDs)
+		if err != nil {
+			return err
+		}
+
+		result, err = t.cvExperienceWithDetails(ctx, experience)
+		return err
+	})
+
+	return result, err
+}
+
+// ImportCvProfileTx creates a cv profile together with all of its children in one transaction
+func (s *Store) ImportCvProfileTx(ctx context.Context, arg db.ImportCvProfileTxParams) (db.CvProfile, error) {
+	var result db.CvProfile
+
+	err := s.execTx(func(t *tables) error {
+		var err error
+		result, err = t.CreateCvProfile(ctx, arg.CreateCvProfileParams)
+		if err != nil {
+			return err
+		}
+
+		for _, education := range arg.Educations {
+			education.CvProfileID = result.ID
+			if _, err = t.CreateCvEducation(ctx, education); err != nil {
+				return err
+			}
+		}
+
+		for _, experience := range arg.Experiences {
+			experience.CvProfileID = result.ID
+			if _, err = t.CreateCvExperience(ctx, experience); err != nil {
+				return err
+			}
+		}
+
+		for _, skill := range arg.Skills {
+			skill.CvProfileID = result.ID
+			skill.Slug = utils.Slugify(skill.Name)
+			if _, err = t.CreateSkill(ctx, skill); err != nil {
+				return err
+			}
+		}
+
+		for _, project := range arg.Projects {
+			project.CvProfileID = result.ID
+			created, err := t.CreateProject(ctx, project.CreateProjectParams)
+			if err != nil {
+				return err
+			}
+
+			// a technology can only be linked to the project once
+			for _, name := range db.UniqueNames(project.TechnologyNames) {
+				technology, err := t.GetTechnologyByName(ctx, name)
+				if errors.Is(err, sql.ErrNoRows) {
+					technology, err = t.CreateTechnology(ctx, db.CreateTechnologyParams{Name: name})
+				}
+				if err != nil {
+					return err
+				}
+
+				_, err = t.CreateProjectTechnology(ctx, db.CreateProjectTechnologyParams{
+					ProjectID:    created.ID,
+					TechnologyID: technology.ID,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// SeedCvProfileTx creates or updates a cv profile together with all of its children in one transaction,
+// rows are matched by the same natural keys as in db.SQLStore.SeedCvProfileTx
+func (s *Store) SeedCvProfileTx(ctx context.Context, arg db.SeedCvProfileTxParams) (db.CvProfile, error) {
+	var result db.CvProfile
+
+	err := s.execTx(func(t *tables) error {
+		var err error
+		result, err = t.GetCvProfileByEmail(ctx, arg.Email)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			result, err = t.CreateCvProfile(ctx, arg.CreateCvProfileParams)
+		case err == nil:
+			result, err = t.UpdateCvProfile(ctx, db.UpdateCvProfileParams{
+				ID:             result.ID,
+				Name:           arg.Name,
+				Email:          arg.Email,
+				Phone:          arg.Phone,
+				Address:        arg.Address,
+				LinkedinUrl:    arg.LinkedinUrl,
+				GithubUrl:      arg.GithubUrl,
+				Bio:            arg.Bio,
+				ProfilePicture: arg.ProfilePicture,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, technology := range arg.Technologies {
+			if err = t.upsertTechnology(ctx, technology); err != nil {
+				return err
+			}
+		}
+
+		// the importances of the moved skills are released first, so that they can be swapped
+		for _, skill := range arg.Skills {
+			existing, err := t.GetSkillByName(ctx, db.GetSkillByNameParams{CvProfileID: result.ID, Name: skill.Name})
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if existing.Category != skill.Category || existing.Importance != skill.Importance {
+				if err = t.ReleaseSkillImportance(ctx, existing.ID); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, skill := range arg.Skills {
+			skill.CvProfileID = result.ID
+			if err = t.upsertSkill(ctx, skill); err != nil {
+				return err
+			}
+		}
+
+		for _, education := range arg.Educations {
+			education.CvProfileID = result.ID
+			if err = t.upsertCvEducation(ctx, education); err != nil {
+				return err
+			}
+		}
+
+		for _, experience := range arg.Experiences {
+			experience.CvProfileID = result.ID
+			if err = t.seedCvExperience(ctx, experience); err != nil {
+				return fmt.Errorf("experience %q: %w", experience.Company, err)
+			}
+		}
+
+		for _, project := range arg.Projects {
+			project.CvProfileID = result.ID
+			if err = t.seedProject(ctx, project); err != nil {
+				return fmt.Errorf("project %q: %w", project.Title, err)
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (t *tables) upsertTechnology(ctx context.Context, arg db.CreateTechnologyParams) error {
+	existing, err := t.GetTechnologyByName(ctx, arg.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		_, err = t.CreateTechnology(ctx, arg)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = t.UpdateTechnology(ctx, db.UpdateTechnologyParams{
+		ID:         existing.ID,
+		Url:        arg.Url,
+		OrderField: arg.OrderField,
+	})
+	return err
+}
+
+func (t *tables) upsertSkill(ctx context.Context, arg db.CreateSkillParams) error {
+	arg.Slug = utils.Slugify(arg.Name)
+	existing, err := t.GetSkillByName(ctx, db.GetSkillByNameParams{CvProfileID: arg.CvProfileID, Name: arg.Name})
+	if errors.Is(err, sql.ErrNoRows) {
+		_, err = t.CreateSkill(ctx, arg)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = t.UpdateSkill(ctx, db.UpdateSkillParams{
+		ID:            existing.ID,
+		Name:          arg.Name,
+		Description:   arg.Description,
+		Category:      arg.Category,
+		Importance:    arg.Importance,
+		Image:         arg.Image,
+		HexThemeColor: arg.HexThemeColor,
+		Slug:          arg.Slug,
+	})
+	return err
+}
+
+func (t *tables) upsertCvEducation(ctx context.Context, arg db.CreateCvEducationParams) error {
+	existing, err := t.GetCvEducationByInstitution(ctx, db.GetCvEducationByInstitutionParams{
+		CvProfileID: arg.CvProfileID,
+		Institution: arg.Institution,
+		Degree:      arg.Degree,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		_, err = t.CreateCvEducation(ctx, arg)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = t.UpdateCvEducation(ctx, db.UpdateCvEducationParams{
+		ID:          existing.ID,
+		Institution: arg.Institution,
+		Degree:      arg.Degree,
+		StartDate:   arg.StartDate,
+		EndDate:     arg.EndDate,
+	})
+	return err
+}
+
+// seedCvExperience upserts the experience and replaces its skill and technology links
+func (t *tables) seedCvExperience(ctx context.Context, arg db.SeedCvExperienceParams) error {
+	experience, err := t.GetCvExperienceByCompany(ctx, db.GetCvExperienceByCompanyParams{
+		CvProfileID: arg.CvProfileID,
+		Company:     arg.Company,
+		Position:    arg.Position,
+		StartDate:   arg.StartDate,
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		experience, err = t.CreateCvExperience(ctx, arg.CreateCvExperienceParams)
+	case err == nil:
+		experience, err = t.UpdateCvExperience(ctx, db.UpdateCvExperienceParams{
+			ID:             experience.ID,
+			Company:        arg.Company,
+			Position:       arg.Position,
+			Location:       arg.Location,
+			EmploymentType: arg.EmploymentType,
+			StartDate:      arg.StartDate,
+			EndDate:        arg.EndDate,
+			Achievements:   arg.Achievements,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	skillIDs, err := t.skillIDs(ctx, arg.CvProfileID, arg.SkillNames)
+	if err != nil {
+		return err
+	}
+	technologyIDs, err := t.technologyIDs(ctx, arg.TechnologyNames)
+	if err != nil {
+		return err
+	}
+
+	if err = t.DeleteCvExperienceSkills(ctx, experience.ID); err != nil {
+		return err
+	}
+	if err = t.DeleteCvExperienceTechnologies(ctx, experience.ID); err != nil {
+		return err
+	}
+	return t.linkCvExperience(ctx, experience.ID, skillIDs, technologyIDs)
+}
+
+// seedProject upserts the project and replaces its skill and technology links
+func (t *tables) seedProject(ctx context.Context, arg db.SeedProjectParams) error {
+	project, err := t.GetProjectByTitle(ctx, db.GetProjectByTitleParams{CvProfileID: arg.CvProfileID, Title: arg.Title})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		project, err = t.CreateProject(ctx, arg.CreateProjectParams)
+	case err == nil:
+		project, err = t.UpdateProject(ctx, db.UpdateProjectParams{
+			ID:               project.ID,
+			Title:            arg.Title,
+			ShortDescription: arg.ShortDescription,
+			Description:      arg.Description,
+			Image:            arg.Image,
+			HexThemeColor:    arg.HexThemeColor,
+			ProjectUrl:       arg.ProjectUrl,
+			Significance:     arg.Significance,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	skillIDs, err := t.skillIDs(ctx, arg.CvProfileID, arg.SkillNames)
+	if err != nil {
+		return err
+	}
+	if err = t.replaceProjectSkills(ctx, project.ID, skillIDs); err != nil {
+		return err
+	}
+
+	technologyIDs, err := t.technologyIDs(ctx, arg.TechnologyNames)
+	if err != nil {
+		return err
+	}
+	return t.replaceProjectTechnologies(ctx, project.ID, technologyIDs)
+}
+
+// skillIDs returns the IDs of the named skills of the profile, a repeated name is returned once
+func (t *tables) skillIDs(ctx context.Context, cvProfileID int32, names []string) ([]int32, error) {
+	ids := make([]int32, 0, len(names))
+	for _, name := range db.UniqueNames(names) {
+		skill, err := t.GetSkillByName(ctx, db.GetSkillByNameParams{CvProfileID: cvProfileID, Name: name})
+		if err != nil {
+			return nil, fmt.Errorf("skill %q: %w", name, err)
+		}
+		ids = append(ids, skill.ID)
+	}
+	return ids, nil
+}
+
+// technologyIDs returns the IDs of the named technologies, a repeated name is returned once
+func (t *tables) technologyIDs(ctx context.Context, names []string) ([]int32, error) {
+	ids := make([]int32, 0, len(names))
+	for _, name := range db.UniqueNames(names) {
+		technology, err := t.GetTechnologyByName(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("technology %q: %w", name, err)
+		}
+		ids = append(ids, technology.ID)
+	}
+	return ids, nil
+}
+
+func (t *tables) replaceProjectSkills(ctx context.Context, projectID int32, skillIDs []int32) error {
+	if err := t.DeleteProjectSkills(ctx, projectID); err != nil {
+		return err
+	}
+	return t.linkProjectSkills(ctx, projectID, skillIDs)
+}
+
+func (t *tables) replaceProjectTechnologies(ctx context.Context, projectID int32, technologyIDs []int32) error {
+	if err := t.DeleteProjectTechnologies(ctx, projectID); err != nil {
+		return err
+	}
+	return t.linkProjectTechnologies(ctx, projectID, technologyIDs)
+}
+
+func (t *tables) linkProjectSkills(ctx context.Context, projectID int32, skillIDs []int32) error {
+	for _, skillID := range skillIDs {
+		_, err := t.CreateProjectSkill(ctx, db.CreateProjectSkillParams{ProjectID: projectID, SkillID: skillID})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *tables) linkProjectTechnologies(ctx context.Context, projectID int32, technologyIDs []int32) error {
+	for _, technologyID := range technologyIDs {
+		_, err := t.CreateProjectTechnology(ctx, db.CreateProjectTechnologyParams{ProjectID: projectID, TechnologyID: technologyID})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *tables) linkCvExperience(ctx context.Context, experienceID int32, skillIDs, technologyIDs []int32) error {
+	for _, skillID := range skillIDs {
+		_, err := t.CreateCvExperienceSkill(ctx, db.CreateCvExperienceSkillParams{CvExperienceID: experienceID, SkillID: skillID})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, technologyID := range technologyIDs {
+		_, err := t.CreateCvExperienceTechnology(ctx, db.CreateCvExperienceTechnologyParams{CvExperienceID: experienceID, TechnologyID: technologyID})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// projectWithTechnologies returns the project in the same shape as ListProjectsWithTechnologies
+func (t *tables) projectWithTechnologies(ctx context.Context, project db.Project) (db.ListProjectsWithTechnologiesRow, error) {
+	technologies, err := t.ListTechnologiesForProject(ctx, project.ID)
+	if err != nil {
+		return db.ListProjectsWithTechnologiesRow{}, err
+	}
+
+	return db.ListProjectsWithTechnologiesRow{
+		ID:               project.ID,
+		Title:            project.Title,
+		ShortDescription: project.ShortDescription,
+		Description:      project.Description,
+		Image:            project.Image,
+		HexThemeColor:    project.HexThemeColor,
+		ProjectUrl:       project.ProjectUrl,
+		Significance:     project.Significance,
+		TechnologiesUsed: technologies,
+	}, nil
+}
+
+// cvExperienceWithDetails returns the experience in the same shape as ListCvExperiencesWithDetails
+func (t *tables) cvExperienceWithDetails(ctx context.Context, experience db.CvExperience) (db.ListCvExperiencesWithDetailsRow, error) {
+	skills, err := t.ListSkillsForCvExperience(ctx, experience.ID)
+	if err != nil {
+		return db.ListCvExperiencesWithDetailsRow{}, err
+	}
+
+	technologies, err := t.ListTechnologiesForCvExperience(ctx, experience.ID)
+	if err != nil {
+		return db.ListCvExperiencesWithDetailsRow{}, err
+	}
+
+	var endDate *time.Time
+	if experience.EndDate.Valid {
+		endDate = &experience.EndDate.Time
+	}
+
+	return db.ListCvExperiencesWithDetailsRow{
+		ID:               experience.ID,
+		Company:          experience.Company,
+		Position:         experience.Position,
+		Location:         experience.Location,
+		EmploymentType:   experience.EmploymentType,
+		StartDate:        experience.StartDate,
+		EndDate:          endDate,
+		Achievements:     experience.Achievements,
+		Skills:           skills,
+		TechnologiesUsed: technologies,
+	}, nil
+}
+
+// checkCvProfileExists returns sql.ErrNoRows when there is no cv profile with the ID
+func (t *tables) checkCvProfileExists(cvProfileID int32) error {
+	if _, ok := t.cvProfiles[cvProfileID]; !ok {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// isPermutation reports whether ids lists every ID of current exactly once, in any order
+func isPermutation(ids, current []int32) bool {
+	if len(ids) != len(current) {
+		return false
+	}
+
+	remaining := make(map[int32]bool, len(current))
+	for _, id := range current {
+		remaining[id] = true
+	}
+	for _, id := range ids {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+
+	return true
+}
@@ -0,0 +1,32 @@
+package memdb
+
+import (
+	"context"
+	"database/sql"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"time"
+)
+
+func (t *tables) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	if _, err := t.GetUser(ctx, arg.Username); err == nil {
+		return db.User{}, uniqueViolation("users", "users_username_key")
+	}
+
+	user := db.User{
+		ID:             t.nextID("users"),
+		Username:       arg.Username,
+		HashedPassword: arg.HashedPassword,
+		CreatedAt:      time.Now(),
+	}
+	t.users[user.ID] = user
+	return user, nil
+}
+
+func (t *tables) GetUser(ctx context.Context, username string) (db.User, error) {
+	for _, user := range t.users {
+		if user.Username == username {
+			return user, nil
+		}
+	}
+	return db.User{}, sql.ErrNoRows
+}
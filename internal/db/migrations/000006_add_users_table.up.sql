@@ -0,0 +1,7 @@
+CREATE TABLE users
+(
+    id              SERIAL PRIMARY KEY,
+    username        VARCHAR(255) UNIQUE NOT NULL,
+    hashed_password VARCHAR(255)        NOT NULL,
+    created_at      TIMESTAMPTZ         NOT NULL DEFAULT (NOW())
+);
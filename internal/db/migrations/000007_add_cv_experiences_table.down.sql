@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS cv_experience_technologies;
+
+DROP TABLE IF EXISTS cv_experience_skills;
+
+DROP TABLE IF EXISTS cv_experiences;
\ No newline at end of file
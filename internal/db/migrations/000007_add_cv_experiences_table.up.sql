@@ -0,0 +1,27 @@
+CREATE TABLE cv_experiences
+(
+    id              SERIAL PRIMARY KEY,
+    company         VARCHAR(255)                        NOT NULL,
+    position        VARCHAR(255)                        NOT NULL,
+    location        VARCHAR(255)                        NOT NULL,
+    employment_type VARCHAR(255)                        NOT NULL,
+    start_date      DATE                                NOT NULL,
+    -- NULL for the current job
+    end_date        DATE,
+    achievements    TEXT[]                              NOT NULL DEFAULT '{}',
+    cv_profile_id   INTEGER REFERENCES cv_profiles (id) NOT NULL
+);
+
+CREATE TABLE cv_experience_skills
+(
+    cv_experience_id INTEGER REFERENCES cv_experiences (id) NOT NULL,
+    skill_id         INTEGER REFERENCES skills (id)         NOT NULL,
+    PRIMARY KEY (cv_experience_id, skill_id)
+);
+
+CREATE TABLE cv_experience_technologies
+(
+    cv_experience_id INTEGER REFERENCES cv_experiences (id) NOT NULL,
+    technology_id    INTEGER REFERENCES technologies (id)   NOT NULL,
+    PRIMARY KEY (cv_experience_id, technology_id)
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_cv_profiles_search;
+DROP INDEX IF EXISTS idx_skills_search;
+DROP INDEX IF EXISTS idx_projects_search;
@@ -0,0 +1,15 @@
+-- The expressions have to match the ones in queries/search.sql, otherwise the indexes are not used
+CREATE INDEX idx_projects_search ON projects USING GIN (
+    (setweight(to_tsvector('english', title), 'A') ||
+     setweight(to_tsvector('english', short_description), 'B') ||
+     setweight(to_tsvector('english', description), 'C'))
+    );
+
+CREATE INDEX idx_skills_search ON skills USING GIN (
+    (setweight(to_tsvector('english', name), 'A') ||
+     setweight(to_tsvector('english', description), 'B'))
+    );
+
+CREATE INDEX idx_cv_profiles_search ON cv_profiles USING GIN (
+    (setweight(to_tsvector('english', bio), 'B'))
+    );
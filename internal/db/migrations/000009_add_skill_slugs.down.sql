@@ -0,0 +1,5 @@
+ALTER TABLE skills
+    DROP CONSTRAINT IF EXISTS unique_profile_skill_slug;
+
+ALTER TABLE skills
+    DROP COLUMN IF EXISTS slug;
@@ -0,0 +1,17 @@
+ALTER TABLE skills
+    ADD COLUMN slug VARCHAR(255) NOT NULL DEFAULT '';
+
+-- The slugs are made by utils.Slugify, which SQL cannot match for every name (e.g. "ł" or "ß" are not
+-- decomposed by normalize), so the existing skills get a placeholder slug, unique within the profile,
+-- and the migrator sets their slugs from Go once all migrations are applied.
+-- "_" is never in a slug made by utils.Slugify.
+UPDATE skills
+SET slug = '_' || id;
+
+-- the queries set the slug from the name
+ALTER TABLE skills
+    ALTER COLUMN slug DROP DEFAULT;
+
+-- Skills are looked up by the slug within a profile
+ALTER TABLE skills
+    ADD CONSTRAINT unique_profile_skill_slug UNIQUE (cv_profile_id, slug);
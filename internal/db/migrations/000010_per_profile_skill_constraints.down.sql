@@ -0,0 +1,12 @@
+-- Fails when profiles share skill names or importances, like the constraints it restores
+ALTER TABLE skills
+    DROP CONSTRAINT IF EXISTS unique_profile_skill_category_importance;
+
+ALTER TABLE skills
+    DROP CONSTRAINT IF EXISTS unique_profile_skill_name;
+
+ALTER TABLE skills
+    ADD CONSTRAINT unique_name UNIQUE (name);
+
+ALTER TABLE skills
+    ADD CONSTRAINT unique_category_importance UNIQUE (category, importance);
@@ -0,0 +1,12 @@
+-- Skill names and the importance within a category are unique per cv profile, not across all profiles
+ALTER TABLE skills
+    DROP CONSTRAINT IF EXISTS unique_name;
+
+ALTER TABLE skills
+    DROP CONSTRAINT IF EXISTS unique_category_importance;
+
+ALTER TABLE skills
+    ADD CONSTRAINT unique_profile_skill_name UNIQUE (cv_profile_id, name);
+
+ALTER TABLE skills
+    ADD CONSTRAINT unique_profile_skill_category_importance UNIQUE (cv_profile_id, category, importance);
@@ -0,0 +1,78 @@
+DROP TRIGGER IF EXISTS cv_experience_skills_cv_profile ON cv_experience_skills;
+DROP FUNCTION IF EXISTS check_cv_experience_skill_cv_profile();
+
+DROP TRIGGER IF EXISTS project_skills_cv_profile ON project_skills;
+DROP FUNCTION IF EXISTS check_project_skill_cv_profile();
+
+ALTER TABLE cv_experiences
+    DROP CONSTRAINT IF EXISTS cv_experiences_dates_check;
+
+ALTER TABLE cv_educations
+    DROP CONSTRAINT IF EXISTS cv_educations_dates_check;
+
+ALTER TABLE projects
+    DROP CONSTRAINT IF EXISTS projects_hex_theme_color_check;
+
+ALTER TABLE skills
+    DROP CONSTRAINT IF EXISTS skills_hex_theme_color_check;
+
+DROP INDEX IF EXISTS idx_cv_experience_technologies_technology_id;
+DROP INDEX IF EXISTS idx_cv_experience_skills_skill_id;
+DROP INDEX IF EXISTS idx_project_skills_skill_id;
+DROP INDEX IF EXISTS idx_project_technologies_technology_id;
+DROP INDEX IF EXISTS idx_cv_experiences_cv_profile_id;
+DROP INDEX IF EXISTS idx_projects_cv_profile_id;
+DROP INDEX IF EXISTS idx_cv_educations_cv_profile_id;
+
+-- the foreign keys go back to the default NO ACTION
+ALTER TABLE cv_experience_technologies
+    DROP CONSTRAINT cv_experience_technologies_cv_experience_id_fkey,
+    ADD CONSTRAINT cv_experience_technologies_cv_experience_id_fkey
+        FOREIGN KEY (cv_experience_id) REFERENCES cv_experiences (id),
+    DROP CONSTRAINT cv_experience_technologies_technology_id_fkey,
+    ADD CONSTRAINT cv_experience_technologies_technology_id_fkey
+        FOREIGN KEY (technology_id) REFERENCES technologies (id);
+
+ALTER TABLE cv_experience_skills
+    DROP CONSTRAINT cv_experience_skills_cv_experience_id_fkey,
+    ADD CONSTRAINT cv_experience_skills_cv_experience_id_fkey
+        FOREIGN KEY (cv_experience_id) REFERENCES cv_experiences (id),
+    DROP CONSTRAINT cv_experience_skills_skill_id_fkey,
+    ADD CONSTRAINT cv_experience_skills_skill_id_fkey
+        FOREIGN KEY (skill_id) REFERENCES skills (id);
+
+ALTER TABLE project_skills
+    DROP CONSTRAINT project_skills_project_id_fkey,
+    ADD CONSTRAINT project_skills_project_id_fkey
+        FOREIGN KEY (project_id) REFERENCES projects (id),
+    DROP CONSTRAINT project_skills_skill_id_fkey,
+    ADD CONSTRAINT project_skills_skill_id_fkey
+        FOREIGN KEY (skill_id) REFERENCES skills (id);
+
+ALTER TABLE project_technologies
+    DROP CONSTRAINT project_technologies_project_id_fkey,
+    ADD CONSTRAINT project_technologies_project_id_fkey
+        FOREIGN KEY (project_id) REFERENCES projects (id),
+    DROP CONSTRAINT project_technologies_technology_id_fkey,
+    ADD CONSTRAINT project_technologies_technology_id_fkey
+        FOREIGN KEY (technology_id) REFERENCES technologies (id);
+
+ALTER TABLE cv_experiences
+    DROP CONSTRAINT cv_experiences_cv_profile_id_fkey,
+    ADD CONSTRAINT cv_experiences_cv_profile_id_fkey
+        FOREIGN KEY (cv_profile_id) REFERENCES cv_profiles (id);
+
+ALTER TABLE projects
+    DROP CONSTRAINT projects_cv_profile_id_fkey,
+    ADD CONSTRAINT projects_cv_profile_id_fkey
+        FOREIGN KEY (cv_profile_id) REFERENCES cv_profiles (id);
+
+ALTER TABLE skills
+    DROP CONSTRAINT skills_cv_profile_id_fkey,
+    ADD CONSTRAINT skills_cv_profile_id_fkey
+        FOREIGN KEY (cv_profile_id) REFERENCES cv_profiles (id);
+
+ALTER TABLE cv_educations
+    DROP CONSTRAINT cv_educations_cv_profile_id_fkey,
+    ADD CONSTRAINT cv_educations_cv_profile_id_fkey
+        FOREIGN KEY (cv_profile_id) REFERENCES cv_profiles (id);
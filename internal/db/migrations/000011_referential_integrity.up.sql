@@ -0,0 +1,137 @@
+-- Deleting a cv profile deletes everything that belongs to it, deleting a project, a skill or an experience
+-- deletes its links. Technologies are shared by all profiles, so they cannot be deleted while they are used.
+ALTER TABLE cv_educations
+    DROP CONSTRAINT cv_educations_cv_profile_id_fkey,
+    ADD CONSTRAINT cv_educations_cv_profile_id_fkey
+        FOREIGN KEY (cv_profile_id) REFERENCES cv_profiles (id) ON DELETE CASCADE;
+
+ALTER TABLE skills
+    DROP CONSTRAINT skills_cv_profile_id_fkey,
+    ADD CONSTRAINT skills_cv_profile_id_fkey
+        FOREIGN KEY (cv_profile_id) REFERENCES cv_profiles (id) ON DELETE CASCADE;
+
+ALTER TABLE projects
+    DROP CONSTRAINT projects_cv_profile_id_fkey,
+    ADD CONSTRAINT projects_cv_profile_id_fkey
+        FOREIGN KEY (cv_profile_id) REFERENCES cv_profiles (id) ON DELETE CASCADE;
+
+ALTER TABLE cv_experiences
+    DROP CONSTRAINT cv_experiences_cv_profile_id_fkey,
+    ADD CONSTRAINT cv_experiences_cv_profile_id_fkey
+        FOREIGN KEY (cv_profile_id) REFERENCES cv_profiles (id) ON DELETE CASCADE;
+
+ALTER TABLE project_technologies
+    DROP CONSTRAINT project_technologies_project_id_fkey,
+    ADD CONSTRAINT project_technologies_project_id_fkey
+        FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+    DROP CONSTRAINT project_technologies_technology_id_fkey,
+    ADD CONSTRAINT project_technologies_technology_id_fkey
+        FOREIGN KEY (technology_id) REFERENCES technologies (id) ON DELETE RESTRICT;
+
+ALTER TABLE project_skills
+    DROP CONSTRAINT project_skills_project_id_fkey,
+    ADD CONSTRAINT project_skills_project_id_fkey
+        FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+    DROP CONSTRAINT project_skills_skill_id_fkey,
+    ADD CONSTRAINT project_skills_skill_id_fkey
+        FOREIGN KEY (skill_id) REFERENCES skills (id) ON DELETE CASCADE;
+
+ALTER TABLE cv_experience_skills
+    DROP CONSTRAINT cv_experience_skills_cv_experience_id_fkey,
+    ADD CONSTRAINT cv_experience_skills_cv_experience_id_fkey
+        FOREIGN KEY (cv_experience_id) REFERENCES cv_experiences (id) ON DELETE CASCADE,
+    DROP CONSTRAINT cv_experience_skills_skill_id_fkey,
+    ADD CONSTRAINT cv_experience_skills_skill_id_fkey
+        FOREIGN KEY (skill_id) REFERENCES skills (id) ON DELETE CASCADE;
+
+ALTER TABLE cv_experience_technologies
+    DROP CONSTRAINT cv_experience_technologies_cv_experience_id_fkey,
+    ADD CONSTRAINT cv_experience_technologies_cv_experience_id_fkey
+        FOREIGN KEY (cv_experience_id) REFERENCES cv_experiences (id) ON DELETE CASCADE,
+    DROP CONSTRAINT cv_experience_technologies_technology_id_fkey,
+    ADD CONSTRAINT cv_experience_technologies_technology_id_fkey
+        FOREIGN KEY (technology_id) REFERENCES technologies (id) ON DELETE RESTRICT;
+
+-- Postgres does not index foreign keys. The unique constraints of skills start with cv_profile_id
+-- and the primary keys of the link tables with their first column, so those are indexed already.
+CREATE INDEX idx_cv_educations_cv_profile_id ON cv_educations (cv_profile_id);
+CREATE INDEX idx_projects_cv_profile_id ON projects (cv_profile_id);
+CREATE INDEX idx_cv_experiences_cv_profile_id ON cv_experiences (cv_profile_id);
+CREATE INDEX idx_project_technologies_technology_id ON project_technologies (technology_id);
+CREATE INDEX idx_project_skills_skill_id ON project_skills (skill_id);
+CREATE INDEX idx_cv_experience_skills_skill_id ON cv_experience_skills (skill_id);
+CREATE INDEX idx_cv_experience_technologies_technology_id ON cv_experience_technologies (technology_id);
+
+-- Theme colors are "#rgb" or "#rrggbb", or empty for the default color. Colors saved without the "#" are fixed,
+-- any other invalid value makes the migration fail and has to be corrected by hand.
+UPDATE skills
+SET hex_theme_color = '#' || hex_theme_color
+WHERE hex_theme_color ~ '^([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$';
+
+UPDATE projects
+SET hex_theme_color = '#' || hex_theme_color
+WHERE hex_theme_color ~ '^([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$';
+
+ALTER TABLE skills
+    ADD CONSTRAINT skills_hex_theme_color_check
+        CHECK (hex_theme_color ~ '^(#[0-9A-Fa-f]{3}|#[0-9A-Fa-f]{6})?$');
+
+ALTER TABLE projects
+    ADD CONSTRAINT projects_hex_theme_color_check
+        CHECK (hex_theme_color ~ '^(#[0-9A-Fa-f]{3}|#[0-9A-Fa-f]{6})?$');
+
+ALTER TABLE cv_educations
+    ADD CONSTRAINT cv_educations_dates_check CHECK (end_date >= start_date);
+
+-- the end date of the current job is NULL
+ALTER TABLE cv_experiences
+    ADD CONSTRAINT cv_experiences_dates_check CHECK (end_date IS NULL OR end_date >= start_date);
+
+-- Links between a project or an experience and a skill have to stay within one cv profile.
+-- The triggers raise foreign key violations, like a composite foreign key on the profile would.
+-- Rows with a missing project, experience or skill are left to the foreign keys.
+CREATE FUNCTION check_project_skill_cv_profile() RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS
+$$
+BEGIN
+    IF EXISTS (SELECT 1
+               FROM projects p
+                        JOIN skills s ON s.id = NEW.skill_id
+               WHERE p.id = NEW.project_id
+                 AND p.cv_profile_id <> s.cv_profile_id) THEN
+        RAISE EXCEPTION 'skill % does not belong to the cv profile of project %', NEW.skill_id, NEW.project_id
+            USING ERRCODE = 'foreign_key_violation', CONSTRAINT = 'project_skills_cv_profile_fkey', TABLE = 'project_skills';
+    END IF;
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER project_skills_cv_profile
+    BEFORE INSERT OR UPDATE
+    ON project_skills
+    FOR EACH ROW
+EXECUTE FUNCTION check_project_skill_cv_profile();
+
+CREATE FUNCTION check_cv_experience_skill_cv_profile() RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS
+$$
+BEGIN
+    IF EXISTS (SELECT 1
+               FROM cv_experiences e
+                        JOIN skills s ON s.id = NEW.skill_id
+               WHERE e.id = NEW.cv_experience_id
+                 AND e.cv_profile_id <> s.cv_profile_id) THEN
+        RAISE EXCEPTION 'skill % does not belong to the cv profile of experience %', NEW.skill_id, NEW.cv_experience_id
+            USING ERRCODE = 'foreign_key_violation', CONSTRAINT = 'cv_experience_skills_cv_profile_fkey', TABLE = 'cv_experience_skills';
+    END IF;
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER cv_experience_skills_cv_profile
+    BEFORE INSERT OR UPDATE
+    ON cv_experience_skills
+    FOR EACH ROW
+EXECUTE FUNCTION check_cv_experience_skill_cv_profile();
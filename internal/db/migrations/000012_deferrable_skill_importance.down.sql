@@ -0,0 +1,3 @@
+ALTER TABLE skills
+    DROP CONSTRAINT unique_profile_skill_category_importance,
+    ADD CONSTRAINT unique_profile_skill_category_importance UNIQUE (cv_profile_id, category, importance);
@@ -0,0 +1,6 @@
+-- Reordering the skills of a category swaps their importances, the unique constraint has to be checked
+-- at the end of the statement, or of the transaction after SET CONSTRAINTS, instead of after every row
+ALTER TABLE skills
+    DROP CONSTRAINT unique_profile_skill_category_importance,
+    ADD CONSTRAINT unique_profile_skill_category_importance UNIQUE (cv_profile_id, category, importance)
+        DEFERRABLE INITIALLY IMMEDIATE;
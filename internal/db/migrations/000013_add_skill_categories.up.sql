@@ -0,0 +1,21 @@
+-- The categories of the skills of a profile, skills.category is the name of the category. The skills of a category
+-- that has no row are still listed, after the other categories.
+CREATE TABLE skill_categories
+(
+    id              SERIAL PRIMARY KEY,
+    cv_profile_id   INTEGER      NOT NULL,
+    name            VARCHAR(255) NOT NULL,
+    description     TEXT         NOT NULL DEFAULT '',
+    hex_theme_color VARCHAR(255) NOT NULL DEFAULT '',
+    display_order   INTEGER      NOT NULL DEFAULT 0,
+    CONSTRAINT skill_categories_cv_profile_id_fkey
+        FOREIGN KEY (cv_profile_id) REFERENCES cv_profiles (id) ON DELETE CASCADE,
+    CONSTRAINT unique_profile_skill_category_name UNIQUE (cv_profile_id, name),
+    CONSTRAINT skill_categories_hex_theme_color_check
+        CHECK (hex_theme_color ~ '^(#[0-9A-Fa-f]{3}|#[0-9A-Fa-f]{6})?$')
+);
+
+-- the existing categories are ordered by name
+INSERT INTO skill_categories (cv_profile_id, name, display_order)
+SELECT cv_profile_id, category, ROW_NUMBER() OVER (PARTITION BY cv_profile_id ORDER BY category)
+FROM (SELECT DISTINCT cv_profile_id, category FROM skills) AS categories;
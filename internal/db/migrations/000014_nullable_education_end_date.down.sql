@@ -0,0 +1,9 @@
+-- the current education ends today, or on its start date if it starts in the future
+UPDATE cv_educations
+SET end_date = GREATEST(start_date, CURRENT_DATE)
+WHERE end_date IS NULL;
+
+ALTER TABLE cv_educations
+    ALTER COLUMN end_date SET NOT NULL,
+    DROP CONSTRAINT cv_educations_dates_check,
+    ADD CONSTRAINT cv_educations_dates_check CHECK (end_date >= start_date);
@@ -0,0 +1,5 @@
+-- The end date of the current education is NULL, like the end date of the current job
+ALTER TABLE cv_educations
+    ALTER COLUMN end_date DROP NOT NULL,
+    DROP CONSTRAINT cv_educations_dates_check,
+    ADD CONSTRAINT cv_educations_dates_check CHECK (end_date IS NULL OR end_date >= start_date);
@@ -0,0 +1,209 @@
+// Package migrations embeds the SQL migrations of the database schema and runs them with golang-migrate.
+// SQLite has its own set in the sqlite directory, with the same versions as the Postgres set.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+//go:embed sqlite/*.sql
+var sqliteFiles embed.FS
+
+// Migrator runs the embedded migrations against a database
+type Migrator struct {
+	migrate *migrate.Migrate
+	// run runs the migrations of Up and Down, the sqlite migrator turns the foreign keys off around them
+	run func(migrations func() error) error
+	// afterUp runs once Up has applied the migrations, the postgres migrator sets the skill slugs from Go
+	afterUp func() error
+}
+
+// NewMigrator creates a migrator for db, driverName is the database/sql driver of db - "postgres" or "sqlite".
+// Postgres migrations use a single connection of db, closing the migrator releases it and leaves db open.
+func NewMigrator(ctx context.Context, driverName string, db *sql.DB) (*Migrator, error) {
+	switch driverName {
+	case "postgres":
+		return newPostgresMigrator(ctx, db)
+	case "sqlite":
+		return newSQLiteMigrator(db)
+	default:
+		return nil, fmt.Errorf("no migrations for driver %q", driverName)
+	}
+}
+
+func newPostgresMigrator(ctx context.Context, db *sql.DB) (*Migrator, error) {
+	source, err := iofs.New(files, ".")
+	if err != nil {
+		return nil, fmt.Errorf("cannot read migrations: %w", err)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get db connection: %w", err)
+	}
+
+	driver, err := postgres.WithConnection(ctx, conn, &postgres.Config{})
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("cannot create migration driver: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", source, "postgres", driver)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("cannot create migrator: %w", err)
+	}
+
+	return &Migrator{
+		migrate: m,
+		run:     func(migrations func() error) error { return migrations() },
+		afterUp: func() error { return backfillSkillSlugs(ctx, conn) },
+	}, nil
+}
+
+func newSQLiteMigrator(db *sql.DB) (*Migrator, error) {
+	source, err := iofs.New(sqliteFiles, "sqlite")
+	if err != nil {
+		return nil, fmt.Errorf("cannot read migrations: %w", err)
+	}
+
+	driver, err := sqlite.WithInstance(db, &sqlite.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create migration driver: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", source, "sqlite", keepOpen{Driver: driver})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create migrator: %w", err)
+	}
+
+	return &Migrator{migrate: m, run: withoutForeignKeys(db), afterUp: func() error { return nil }}, nil
+}
+
+// withoutForeignKeys runs the migrations with the foreign keys off, the migrations that rebuild a table
+// to change its constraints drop the old table while other tables still reference it.
+// The pragma cannot be changed inside the transaction of a migration and it is set per connection,
+// so it only works on a db with a single connection, as opened by sqlitedb.Open.
+// The foreign keys are checked once the migrations are done.
+func withoutForeignKeys(db *sql.DB) func(migrations func() error) error {
+	return func(migrations func() error) (err error) {
+		if _, err := db.Exec("PRAGMA foreign_keys = OFF"); err != nil {
+			return fmt.Errorf("cannot turn the foreign keys off: %w", err)
+		}
+		defer func() {
+			if _, onErr := db.Exec("PRAGMA foreign_keys = ON"); onErr != nil && err == nil {
+				err = fmt.Errorf("cannot turn the foreign keys on: %w", onErr)
+			}
+		}()
+
+		if err := migrations(); err != nil {
+			return err
+		}
+
+		rows, err := db.Query("PRAGMA foreign_key_check")
+		if err != nil {
+			return fmt.Errorf("cannot check the foreign keys: %w", err)
+		}
+		defer rows.Close()
+		if rows.Next() {
+			return errors.New("the migrations left rows that violate a foreign key")
+		}
+		return rows.Err()
+	}
+}
+
+// keepOpen does not close the db when the migrator is closed, the sqlite driver works on
+// the whole *sql.DB instead of a single connection and the db is still used afterwards
+type keepOpen struct {
+	database.Driver
+}
+
+func (keepOpen) Close() error {
+	return nil
+}
+
+// Up applies all migrations that have not been applied yet, it is not an error when there are none
+func (m *Migrator) Up() error {
+	err := m.run(func() error {
+		if err := m.migrate.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return m.afterUp()
+}
+
+// Down rolls back the last n applied migrations
+func (m *Migrator) Down(n int) error {
+	if n < 1 {
+		return fmt.Errorf("the number of migrations to roll back must be positive, got %d", n)
+	}
+	return m.run(func() error { return m.migrate.Steps(-n) })
+}
+
+// Version returns the current version of the schema and whether the last migration failed,
+// the version is 0 when no migration has been applied
+func (m *Migrator) Version() (version uint, dirty bool, err error) {
+	version, dirty, err = m.migrate.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// Close releases the connection used by the migrator
+func (m *Migrator) Close() error {
+	sourceErr, dbErr := m.migrate.Close()
+	if sourceErr != nil {
+		return sourceErr
+	}
+	return dbErr
+}
+
+// LatestVersion returns the version of the newest embedded migration, it is the version the code expects
+func LatestVersion() uint {
+	return latestVersion(files, ".")
+}
+
+func latestVersion(fsys fs.FS, dir string) uint {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return 0
+	}
+
+	var latest uint
+	for _, entry := range entries {
+		// file names look like 000008_add_full_text_search_indexes.up.sql
+		prefix, _, ok := strings.Cut(entry.Name(), "_")
+		if !ok {
+			continue
+		}
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			continue
+		}
+		if uint(version) > latest {
+			latest = uint(version)
+		}
+	}
+
+	return latest
+}
@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"github.com/stretchr/testify/require"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	up, down := readMigrations(t, files, ".")
+
+	// versions are sequential, so the latest one is the number of migrations
+	require.NotZero(t, LatestVersion())
+	require.Len(t, up, int(LatestVersion()))
+	require.Equal(t, up, down)
+}
+
+func TestEmbeddedSQLiteMigrations(t *testing.T) {
+	up, down := readMigrations(t, sqliteFiles, "sqlite")
+	require.NotEmpty(t, up)
+	require.Equal(t, up, down)
+
+	// both databases have to report the version the code expects
+	require.Equal(t, LatestVersion(), latestVersion(sqliteFiles, "sqlite"))
+}
+
+// readMigrations returns the names of the up and down migrations in dir, without the suffixes
+func readMigrations(t *testing.T, fsys fs.FS, dir string) (up, down map[string]bool) {
+	entries, err := fs.ReadDir(fsys, dir)
+	require.NoError(t, err)
+
+	// every migration has both directions
+	up = make(map[string]bool)
+	down = make(map[string]bool)
+	for _, entry := range entries {
+		name := entry.Name()
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			up[strings.TrimSuffix(name, ".up.sql")] = true
+		case strings.HasSuffix(name, ".down.sql"):
+			down[strings.TrimSuffix(name, ".down.sql")] = true
+		}
+	}
+
+	return up, down
+}
@@ -0,0 +1,114 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"strings"
+)
+
+// skillSlugPlaceholder starts the slugs that 000009 gives the existing skills on Postgres,
+// it is never in a slug made by utils.Slugify
+const skillSlugPlaceholder = "_"
+
+// skillSlugRow is a skill as read by backfillSkillSlugs
+type skillSlugRow struct {
+	id          int32
+	cvProfileID int32
+	name        string
+	slug        string
+}
+
+// backfillSkillSlugs replaces the placeholder slugs of 000009 with the slugs made by utils.Slugify.
+// It runs after every Up of the Postgres migrator and does nothing once there are no placeholders left,
+// so a backfill that did not finish is completed by the next Up.
+func backfillSkillSlugs(ctx context.Context, conn *sql.Conn) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin the skill slugs backfill: %w", err)
+	}
+	defer tx.Rollback()
+
+	// other migrators and new skills wait for the backfill, so the slugs do not change while it runs
+	if _, err := tx.ExecContext(ctx, "LOCK TABLE skills IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		return fmt.Errorf("cannot lock the skills: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, "SELECT id, cv_profile_id, name, slug FROM skills ORDER BY id")
+	if err != nil {
+		return fmt.Errorf("cannot read the skills: %w", err)
+	}
+	defer rows.Close()
+
+	var skills []skillSlugRow
+	for rows.Next() {
+		var skill skillSlugRow
+		if err := rows.Scan(&skill.id, &skill.cvProfileID, &skill.name, &skill.slug); err != nil {
+			return fmt.Errorf("cannot read the skills: %w", err)
+		}
+		skills = append(skills, skill)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("cannot read the skills: %w", err)
+	}
+
+	slugs, err := skillSlugs(skills)
+	if err != nil {
+		return err
+	}
+	if len(slugs) == 0 {
+		return nil
+	}
+
+	for _, skill := range skills {
+		slug, ok := slugs[skill.id]
+		if !ok {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE skills SET slug = $2 WHERE id = $1", skill.id, slug); err != nil {
+			return fmt.Errorf("cannot set the slug of skill %d: %w", skill.id, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// skillSlugs returns the new slugs of the skills that have a placeholder slug, by the id of the skill.
+// skills are all skills of all profiles, sorted by id.
+// Names that differ only in case, accents or punctuation have the same slug, e.g. "Go" and "go",
+// the skill with the lowest id keeps the slug and the others get their id appended to it,
+// the same as the SQLite migration does.
+func skillSlugs(skills []skillSlugRow) (map[int32]string, error) {
+	type profileSlug struct {
+		cvProfileID int32
+		slug        string
+	}
+
+	taken := make(map[profileSlug]bool)
+	for _, skill := range skills {
+		if !strings.HasPrefix(skill.slug, skillSlugPlaceholder) {
+			taken[profileSlug{skill.cvProfileID, skill.slug}] = true
+		}
+	}
+
+	slugs := make(map[int32]string)
+	for _, skill := range skills {
+		if !strings.HasPrefix(skill.slug, skillSlugPlaceholder) {
+			continue
+		}
+
+		slug := utils.Slugify(skill.name)
+		if taken[profileSlug{skill.cvProfileID, slug}] {
+			slug = fmt.Sprintf("%s-%d", slug, skill.id)
+		}
+		if taken[profileSlug{skill.cvProfileID, slug}] {
+			return nil, fmt.Errorf("cannot slug skill %d %q: cv profile %d already has a skill with the slug %q, rename one of them", skill.id, skill.name, skill.cvProfileID, slug)
+		}
+
+		taken[profileSlug{skill.cvProfileID, slug}] = true
+		slugs[skill.id] = slug
+	}
+
+	return slugs, nil
+}
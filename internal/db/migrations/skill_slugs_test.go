@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSkillSlugs(t *testing.T) {
+	skills := []skillSlugRow{
+		{id: 1, cvProfileID: 1, name: "Go", slug: "_1"},
+		{id: 2, cvProfileID: 1, name: "Łódź", slug: "_2"},
+		{id: 3, cvProfileID: 1, name: "go", slug: "_3"},
+		{id: 4, cvProfileID: 2, name: "go", slug: "_4"},
+		{id: 5, cvProfileID: 1, name: "!?", slug: "_5"},
+		// skills created after the migration already have their slugs
+		{id: 6, cvProfileID: 2, name: "C++", slug: "c-plus-plus"},
+		{id: 7, cvProfileID: 2, name: "c++", slug: "_7"},
+	}
+
+	slugs, err := skillSlugs(skills)
+	require.NoError(t, err)
+	require.Equal(t, map[int32]string{
+		1: "go",
+		2: "lodz",
+		// the skill with the lowest id keeps the slug within a profile
+		3: "go-3",
+		4: "go",
+		5: "92c34329",
+		7: "c-plus-plus-7",
+	}, slugs)
+
+	// there is nothing to do once every skill has a slug
+	slugs, err = skillSlugs([]skillSlugRow{{id: 1, cvProfileID: 1, name: "Go", slug: "go"}})
+	require.NoError(t, err)
+	require.Empty(t, slugs)
+}
+
+func TestSkillSlugsTaken(t *testing.T) {
+	// "Go 2" already has the slug that the second "go" would get
+	skills := []skillSlugRow{
+		{id: 1, cvProfileID: 1, name: "Go", slug: "_1"},
+		{id: 2, cvProfileID: 1, name: "go", slug: "_2"},
+		{id: 3, cvProfileID: 1, name: "Go 2", slug: "go-2"},
+	}
+
+	_, err := skillSlugs(skills)
+	require.ErrorContains(t, err, `skill 2 "go"`)
+}
@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS cv_profiles_search;
+DROP TABLE IF EXISTS skills_search;
+DROP TABLE IF EXISTS projects_search;
+DROP TABLE IF EXISTS cv_experience_technologies;
+DROP TABLE IF EXISTS cv_experience_skills;
+DROP TABLE IF EXISTS cv_experiences;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS project_skills;
+DROP TABLE IF EXISTS project_technologies;
+DROP TABLE IF EXISTS technologies;
+DROP TABLE IF EXISTS projects;
+DROP TABLE IF EXISTS skills;
+DROP TABLE IF EXISTS cv_educations;
+DROP TABLE IF EXISTS cv_profiles;
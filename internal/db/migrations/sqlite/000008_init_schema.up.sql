@@ -0,0 +1,351 @@
+-- The SQLite schema starts at version 8 of the Postgres schema. Every later change is a migration
+-- with the same version in both sets, so both databases report the version the code expects.
+--
+-- SQLite does not report which foreign key is broken, so the triggers below raise the names
+-- of the Postgres constraints and errors are handled the same way for both databases.
+
+CREATE TABLE cv_profiles
+(
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    name            TEXT      NOT NULL,
+    email           TEXT      NOT NULL,
+    phone           TEXT      NOT NULL,
+    address         TEXT      NOT NULL,
+    linkedin_url    TEXT,
+    github_url      TEXT      NOT NULL,
+    bio             TEXT      NOT NULL,
+    created_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    profile_picture TEXT      NOT NULL
+);
+
+CREATE TABLE cv_educations
+(
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    institution   TEXT    NOT NULL,
+    degree        TEXT    NOT NULL,
+    start_date    DATE    NOT NULL,
+    end_date      DATE    NOT NULL,
+    cv_profile_id INTEGER NOT NULL CONSTRAINT cv_educations_cv_profile_id_fkey REFERENCES cv_profiles (id)
+);
+
+CREATE TABLE skills
+(
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    name            TEXT    NOT NULL,
+    description     TEXT    NOT NULL,
+    category        TEXT    NOT NULL,
+    image           TEXT    NOT NULL,
+    hex_theme_color TEXT    NOT NULL,
+    cv_profile_id   INTEGER NOT NULL CONSTRAINT skills_cv_profile_id_fkey REFERENCES cv_profiles (id),
+    importance      INTEGER NOT NULL DEFAULT 1,
+    CONSTRAINT unique_name UNIQUE (name),
+    CONSTRAINT unique_category_importance UNIQUE (category, importance)
+);
+
+CREATE INDEX idx_skills_name ON skills (name);
+
+CREATE TABLE projects
+(
+    id                INTEGER PRIMARY KEY AUTOINCREMENT,
+    title             TEXT    NOT NULL,
+    short_description TEXT    NOT NULL,
+    description       TEXT    NOT NULL,
+    image             TEXT    NOT NULL,
+    hex_theme_color   TEXT    NOT NULL,
+    project_url       TEXT    NOT NULL,
+    cv_profile_id     INTEGER NOT NULL CONSTRAINT projects_cv_profile_id_fkey REFERENCES cv_profiles (id),
+    significance      INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE technologies
+(
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    name        TEXT    NOT NULL,
+    url         TEXT    NOT NULL,
+    order_field INTEGER NOT NULL
+);
+
+CREATE TABLE project_technologies
+(
+    project_id    INTEGER NOT NULL CONSTRAINT project_technologies_project_id_fkey REFERENCES projects (id),
+    technology_id INTEGER NOT NULL CONSTRAINT project_technologies_technology_id_fkey REFERENCES technologies (id),
+    PRIMARY KEY (project_id, technology_id)
+);
+
+CREATE TABLE project_skills
+(
+    project_id INTEGER NOT NULL CONSTRAINT project_skills_project_id_fkey REFERENCES projects (id),
+    skill_id   INTEGER NOT NULL CONSTRAINT project_skills_skill_id_fkey REFERENCES skills (id),
+    PRIMARY KEY (project_id, skill_id)
+);
+
+CREATE TABLE users
+(
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    username        TEXT      NOT NULL CONSTRAINT users_username_key UNIQUE,
+    hashed_password TEXT      NOT NULL,
+    created_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE cv_experiences
+(
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    company         TEXT    NOT NULL,
+    position        TEXT    NOT NULL,
+    location        TEXT    NOT NULL,
+    employment_type TEXT    NOT NULL,
+    start_date      DATE    NOT NULL,
+    -- NULL for the current job
+    end_date        DATE,
+    -- a JSON array of strings
+    achievements    TEXT    NOT NULL DEFAULT '[]',
+    cv_profile_id   INTEGER NOT NULL CONSTRAINT cv_experiences_cv_profile_id_fkey REFERENCES cv_profiles (id)
+);
+
+CREATE TABLE cv_experience_skills
+(
+    cv_experience_id INTEGER NOT NULL CONSTRAINT cv_experience_skills_cv_experience_id_fkey REFERENCES cv_experiences (id),
+    skill_id         INTEGER NOT NULL CONSTRAINT cv_experience_skills_skill_id_fkey REFERENCES skills (id),
+    PRIMARY KEY (cv_experience_id, skill_id)
+);
+
+CREATE TABLE cv_experience_technologies
+(
+    cv_experience_id INTEGER NOT NULL CONSTRAINT cv_experience_technologies_cv_experience_id_fkey REFERENCES cv_experiences (id),
+    technology_id    INTEGER NOT NULL CONSTRAINT cv_experience_technologies_technology_id_fkey REFERENCES technologies (id),
+    PRIMARY KEY (cv_experience_id, technology_id)
+);
+
+-- Inserting a row that references a missing row
+CREATE TRIGGER cv_educations_fkey
+    BEFORE INSERT
+    ON cv_educations
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_educations_cv_profile_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_profiles WHERE id = NEW.cv_profile_id);
+END;
+
+CREATE TRIGGER skills_fkey
+    BEFORE INSERT
+    ON skills
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: skills_cv_profile_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_profiles WHERE id = NEW.cv_profile_id);
+END;
+
+CREATE TRIGGER projects_fkey
+    BEFORE INSERT
+    ON projects
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: projects_cv_profile_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_profiles WHERE id = NEW.cv_profile_id);
+END;
+
+CREATE TRIGGER cv_experiences_fkey
+    BEFORE INSERT
+    ON cv_experiences
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experiences_cv_profile_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_profiles WHERE id = NEW.cv_profile_id);
+END;
+
+CREATE TRIGGER project_technologies_fkey
+    BEFORE INSERT
+    ON project_technologies
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_technologies_project_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM projects WHERE id = NEW.project_id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_technologies_technology_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM technologies WHERE id = NEW.technology_id);
+END;
+
+CREATE TRIGGER project_skills_fkey
+    BEFORE INSERT
+    ON project_skills
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_skills_project_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM projects WHERE id = NEW.project_id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_skills_skill_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM skills WHERE id = NEW.skill_id);
+END;
+
+CREATE TRIGGER cv_experience_skills_fkey
+    BEFORE INSERT
+    ON cv_experience_skills
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_skills_cv_experience_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_experiences WHERE id = NEW.cv_experience_id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_skills_skill_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM skills WHERE id = NEW.skill_id);
+END;
+
+CREATE TRIGGER cv_experience_technologies_fkey
+    BEFORE INSERT
+    ON cv_experience_technologies
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_technologies_cv_experience_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_experiences WHERE id = NEW.cv_experience_id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_technologies_technology_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM technologies WHERE id = NEW.technology_id);
+END;
+
+-- Deleting a row that is still referenced
+CREATE TRIGGER cv_profiles_referenced
+    BEFORE DELETE
+    ON cv_profiles
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_educations_cv_profile_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_educations WHERE cv_profile_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: skills_cv_profile_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM skills WHERE cv_profile_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: projects_cv_profile_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM projects WHERE cv_profile_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experiences_cv_profile_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_experiences WHERE cv_profile_id = OLD.id);
+END;
+
+CREATE TRIGGER projects_referenced
+    BEFORE DELETE
+    ON projects
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_technologies_project_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM project_technologies WHERE project_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_skills_project_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM project_skills WHERE project_id = OLD.id);
+END;
+
+CREATE TRIGGER skills_referenced
+    BEFORE DELETE
+    ON skills
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_skills_skill_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM project_skills WHERE skill_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_skills_skill_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_experience_skills WHERE skill_id = OLD.id);
+END;
+
+CREATE TRIGGER technologies_referenced
+    BEFORE DELETE
+    ON technologies
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_technologies_technology_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM project_technologies WHERE technology_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_technologies_technology_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_experience_technologies WHERE technology_id = OLD.id);
+END;
+
+CREATE TRIGGER cv_experiences_referenced
+    BEFORE DELETE
+    ON cv_experiences
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_skills_cv_experience_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_experience_skills WHERE cv_experience_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_technologies_cv_experience_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_experience_technologies WHERE cv_experience_id = OLD.id);
+END;
+
+-- Full text search, the external content tables index the text columns of projects, skills and cv_profiles
+CREATE VIRTUAL TABLE projects_search USING fts5
+(
+    title,
+    short_description,
+    description,
+    content = 'projects',
+    content_rowid = 'id',
+    tokenize = 'porter unicode61'
+);
+
+CREATE TRIGGER projects_search_insert
+    AFTER INSERT
+    ON projects
+BEGIN
+    INSERT INTO projects_search (rowid, title, short_description, description)
+    VALUES (NEW.id, NEW.title, NEW.short_description, NEW.description);
+END;
+
+CREATE TRIGGER projects_search_update
+    AFTER UPDATE
+    ON projects
+BEGIN
+    INSERT INTO projects_search (projects_search, rowid, title, short_description, description)
+    VALUES ('delete', OLD.id, OLD.title, OLD.short_description, OLD.description);
+    INSERT INTO projects_search (rowid, title, short_description, description)
+    VALUES (NEW.id, NEW.title, NEW.short_description, NEW.description);
+END;
+
+CREATE TRIGGER projects_search_delete
+    AFTER DELETE
+    ON projects
+BEGIN
+    INSERT INTO projects_search (projects_search, rowid, title, short_description, description)
+    VALUES ('delete', OLD.id, OLD.title, OLD.short_description, OLD.description);
+END;
+
+CREATE VIRTUAL TABLE skills_search USING fts5
+(
+    name,
+    description,
+    content = 'skills',
+    content_rowid = 'id',
+    tokenize = 'porter unicode61'
+);
+
+CREATE TRIGGER skills_search_insert
+    AFTER INSERT
+    ON skills
+BEGIN
+    INSERT INTO skills_search (rowid, name, description)
+    VALUES (NEW.id, NEW.name, NEW.description);
+END;
+
+CREATE TRIGGER skills_search_update
+    AFTER UPDATE
+    ON skills
+BEGIN
+    INSERT INTO skills_search (skills_search, rowid, name, description)
+    VALUES ('delete', OLD.id, OLD.name, OLD.description);
+    INSERT INTO skills_search (rowid, name, description)
+    VALUES (NEW.id, NEW.name, NEW.description);
+END;
+
+CREATE TRIGGER skills_search_delete
+    AFTER DELETE
+    ON skills
+BEGIN
+    INSERT INTO skills_search (skills_search, rowid, name, description)
+    VALUES ('delete', OLD.id, OLD.name, OLD.description);
+END;
+
+CREATE VIRTUAL TABLE cv_profiles_search USING fts5
+(
+    bio,
+    content = 'cv_profiles',
+    content_rowid = 'id',
+    tokenize = 'porter unicode61'
+);
+
+CREATE TRIGGER cv_profiles_search_insert
+    AFTER INSERT
+    ON cv_profiles
+BEGIN
+    INSERT INTO cv_profiles_search (rowid, bio)
+    VALUES (NEW.id, NEW.bio);
+END;
+
+CREATE TRIGGER cv_profiles_search_update
+    AFTER UPDATE
+    ON cv_profiles
+BEGIN
+    INSERT INTO cv_profiles_search (cv_profiles_search, rowid, bio)
+    VALUES ('delete', OLD.id, OLD.bio);
+    INSERT INTO cv_profiles_search (rowid, bio)
+    VALUES (NEW.id, NEW.bio);
+END;
+
+CREATE TRIGGER cv_profiles_search_delete
+    AFTER DELETE
+    ON cv_profiles
+BEGIN
+    INSERT INTO cv_profiles_search (cv_profiles_search, rowid, bio)
+    VALUES ('delete', OLD.id, OLD.bio);
+END;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS unique_profile_skill_slug;
+
+ALTER TABLE skills
+    DROP COLUMN slug;
@@ -0,0 +1,19 @@
+-- skill_slug is registered by the sqlitedb package, it is utils.Slugify
+ALTER TABLE skills
+    ADD COLUMN slug TEXT NOT NULL DEFAULT '';
+
+UPDATE skills
+SET slug = skill_slug(name);
+
+-- Names that differ only in case, accents or punctuation have the same slug, e.g. "Go" and "go",
+-- the skill with the lowest id keeps the slug and the others get their id appended to it,
+-- the same as the Postgres migrator does
+UPDATE skills
+SET slug = slug || '-' || id
+WHERE EXISTS (SELECT 1
+              FROM skills AS other
+              WHERE other.cv_profile_id = skills.cv_profile_id
+                AND other.slug = skills.slug
+                AND other.id < skills.id);
+
+CREATE UNIQUE INDEX unique_profile_skill_slug ON skills (cv_profile_id, slug);
@@ -0,0 +1,79 @@
+-- Skill names and the importance within a category are unique per cv profile, not across all profiles.
+-- SQLite cannot drop constraints, so the table is rebuilt. The migrator turns the foreign keys off while the
+-- migrations run, and legacy_alter_table keeps the rename from checking the triggers that reference skills.
+PRAGMA legacy_alter_table = ON;
+
+CREATE TABLE skills_new
+(
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    name            TEXT    NOT NULL,
+    description     TEXT    NOT NULL,
+    category        TEXT    NOT NULL,
+    image           TEXT    NOT NULL,
+    hex_theme_color TEXT    NOT NULL,
+    cv_profile_id   INTEGER NOT NULL CONSTRAINT skills_cv_profile_id_fkey REFERENCES cv_profiles (id),
+    importance      INTEGER NOT NULL DEFAULT 1,
+    slug            TEXT    NOT NULL DEFAULT '',
+    CONSTRAINT unique_profile_skill_name UNIQUE (cv_profile_id, name),
+    CONSTRAINT unique_profile_skill_category_importance UNIQUE (cv_profile_id, category, importance)
+);
+
+INSERT INTO skills_new (id, name, description, category, image, hex_theme_color, cv_profile_id, importance, slug)
+SELECT id, name, description, category, image, hex_theme_color, cv_profile_id, importance, slug
+FROM skills;
+
+DROP TABLE skills;
+
+ALTER TABLE skills_new
+    RENAME TO skills;
+
+PRAGMA legacy_alter_table = OFF;
+
+-- the indexes and triggers were dropped with the old table
+CREATE INDEX idx_skills_name ON skills (name);
+
+CREATE UNIQUE INDEX unique_profile_skill_slug ON skills (cv_profile_id, slug);
+
+CREATE TRIGGER skills_fkey
+    BEFORE INSERT
+    ON skills
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: skills_cv_profile_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_profiles WHERE id = NEW.cv_profile_id);
+END;
+
+CREATE TRIGGER skills_referenced
+    BEFORE DELETE
+    ON skills
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_skills_skill_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM project_skills WHERE skill_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_skills_skill_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_experience_skills WHERE skill_id = OLD.id);
+END;
+
+CREATE TRIGGER skills_search_insert
+    AFTER INSERT
+    ON skills
+BEGIN
+    INSERT INTO skills_search (rowid, name, description)
+    VALUES (NEW.id, NEW.name, NEW.description);
+END;
+
+CREATE TRIGGER skills_search_update
+    AFTER UPDATE
+    ON skills
+BEGIN
+    INSERT INTO skills_search (skills_search, rowid, name, description)
+    VALUES ('delete', OLD.id, OLD.name, OLD.description);
+    INSERT INTO skills_search (rowid, name, description)
+    VALUES (NEW.id, NEW.name, NEW.description);
+END;
+
+CREATE TRIGGER skills_search_delete
+    AFTER DELETE
+    ON skills
+BEGIN
+    INSERT INTO skills_search (skills_search, rowid, name, description)
+    VALUES ('delete', OLD.id, OLD.name, OLD.description);
+END;
@@ -0,0 +1,333 @@
+-- Rebuilds the tables without the cascades and checks, see the up migration
+PRAGMA legacy_alter_table = ON;
+
+CREATE TABLE cv_educations_new
+(
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    institution   TEXT    NOT NULL,
+    degree        TEXT    NOT NULL,
+    start_date    DATE    NOT NULL,
+    end_date      DATE    NOT NULL,
+    cv_profile_id INTEGER NOT NULL CONSTRAINT cv_educations_cv_profile_id_fkey REFERENCES cv_profiles (id)
+);
+
+INSERT INTO cv_educations_new (id, institution, degree, start_date, end_date, cv_profile_id)
+SELECT id, institution, degree, start_date, end_date, cv_profile_id
+FROM cv_educations;
+
+DROP TABLE cv_educations;
+
+ALTER TABLE cv_educations_new
+    RENAME TO cv_educations;
+
+CREATE TABLE skills_new
+(
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    name            TEXT    NOT NULL,
+    description     TEXT    NOT NULL,
+    category        TEXT    NOT NULL,
+    image           TEXT    NOT NULL,
+    hex_theme_color TEXT    NOT NULL,
+    cv_profile_id   INTEGER NOT NULL CONSTRAINT skills_cv_profile_id_fkey REFERENCES cv_profiles (id),
+    importance      INTEGER NOT NULL DEFAULT 1,
+    slug            TEXT    NOT NULL DEFAULT '',
+    CONSTRAINT unique_profile_skill_name UNIQUE (cv_profile_id, name),
+    CONSTRAINT unique_profile_skill_category_importance UNIQUE (cv_profile_id, category, importance)
+);
+
+INSERT INTO skills_new (id, name, description, category, image, hex_theme_color, cv_profile_id, importance, slug)
+SELECT id, name, description, category, image, hex_theme_color, cv_profile_id, importance, slug
+FROM skills;
+
+DROP TABLE skills;
+
+ALTER TABLE skills_new
+    RENAME TO skills;
+
+CREATE TABLE projects_new
+(
+    id                INTEGER PRIMARY KEY AUTOINCREMENT,
+    title             TEXT    NOT NULL,
+    short_description TEXT    NOT NULL,
+    description       TEXT    NOT NULL,
+    image             TEXT    NOT NULL,
+    hex_theme_color   TEXT    NOT NULL,
+    project_url       TEXT    NOT NULL,
+    cv_profile_id     INTEGER NOT NULL CONSTRAINT projects_cv_profile_id_fkey REFERENCES cv_profiles (id),
+    significance      INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO projects_new (id, title, short_description, description, image, hex_theme_color, project_url, cv_profile_id, significance)
+SELECT id, title, short_description, description, image, hex_theme_color, project_url, cv_profile_id, significance
+FROM projects;
+
+DROP TABLE projects;
+
+ALTER TABLE projects_new
+    RENAME TO projects;
+
+CREATE TABLE cv_experiences_new
+(
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    company         TEXT    NOT NULL,
+    position        TEXT    NOT NULL,
+    location        TEXT    NOT NULL,
+    employment_type TEXT    NOT NULL,
+    start_date      DATE    NOT NULL,
+    -- NULL for the current job
+    end_date        DATE,
+    -- a JSON array of strings
+    achievements    TEXT    NOT NULL DEFAULT '[]',
+    cv_profile_id   INTEGER NOT NULL CONSTRAINT cv_experiences_cv_profile_id_fkey REFERENCES cv_profiles (id)
+);
+
+INSERT INTO cv_experiences_new (id, company, position, location, employment_type, start_date, end_date, achievements, cv_profile_id)
+SELECT id, company, position, location, employment_type, start_date, end_date, achievements, cv_profile_id
+FROM cv_experiences;
+
+DROP TABLE cv_experiences;
+
+ALTER TABLE cv_experiences_new
+    RENAME TO cv_experiences;
+
+CREATE TABLE project_technologies_new
+(
+    project_id    INTEGER NOT NULL CONSTRAINT project_technologies_project_id_fkey REFERENCES projects (id),
+    technology_id INTEGER NOT NULL CONSTRAINT project_technologies_technology_id_fkey REFERENCES technologies (id),
+    PRIMARY KEY (project_id, technology_id)
+);
+
+INSERT INTO project_technologies_new (project_id, technology_id)
+SELECT project_id, technology_id
+FROM project_technologies;
+
+DROP TABLE project_technologies;
+
+ALTER TABLE project_technologies_new
+    RENAME TO project_technologies;
+
+CREATE TABLE project_skills_new
+(
+    project_id INTEGER NOT NULL CONSTRAINT project_skills_project_id_fkey REFERENCES projects (id),
+    skill_id   INTEGER NOT NULL CONSTRAINT project_skills_skill_id_fkey REFERENCES skills (id),
+    PRIMARY KEY (project_id, skill_id)
+);
+
+INSERT INTO project_skills_new (project_id, skill_id)
+SELECT project_id, skill_id
+FROM project_skills;
+
+DROP TABLE project_skills;
+
+ALTER TABLE project_skills_new
+    RENAME TO project_skills;
+
+CREATE TABLE cv_experience_skills_new
+(
+    cv_experience_id INTEGER NOT NULL CONSTRAINT cv_experience_skills_cv_experience_id_fkey REFERENCES cv_experiences (id),
+    skill_id         INTEGER NOT NULL CONSTRAINT cv_experience_skills_skill_id_fkey REFERENCES skills (id),
+    PRIMARY KEY (cv_experience_id, skill_id)
+);
+
+INSERT INTO cv_experience_skills_new (cv_experience_id, skill_id)
+SELECT cv_experience_id, skill_id
+FROM cv_experience_skills;
+
+DROP TABLE cv_experience_skills;
+
+ALTER TABLE cv_experience_skills_new
+    RENAME TO cv_experience_skills;
+
+CREATE TABLE cv_experience_technologies_new
+(
+    cv_experience_id INTEGER NOT NULL CONSTRAINT cv_experience_technologies_cv_experience_id_fkey REFERENCES cv_experiences (id),
+    technology_id    INTEGER NOT NULL CONSTRAINT cv_experience_technologies_technology_id_fkey REFERENCES technologies (id),
+    PRIMARY KEY (cv_experience_id, technology_id)
+);
+
+INSERT INTO cv_experience_technologies_new (cv_experience_id, technology_id)
+SELECT cv_experience_id, technology_id
+FROM cv_experience_technologies;
+
+DROP TABLE cv_experience_technologies;
+
+ALTER TABLE cv_experience_technologies_new
+    RENAME TO cv_experience_technologies;
+
+PRAGMA legacy_alter_table = OFF;
+
+-- the indexes and triggers were dropped with the new tables
+CREATE INDEX idx_skills_name ON skills (name);
+
+CREATE UNIQUE INDEX unique_profile_skill_slug ON skills (cv_profile_id, slug);
+
+-- Inserting a row that references a missing row
+CREATE TRIGGER cv_educations_fkey
+    BEFORE INSERT
+    ON cv_educations
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_educations_cv_profile_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_profiles WHERE id = NEW.cv_profile_id);
+END;
+
+CREATE TRIGGER skills_fkey
+    BEFORE INSERT
+    ON skills
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: skills_cv_profile_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_profiles WHERE id = NEW.cv_profile_id);
+END;
+
+CREATE TRIGGER projects_fkey
+    BEFORE INSERT
+    ON projects
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: projects_cv_profile_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_profiles WHERE id = NEW.cv_profile_id);
+END;
+
+CREATE TRIGGER cv_experiences_fkey
+    BEFORE INSERT
+    ON cv_experiences
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experiences_cv_profile_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_profiles WHERE id = NEW.cv_profile_id);
+END;
+
+CREATE TRIGGER project_technologies_fkey
+    BEFORE INSERT
+    ON project_technologies
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_technologies_project_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM projects WHERE id = NEW.project_id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_technologies_technology_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM technologies WHERE id = NEW.technology_id);
+END;
+
+CREATE TRIGGER project_skills_fkey
+    BEFORE INSERT
+    ON project_skills
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_skills_project_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM projects WHERE id = NEW.project_id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_skills_skill_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM skills WHERE id = NEW.skill_id);
+END;
+
+CREATE TRIGGER cv_experience_skills_fkey
+    BEFORE INSERT
+    ON cv_experience_skills
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_skills_cv_experience_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_experiences WHERE id = NEW.cv_experience_id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_skills_skill_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM skills WHERE id = NEW.skill_id);
+END;
+
+CREATE TRIGGER cv_experience_technologies_fkey
+    BEFORE INSERT
+    ON cv_experience_technologies
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_technologies_cv_experience_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_experiences WHERE id = NEW.cv_experience_id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_technologies_technology_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM technologies WHERE id = NEW.technology_id);
+END;
+
+-- Deleting a row that is still referenced
+CREATE TRIGGER cv_profiles_referenced
+    BEFORE DELETE
+    ON cv_profiles
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_educations_cv_profile_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_educations WHERE cv_profile_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: skills_cv_profile_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM skills WHERE cv_profile_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: projects_cv_profile_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM projects WHERE cv_profile_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experiences_cv_profile_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_experiences WHERE cv_profile_id = OLD.id);
+END;
+
+CREATE TRIGGER projects_referenced
+    BEFORE DELETE
+    ON projects
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_technologies_project_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM project_technologies WHERE project_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_skills_project_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM project_skills WHERE project_id = OLD.id);
+END;
+
+CREATE TRIGGER skills_referenced
+    BEFORE DELETE
+    ON skills
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: project_skills_skill_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM project_skills WHERE skill_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_skills_skill_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_experience_skills WHERE skill_id = OLD.id);
+END;
+
+CREATE TRIGGER cv_experiences_referenced
+    BEFORE DELETE
+    ON cv_experiences
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_skills_cv_experience_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_experience_skills WHERE cv_experience_id = OLD.id);
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_experience_technologies_cv_experience_id_fkey')
+    WHERE EXISTS (SELECT 1 FROM cv_experience_technologies WHERE cv_experience_id = OLD.id);
+END;
+
+-- Full text search of the rebuilt tables
+CREATE TRIGGER projects_search_insert
+    AFTER INSERT
+    ON projects
+BEGIN
+    INSERT INTO projects_search (rowid, title, short_description, description)
+    VALUES (NEW.id, NEW.title, NEW.short_description, NEW.description);
+END;
+
+CREATE TRIGGER projects_search_update
+    AFTER UPDATE
+    ON projects
+BEGIN
+    INSERT INTO projects_search (projects_search, rowid, title, short_description, description)
+    VALUES ('delete', OLD.id, OLD.title, OLD.short_description, OLD.description);
+    INSERT INTO projects_search (rowid, title, short_description, description)
+    VALUES (NEW.id, NEW.title, NEW.short_description, NEW.description);
+END;
+
+CREATE TRIGGER projects_search_delete
+    AFTER DELETE
+    ON projects
+BEGIN
+    INSERT INTO projects_search (projects_search, rowid, title, short_description, description)
+    VALUES ('delete', OLD.id, OLD.title, OLD.short_description, OLD.description);
+END;
+
+CREATE TRIGGER skills_search_insert
+    AFTER INSERT
+    ON skills
+BEGIN
+    INSERT INTO skills_search (rowid, name, description)
+    VALUES (NEW.id, NEW.name, NEW.description);
+END;
+
+CREATE TRIGGER skills_search_update
+    AFTER UPDATE
+    ON skills
+BEGIN
+    INSERT INTO skills_search (skills_search, rowid, name, description)
+    VALUES ('delete', OLD.id, OLD.name, OLD.description);
+    INSERT INTO skills_search (rowid, name, description)
+    VALUES (NEW.id, NEW.name, NEW.description);
+END;
+
+CREATE TRIGGER skills_search_delete
+    AFTER DELETE
+    ON skills
+BEGIN
+    INSERT INTO skills_search (skills_search, rowid, name, description)
+    VALUES ('delete', OLD.id, OLD.name, OLD.description);
+END;
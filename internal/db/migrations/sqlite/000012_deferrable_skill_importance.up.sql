@@ -0,0 +1,4 @@
+-- SQLite cannot defer unique constraints, they are checked after every row. The schema does not change,
+-- the migration keeps the versions of both sets equal: ReorderSkills of the SQLite querier moves the
+-- importances of the category out of the way before it writes the new ones.
+SELECT 1;
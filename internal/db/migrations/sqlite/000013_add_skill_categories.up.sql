@@ -0,0 +1,27 @@
+-- The categories of the skills of a profile, skills.category is the name of the category. The skills of a category
+-- that has no row are still listed, after the other categories.
+CREATE TABLE skill_categories
+(
+    id              INTEGER PRIMARY KEY AUTOINCREMENT,
+    cv_profile_id   INTEGER NOT NULL CONSTRAINT skill_categories_cv_profile_id_fkey REFERENCES cv_profiles (id) ON DELETE CASCADE,
+    name            TEXT    NOT NULL,
+    description     TEXT    NOT NULL DEFAULT '',
+    hex_theme_color TEXT    NOT NULL DEFAULT '',
+    display_order   INTEGER NOT NULL DEFAULT 0,
+    CONSTRAINT unique_profile_skill_category_name UNIQUE (cv_profile_id, name),
+    CONSTRAINT skill_categories_hex_theme_color_check CHECK (hex_theme_color = '' OR hex_theme_color GLOB '#[0-9A-Fa-f][0-9A-Fa-f][0-9A-Fa-f]' OR
+                                                       hex_theme_color GLOB '#[0-9A-Fa-f][0-9A-Fa-f][0-9A-Fa-f][0-9A-Fa-f][0-9A-Fa-f][0-9A-Fa-f]')
+);
+
+CREATE TRIGGER skill_categories_fkey
+    BEFORE INSERT
+    ON skill_categories
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: skill_categories_cv_profile_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_profiles WHERE id = NEW.cv_profile_id);
+END;
+
+-- the existing categories are ordered by name
+INSERT INTO skill_categories (cv_profile_id, name, display_order)
+SELECT cv_profile_id, category, ROW_NUMBER() OVER (PARTITION BY cv_profile_id ORDER BY category)
+FROM (SELECT DISTINCT cv_profile_id, category FROM skills) AS categories;
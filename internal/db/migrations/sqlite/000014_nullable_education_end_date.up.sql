@@ -0,0 +1,36 @@
+-- The end date of the current education is NULL, like the end date of the current job.
+-- SQLite cannot drop NOT NULL from a column, so the table is rebuilt like in 000011.
+PRAGMA legacy_alter_table = ON;
+
+CREATE TABLE cv_educations_new
+(
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    institution   TEXT    NOT NULL,
+    degree        TEXT    NOT NULL,
+    start_date    DATE    NOT NULL,
+    end_date      DATE,
+    cv_profile_id INTEGER NOT NULL CONSTRAINT cv_educations_cv_profile_id_fkey REFERENCES cv_profiles (id) ON DELETE CASCADE,
+    CONSTRAINT cv_educations_dates_check CHECK (end_date IS NULL OR date(end_date) >= date(start_date))
+);
+
+INSERT INTO cv_educations_new (id, institution, degree, start_date, end_date, cv_profile_id)
+SELECT id, institution, degree, start_date, end_date, cv_profile_id
+FROM cv_educations;
+
+DROP TABLE cv_educations;
+
+ALTER TABLE cv_educations_new
+    RENAME TO cv_educations;
+
+PRAGMA legacy_alter_table = OFF;
+
+-- the index and the trigger were dropped with the old table
+CREATE INDEX idx_cv_educations_cv_profile_id ON cv_educations (cv_profile_id);
+
+CREATE TRIGGER cv_educations_fkey
+    BEFORE INSERT
+    ON cv_educations
+BEGIN
+    SELECT RAISE(ABORT, 'foreign_key_violation: cv_educations_cv_profile_id_fkey')
+    WHERE NOT EXISTS (SELECT 1 FROM cv_profiles WHERE id = NEW.cv_profile_id);
+END;
@@ -50,6 +50,66 @@ func (mr *MockStoreMockRecorder) CreateCvEducation(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCvEducation", reflect.TypeOf((*MockStore)(nil).CreateCvEducation), arg0, arg1)
 }
 
+// CreateCvExperience mocks base method.
+func (m *MockStore) CreateCvExperience(arg0 context.Context, arg1 db.CreateCvExperienceParams) (db.CvExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCvExperience", arg0, arg1)
+	ret0, _ := ret[0].(db.CvExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCvExperience indicates an expected call of CreateCvExperience.
+func (mr *MockStoreMockRecorder) CreateCvExperience(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCvExperience", reflect.TypeOf((*MockStore)(nil).CreateCvExperience), arg0, arg1)
+}
+
+// CreateCvExperienceSkill mocks base method.
+func (m *MockStore) CreateCvExperienceSkill(arg0 context.Context, arg1 db.CreateCvExperienceSkillParams) (db.CvExperienceSkill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCvExperienceSkill", arg0, arg1)
+	ret0, _ := ret[0].(db.CvExperienceSkill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCvExperienceSkill indicates an expected call of CreateCvExperienceSkill.
+func (mr *MockStoreMockRecorder) CreateCvExperienceSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCvExperienceSkill", reflect.TypeOf((*MockStore)(nil).CreateCvExperienceSkill), arg0, arg1)
+}
+
+// CreateCvExperienceTechnology mocks base method.
+func (m *MockStore) CreateCvExperienceTechnology(arg0 context.Context, arg1 db.CreateCvExperienceTechnologyParams) (db.CvExperienceTechnology, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCvExperienceTechnology", arg0, arg1)
+	ret0, _ := ret[0].(db.CvExperienceTechnology)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCvExperienceTechnology indicates an expected call of CreateCvExperienceTechnology.
+func (mr *MockStoreMockRecorder) CreateCvExperienceTechnology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCvExperienceTechnology", reflect.TypeOf((*MockStore)(nil).CreateCvExperienceTechnology), arg0, arg1)
+}
+
+// CreateCvExperienceTx mocks base method.
+func (m *MockStore) CreateCvExperienceTx(arg0 context.Context, arg1 db.CreateCvExperienceTxParams) (db.ListCvExperiencesWithDetailsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCvExperienceTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ListCvExperiencesWithDetailsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCvExperienceTx indicates an expected call of CreateCvExperienceTx.
+func (mr *MockStoreMockRecorder) CreateCvExperienceTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCvExperienceTx", reflect.TypeOf((*MockStore)(nil).CreateCvExperienceTx), arg0, arg1)
+}
+
 // CreateCvProfile mocks base method.
 func (m *MockStore) CreateCvProfile(arg0 context.Context, arg1 db.CreateCvProfileParams) (db.CvProfile, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +170,21 @@ func (mr *MockStoreMockRecorder) CreateProjectTechnology(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectTechnology", reflect.TypeOf((*MockStore)(nil).CreateProjectTechnology), arg0, arg1)
 }
 
+// CreateProjectTx mocks base method.
+func (m *MockStore) CreateProjectTx(arg0 context.Context, arg1 db.CreateProjectTxParams) (db.ListProjectsWithTechnologiesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ListProjectsWithTechnologiesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectTx indicates an expected call of CreateProjectTx.
+func (mr *MockStoreMockRecorder) CreateProjectTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectTx", reflect.TypeOf((*MockStore)(nil).CreateProjectTx), arg0, arg1)
+}
+
 // CreateSkill mocks base method.
 func (m *MockStore) CreateSkill(arg0 context.Context, arg1 db.CreateSkillParams) (db.Skill, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +215,262 @@ func (mr *MockStoreMockRecorder) CreateTechnology(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTechnology", reflect.TypeOf((*MockStore)(nil).CreateTechnology), arg0, arg1)
 }
 
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+}
+
+// CvProfileExists mocks base method.
+func (m *MockStore) CvProfileExists(arg0 context.Context, arg1 int32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CvProfileExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CvProfileExists indicates an expected call of CvProfileExists.
+func (mr *MockStoreMockRecorder) CvProfileExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CvProfileExists", reflect.TypeOf((*MockStore)(nil).CvProfileExists), arg0, arg1)
+}
+
+// DeleteCvEducationsByCvProfile mocks base method.
+func (m *MockStore) DeleteCvEducationsByCvProfile(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCvEducationsByCvProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCvEducationsByCvProfile indicates an expected call of DeleteCvEducationsByCvProfile.
+func (mr *MockStoreMockRecorder) DeleteCvEducationsByCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCvEducationsByCvProfile", reflect.TypeOf((*MockStore)(nil).DeleteCvEducationsByCvProfile), arg0, arg1)
+}
+
+// DeleteCvExperienceSkills mocks base method.
+func (m *MockStore) DeleteCvExperienceSkills(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCvExperienceSkills", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCvExperienceSkills indicates an expected call of DeleteCvExperienceSkills.
+func (mr *MockStoreMockRecorder) DeleteCvExperienceSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCvExperienceSkills", reflect.TypeOf((*MockStore)(nil).DeleteCvExperienceSkills), arg0, arg1)
+}
+
+// DeleteCvExperienceSkillsByCvProfile mocks base method.
+func (m *MockStore) DeleteCvExperienceSkillsByCvProfile(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCvExperienceSkillsByCvProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCvExperienceSkillsByCvProfile indicates an expected call of DeleteCvExperienceSkillsByCvProfile.
+func (mr *MockStoreMockRecorder) DeleteCvExperienceSkillsByCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCvExperienceSkillsByCvProfile", reflect.TypeOf((*MockStore)(nil).DeleteCvExperienceSkillsByCvProfile), arg0, arg1)
+}
+
+// DeleteCvExperienceTechnologies mocks base method.
+func (m *MockStore) DeleteCvExperienceTechnologies(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCvExperienceTechnologies", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCvExperienceTechnologies indicates an expected call of DeleteCvExperienceTechnologies.
+func (mr *MockStoreMockRecorder) DeleteCvExperienceTechnologies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCvExperienceTechnologies", reflect.TypeOf((*MockStore)(nil).DeleteCvExperienceTechnologies), arg0, arg1)
+}
+
+// DeleteCvExperienceTechnologiesByCvProfile mocks base method.
+func (m *MockStore) DeleteCvExperienceTechnologiesByCvProfile(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCvExperienceTechnologiesByCvProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCvExperienceTechnologiesByCvProfile indicates an expected call of DeleteCvExperienceTechnologiesByCvProfile.
+func (mr *MockStoreMockRecorder) DeleteCvExperienceTechnologiesByCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCvExperienceTechnologiesByCvProfile", reflect.TypeOf((*MockStore)(nil).DeleteCvExperienceTechnologiesByCvProfile), arg0, arg1)
+}
+
+// DeleteCvExperiencesByCvProfile mocks base method.
+func (m *MockStore) DeleteCvExperiencesByCvProfile(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCvExperiencesByCvProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCvExperiencesByCvProfile indicates an expected call of DeleteCvExperiencesByCvProfile.
+func (mr *MockStoreMockRecorder) DeleteCvExperiencesByCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCvExperiencesByCvProfile", reflect.TypeOf((*MockStore)(nil).DeleteCvExperiencesByCvProfile), arg0, arg1)
+}
+
+// DeleteCvProfile mocks base method.
+func (m *MockStore) DeleteCvProfile(arg0 context.Context, arg1 int32) (db.CvProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCvProfile", arg0, arg1)
+	ret0, _ := ret[0].(db.CvProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCvProfile indicates an expected call of DeleteCvProfile.
+func (mr *MockStoreMockRecorder) DeleteCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCvProfile", reflect.TypeOf((*MockStore)(nil).DeleteCvProfile), arg0, arg1)
+}
+
+// DeleteCvProfileTx mocks base method.
+func (m *MockStore) DeleteCvProfileTx(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCvProfileTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCvProfileTx indicates an expected call of DeleteCvProfileTx.
+func (mr *MockStoreMockRecorder) DeleteCvProfileTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCvProfileTx", reflect.TypeOf((*MockStore)(nil).DeleteCvProfileTx), arg0, arg1)
+}
+
+// DeleteProject mocks base method.
+func (m *MockStore) DeleteProject(arg0 context.Context, arg1 int32) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockStoreMockRecorder) DeleteProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockStore)(nil).DeleteProject), arg0, arg1)
+}
+
+// DeleteProjectSkills mocks base method.
+func (m *MockStore) DeleteProjectSkills(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectSkills", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectSkills indicates an expected call of DeleteProjectSkills.
+func (mr *MockStoreMockRecorder) DeleteProjectSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectSkills", reflect.TypeOf((*MockStore)(nil).DeleteProjectSkills), arg0, arg1)
+}
+
+// DeleteProjectSkillsByCvProfile mocks base method.
+func (m *MockStore) DeleteProjectSkillsByCvProfile(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectSkillsByCvProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectSkillsByCvProfile indicates an expected call of DeleteProjectSkillsByCvProfile.
+func (mr *MockStoreMockRecorder) DeleteProjectSkillsByCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectSkillsByCvProfile", reflect.TypeOf((*MockStore)(nil).DeleteProjectSkillsByCvProfile), arg0, arg1)
+}
+
+// DeleteProjectTechnologies mocks base method.
+func (m *MockStore) DeleteProjectTechnologies(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectTechnologies", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectTechnologies indicates an expected call of DeleteProjectTechnologies.
+func (mr *MockStoreMockRecorder) DeleteProjectTechnologies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectTechnologies", reflect.TypeOf((*MockStore)(nil).DeleteProjectTechnologies), arg0, arg1)
+}
+
+// DeleteProjectTechnologiesByCvProfile mocks base method.
+func (m *MockStore) DeleteProjectTechnologiesByCvProfile(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectTechnologiesByCvProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectTechnologiesByCvProfile indicates an expected call of DeleteProjectTechnologiesByCvProfile.
+func (mr *MockStoreMockRecorder) DeleteProjectTechnologiesByCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectTechnologiesByCvProfile", reflect.TypeOf((*MockStore)(nil).DeleteProjectTechnologiesByCvProfile), arg0, arg1)
+}
+
+// DeleteProjectTx mocks base method.
+func (m *MockStore) DeleteProjectTx(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectTx indicates an expected call of DeleteProjectTx.
+func (mr *MockStoreMockRecorder) DeleteProjectTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectTx", reflect.TypeOf((*MockStore)(nil).DeleteProjectTx), arg0, arg1)
+}
+
+// DeleteProjectsByCvProfile mocks base method.
+func (m *MockStore) DeleteProjectsByCvProfile(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectsByCvProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectsByCvProfile indicates an expected call of DeleteProjectsByCvProfile.
+func (mr *MockStoreMockRecorder) DeleteProjectsByCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectsByCvProfile", reflect.TypeOf((*MockStore)(nil).DeleteProjectsByCvProfile), arg0, arg1)
+}
+
+// DeleteSkillsByCvProfile mocks base method.
+func (m *MockStore) DeleteSkillsByCvProfile(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSkillsByCvProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSkillsByCvProfile indicates an expected call of DeleteSkillsByCvProfile.
+func (mr *MockStoreMockRecorder) DeleteSkillsByCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSkillsByCvProfile", reflect.TypeOf((*MockStore)(nil).DeleteSkillsByCvProfile), arg0, arg1)
+}
+
 // GetCvEducation mocks base method.
 func (m *MockStore) GetCvEducation(arg0 context.Context, arg1 int32) (db.CvEducation, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +486,51 @@ func (mr *MockStoreMockRecorder) GetCvEducation(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCvEducation", reflect.TypeOf((*MockStore)(nil).GetCvEducation), arg0, arg1)
 }
 
+// GetCvEducationByInstitution mocks base method.
+func (m *MockStore) GetCvEducationByInstitution(arg0 context.Context, arg1 db.GetCvEducationByInstitutionParams) (db.CvEducation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCvEducationByInstitution", arg0, arg1)
+	ret0, _ := ret[0].(db.CvEducation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCvEducationByInstitution indicates an expected call of GetCvEducationByInstitution.
+func (mr *MockStoreMockRecorder) GetCvEducationByInstitution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCvEducationByInstitution", reflect.TypeOf((*MockStore)(nil).GetCvEducationByInstitution), arg0, arg1)
+}
+
+// GetCvExperience mocks base method.
+func (m *MockStore) GetCvExperience(arg0 context.Context, arg1 int32) (db.CvExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCvExperience", arg0, arg1)
+	ret0, _ := ret[0].(db.CvExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCvExperience indicates an expected call of GetCvExperience.
+func (mr *MockStoreMockRecorder) GetCvExperience(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCvExperience", reflect.TypeOf((*MockStore)(nil).GetCvExperience), arg0, arg1)
+}
+
+// GetCvExperienceByCompany mocks base method.
+func (m *MockStore) GetCvExperienceByCompany(arg0 context.Context, arg1 db.GetCvExperienceByCompanyParams) (db.CvExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCvExperienceByCompany", arg0, arg1)
+	ret0, _ := ret[0].(db.CvExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCvExperienceByCompany indicates an expected call of GetCvExperienceByCompany.
+func (mr *MockStoreMockRecorder) GetCvExperienceByCompany(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCvExperienceByCompany", reflect.TypeOf((*MockStore)(nil).GetCvExperienceByCompany), arg0, arg1)
+}
+
 // GetCvProfile mocks base method.
 func (m *MockStore) GetCvProfile(arg0 context.Context, arg1 int32) (db.CvProfile, error) {
 	m.ctrl.T.Helper()
@@ -170,122 +546,734 @@ func (mr *MockStoreMockRecorder) GetCvProfile(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCvProfile", reflect.TypeOf((*MockStore)(nil).GetCvProfile), arg0, arg1)
 }
 
-// GetSkill mocks base method.
-func (m *MockStore) GetSkill(arg0 context.Context, arg1 int32) (db.Skill, error) {
+// GetCvProfileByEmail mocks base method.
+func (m *MockStore) GetCvProfileByEmail(arg0 context.Context, arg1 string) (db.CvProfile, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSkill", arg0, arg1)
-	ret0, _ := ret[0].(db.Skill)
+	ret := m.ctrl.Call(m, "GetCvProfileByEmail", arg0, arg1)
+	ret0, _ := ret[0].(db.CvProfile)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSkill indicates an expected call of GetSkill.
-func (mr *MockStoreMockRecorder) GetSkill(arg0, arg1 interface{}) *gomock.Call {
+// GetCvProfileByEmail indicates an expected call of GetCvProfileByEmail.
+func (mr *MockStoreMockRecorder) GetCvProfileByEmail(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkill", reflect.TypeOf((*MockStore)(nil).GetSkill), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCvProfileByEmail", reflect.TypeOf((*MockStore)(nil).GetCvProfileByEmail), arg0, arg1)
 }
 
-// ListCvEducations mocks base method.
-func (m *MockStore) ListCvEducations(arg0 context.Context, arg1 db.ListCvEducationsParams) ([]db.CvEducation, error) {
+// GetProject mocks base method.
+func (m *MockStore) GetProject(arg0 context.Context, arg1 int32) (db.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCvEducations", arg0, arg1)
-	ret0, _ := ret[0].([]db.CvEducation)
+	ret := m.ctrl.Call(m, "GetProject", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListCvEducations indicates an expected call of ListCvEducations.
-func (mr *MockStoreMockRecorder) ListCvEducations(arg0, arg1 interface{}) *gomock.Call {
+// GetProject indicates an expected call of GetProject.
+func (mr *MockStoreMockRecorder) GetProject(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCvEducations", reflect.TypeOf((*MockStore)(nil).ListCvEducations), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockStore)(nil).GetProject), arg0, arg1)
 }
 
-// ListProjects mocks base method.
-func (m *MockStore) ListProjects(arg0 context.Context, arg1 db.ListProjectsParams) ([]db.ListProjectsRow, error) {
+// GetProjectByTitle mocks base method.
+func (m *MockStore) GetProjectByTitle(arg0 context.Context, arg1 db.GetProjectByTitleParams) (db.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProjects", arg0, arg1)
-	ret0, _ := ret[0].([]db.ListProjectsRow)
+	ret := m.ctrl.Call(m, "GetProjectByTitle", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListProjects indicates an expected call of ListProjects.
-func (mr *MockStoreMockRecorder) ListProjects(arg0, arg1 interface{}) *gomock.Call {
+// GetProjectByTitle indicates an expected call of GetProjectByTitle.
+func (mr *MockStoreMockRecorder) GetProjectByTitle(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockStore)(nil).ListProjects), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectByTitle", reflect.TypeOf((*MockStore)(nil).GetProjectByTitle), arg0, arg1)
 }
 
-// ListProjectsBySkillName mocks base method.
-func (m *MockStore) ListProjectsBySkillName(arg0 context.Context, arg1 db.ListProjectsBySkillNameParams) ([]db.ListProjectsBySkillNameRow, error) {
+// GetSkill mocks base method.
+func (m *MockStore) GetSkill(arg0 context.Context, arg1 int32) (db.Skill, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProjectsBySkillName", arg0, arg1)
-	ret0, _ := ret[0].([]db.ListProjectsBySkillNameRow)
+	ret := m.ctrl.Call(m, "GetSkill", arg0, arg1)
+	ret0, _ := ret[0].(db.Skill)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListProjectsBySkillName indicates an expected call of ListProjectsBySkillName.
-func (mr *MockStoreMockRecorder) ListProjectsBySkillName(arg0, arg1 interface{}) *gomock.Call {
+// GetSkill indicates an expected call of GetSkill.
+func (mr *MockStoreMockRecorder) GetSkill(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsBySkillName", reflect.TypeOf((*MockStore)(nil).ListProjectsBySkillName), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkill", reflect.TypeOf((*MockStore)(nil).GetSkill), arg0, arg1)
 }
 
-// ListProjectsWithTechnologies mocks base method.
-func (m *MockStore) ListProjectsWithTechnologies(arg0 context.Context, arg1 db.ListProjectsWithTechnologiesParams) ([]db.ListProjectsWithTechnologiesRow, error) {
+// GetSkillByName mocks base method.
+func (m *MockStore) GetSkillByName(arg0 context.Context, arg1 db.GetSkillByNameParams) (db.Skill, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProjectsWithTechnologies", arg0, arg1)
-	ret0, _ := ret[0].([]db.ListProjectsWithTechnologiesRow)
+	ret := m.ctrl.Call(m, "GetSkillByName", arg0, arg1)
+	ret0, _ := ret[0].(db.Skill)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListProjectsWithTechnologies indicates an expected call of ListProjectsWithTechnologies.
-func (mr *MockStoreMockRecorder) ListProjectsWithTechnologies(arg0, arg1 interface{}) *gomock.Call {
+// GetSkillByName indicates an expected call of GetSkillByName.
+func (mr *MockStoreMockRecorder) GetSkillByName(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsWithTechnologies", reflect.TypeOf((*MockStore)(nil).ListProjectsWithTechnologies), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkillByName", reflect.TypeOf((*MockStore)(nil).GetSkillByName), arg0, arg1)
 }
 
-// ListProjectsWithTechnologiesBySkillName mocks base method.
-func (m *MockStore) ListProjectsWithTechnologiesBySkillName(arg0 context.Context, arg1 db.ListProjectsWithTechnologiesBySkillNameParams) ([]db.ListProjectsWithTechnologiesBySkillNameRow, error) {
+// GetSkillBySlug mocks base method.
+func (m *MockStore) GetSkillBySlug(arg0 context.Context, arg1 db.GetSkillBySlugParams) (db.Skill, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProjectsWithTechnologiesBySkillName", arg0, arg1)
-	ret0, _ := ret[0].([]db.ListProjectsWithTechnologiesBySkillNameRow)
+	ret := m.ctrl.Call(m, "GetSkillBySlug", arg0, arg1)
+	ret0, _ := ret[0].(db.Skill)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListProjectsWithTechnologiesBySkillName indicates an expected call of ListProjectsWithTechnologiesBySkillName.
-func (mr *MockStoreMockRecorder) ListProjectsWithTechnologiesBySkillName(arg0, arg1 interface{}) *gomock.Call {
+// GetSkillBySlug indicates an expected call of GetSkillBySlug.
+func (mr *MockStoreMockRecorder) GetSkillBySlug(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsWithTechnologiesBySkillName", reflect.TypeOf((*MockStore)(nil).ListProjectsWithTechnologiesBySkillName), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkillBySlug", reflect.TypeOf((*MockStore)(nil).GetSkillBySlug), arg0, arg1)
 }
 
-// ListSkills mocks base method.
-func (m *MockStore) ListSkills(arg0 context.Context, arg1 db.ListSkillsParams) ([]db.Skill, error) {
+// GetTechnologyByName mocks base method.
+func (m *MockStore) GetTechnologyByName(arg0 context.Context, arg1 string) (db.Technology, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSkills", arg0, arg1)
-	ret0, _ := ret[0].([]db.Skill)
+	ret := m.ctrl.Call(m, "GetTechnologyByName", arg0, arg1)
+	ret0, _ := ret[0].(db.Technology)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSkills indicates an expected call of ListSkills.
-func (mr *MockStoreMockRecorder) ListSkills(arg0, arg1 interface{}) *gomock.Call {
+// GetTechnologyByName indicates an expected call of GetTechnologyByName.
+func (mr *MockStoreMockRecorder) GetTechnologyByName(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkills", reflect.TypeOf((*MockStore)(nil).ListSkills), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTechnologyByName", reflect.TypeOf((*MockStore)(nil).GetTechnologyByName), arg0, arg1)
 }
 
-// ListTechnologiesForProject mocks base method.
-func (m *MockStore) ListTechnologiesForProject(arg0 context.Context, arg1 int32) ([]db.ListTechnologiesForProjectRow, error) {
+// GetUser mocks base method.
+func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTechnologiesForProject", arg0, arg1)
-	ret0, _ := ret[0].([]db.ListTechnologiesForProjectRow)
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTechnologiesForProject indicates an expected call of ListTechnologiesForProject.
-func (mr *MockStoreMockRecorder) ListTechnologiesForProject(arg0, arg1 interface{}) *gomock.Call {
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTechnologiesForProject", reflect.TypeOf((*MockStore)(nil).ListTechnologiesForProject), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+}
+
+// ImportCvProfileTx mocks base method.
+func (m *MockStore) ImportCvProfileTx(arg0 context.Context, arg1 db.ImportCvProfileTxParams) (db.CvProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCvProfileTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CvProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCvProfileTx indicates an expected call of ImportCvProfileTx.
+func (mr *MockStoreMockRecorder) ImportCvProfileTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCvProfileTx", reflect.TypeOf((*MockStore)(nil).ImportCvProfileTx), arg0, arg1)
+}
+
+// ListCvEducations mocks base method.
+func (m *MockStore) ListCvEducations(arg0 context.Context, arg1 db.ListCvEducationsParams) ([]db.CvEducation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCvEducations", arg0, arg1)
+	ret0, _ := ret[0].([]db.CvEducation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCvEducations indicates an expected call of ListCvEducations.
+func (mr *MockStoreMockRecorder) ListCvEducations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCvEducations", reflect.TypeOf((*MockStore)(nil).ListCvEducations), arg0, arg1)
+}
+
+// ListCvExperiences mocks base method.
+func (m *MockStore) ListCvExperiences(arg0 context.Context, arg1 db.ListCvExperiencesParams) ([]db.CvExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCvExperiences", arg0, arg1)
+	ret0, _ := ret[0].([]db.CvExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCvExperiences indicates an expected call of ListCvExperiences.
+func (mr *MockStoreMockRecorder) ListCvExperiences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCvExperiences", reflect.TypeOf((*MockStore)(nil).ListCvExperiences), arg0, arg1)
+}
+
+// ListCvExperiencesWithDetails mocks base method.
+func (m *MockStore) ListCvExperiencesWithDetails(arg0 context.Context, arg1 db.ListCvExperiencesWithDetailsParams) ([]db.ListCvExperiencesWithDetailsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCvExperiencesWithDetails", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCvExperiencesWithDetailsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCvExperiencesWithDetails indicates an expected call of ListCvExperiencesWithDetails.
+func (mr *MockStoreMockRecorder) ListCvExperiencesWithDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCvExperiencesWithDetails", reflect.TypeOf((*MockStore)(nil).ListCvExperiencesWithDetails), arg0, arg1)
+}
+
+// ListCvExperiencesWithJSON mocks base method.
+func (m *MockStore) ListCvExperiencesWithJSON(arg0 context.Context, arg1 db.ListCvExperiencesWithJSONParams) ([]db.ListCvExperiencesWithJSONRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCvExperiencesWithJSON", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListCvExperiencesWithJSONRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCvExperiencesWithJSON indicates an expected call of ListCvExperiencesWithJSON.
+func (mr *MockStoreMockRecorder) ListCvExperiencesWithJSON(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCvExperiencesWithJSON", reflect.TypeOf((*MockStore)(nil).ListCvExperiencesWithJSON), arg0, arg1)
+}
+
+// ListProjectSkills mocks base method.
+func (m *MockStore) ListProjectSkills(arg0 context.Context, arg1 int32) ([]db.ProjectSkill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectSkills", arg0, arg1)
+	ret0, _ := ret[0].([]db.ProjectSkill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectSkills indicates an expected call of ListProjectSkills.
+func (mr *MockStoreMockRecorder) ListProjectSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectSkills", reflect.TypeOf((*MockStore)(nil).ListProjectSkills), arg0, arg1)
+}
+
+// ListProjects mocks base method.
+func (m *MockStore) ListProjects(arg0 context.Context, arg1 db.ListProjectsParams) ([]db.ListProjectsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListProjectsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockStoreMockRecorder) ListProjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockStore)(nil).ListProjects), arg0, arg1)
+}
+
+// ListProjectsByCvProfile mocks base method.
+func (m *MockStore) ListProjectsByCvProfile(arg0 context.Context, arg1 int32) ([]db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsByCvProfile", arg0, arg1)
+	ret0, _ := ret[0].([]db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsByCvProfile indicates an expected call of ListProjectsByCvProfile.
+func (mr *MockStoreMockRecorder) ListProjectsByCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsByCvProfile", reflect.TypeOf((*MockStore)(nil).ListProjectsByCvProfile), arg0, arg1)
+}
+
+// ListProjectsBySkillName mocks base method.
+func (m *MockStore) ListProjectsBySkillName(arg0 context.Context, arg1 db.ListProjectsBySkillNameParams) ([]db.ListProjectsBySkillNameRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsBySkillName", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListProjectsBySkillNameRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsBySkillName indicates an expected call of ListProjectsBySkillName.
+func (mr *MockStoreMockRecorder) ListProjectsBySkillName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsBySkillName", reflect.TypeOf((*MockStore)(nil).ListProjectsBySkillName), arg0, arg1)
+}
+
+// ListProjectsWithTechnologies mocks base method.
+func (m *MockStore) ListProjectsWithTechnologies(arg0 context.Context, arg1 db.ListProjectsWithTechnologiesParams) ([]db.ListProjectsWithTechnologiesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsWithTechnologies", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListProjectsWithTechnologiesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsWithTechnologies indicates an expected call of ListProjectsWithTechnologies.
+func (mr *MockStoreMockRecorder) ListProjectsWithTechnologies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsWithTechnologies", reflect.TypeOf((*MockStore)(nil).ListProjectsWithTechnologies), arg0, arg1)
+}
+
+// ListProjectsWithTechnologiesBySkillName mocks base method.
+func (m *MockStore) ListProjectsWithTechnologiesBySkillName(arg0 context.Context, arg1 db.ListProjectsWithTechnologiesBySkillNameParams) ([]db.ListProjectsWithTechnologiesBySkillNameRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsWithTechnologiesBySkillName", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListProjectsWithTechnologiesBySkillNameRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsWithTechnologiesBySkillName indicates an expected call of ListProjectsWithTechnologiesBySkillName.
+func (mr *MockStoreMockRecorder) ListProjectsWithTechnologiesBySkillName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsWithTechnologiesBySkillName", reflect.TypeOf((*MockStore)(nil).ListProjectsWithTechnologiesBySkillName), arg0, arg1)
+}
+
+// ListProjectsWithTechnologyJSON mocks base method.
+func (m *MockStore) ListProjectsWithTechnologyJSON(arg0 context.Context, arg1 db.ListProjectsWithTechnologyJSONParams) ([]db.ListProjectsWithTechnologyJSONRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsWithTechnologyJSON", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListProjectsWithTechnologyJSONRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsWithTechnologyJSON indicates an expected call of ListProjectsWithTechnologyJSON.
+func (mr *MockStoreMockRecorder) ListProjectsWithTechnologyJSON(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsWithTechnologyJSON", reflect.TypeOf((*MockStore)(nil).ListProjectsWithTechnologyJSON), arg0, arg1)
+}
+
+// ListProjectsWithTechnologyJSONBySkillName mocks base method.
+func (m *MockStore) ListProjectsWithTechnologyJSONBySkillName(arg0 context.Context, arg1 db.ListProjectsWithTechnologyJSONBySkillNameParams) ([]db.ListProjectsWithTechnologyJSONBySkillNameRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsWithTechnologyJSONBySkillName", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListProjectsWithTechnologyJSONBySkillNameRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsWithTechnologyJSONBySkillName indicates an expected call of ListProjectsWithTechnologyJSONBySkillName.
+func (mr *MockStoreMockRecorder) ListProjectsWithTechnologyJSONBySkillName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsWithTechnologyJSONBySkillName", reflect.TypeOf((*MockStore)(nil).ListProjectsWithTechnologyJSONBySkillName), arg0, arg1)
+}
+
+// ListSkillCategoriesWithSkills mocks base method.
+func (m *MockStore) ListSkillCategoriesWithSkills(arg0 context.Context, arg1 int32) ([]db.ListSkillCategoriesWithSkillsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkillCategoriesWithSkills", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListSkillCategoriesWithSkillsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkillCategoriesWithSkills indicates an expected call of ListSkillCategoriesWithSkills.
+func (mr *MockStoreMockRecorder) ListSkillCategoriesWithSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkillCategoriesWithSkills", reflect.TypeOf((*MockStore)(nil).ListSkillCategoriesWithSkills), arg0, arg1)
+}
+
+// ListSkills mocks base method.
+func (m *MockStore) ListSkills(arg0 context.Context, arg1 db.ListSkillsParams) ([]db.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkills", arg0, arg1)
+	ret0, _ := ret[0].([]db.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkills indicates an expected call of ListSkills.
+func (mr *MockStoreMockRecorder) ListSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkills", reflect.TypeOf((*MockStore)(nil).ListSkills), arg0, arg1)
+}
+
+// ListSkillsByCategory mocks base method.
+func (m *MockStore) ListSkillsByCategory(arg0 context.Context, arg1 db.ListSkillsByCategoryParams) ([]db.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkillsByCategory", arg0, arg1)
+	ret0, _ := ret[0].([]db.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkillsByCategory indicates an expected call of ListSkillsByCategory.
+func (mr *MockStoreMockRecorder) ListSkillsByCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkillsByCategory", reflect.TypeOf((*MockStore)(nil).ListSkillsByCategory), arg0, arg1)
+}
+
+// ListSkillsForCvExperience mocks base method.
+func (m *MockStore) ListSkillsForCvExperience(arg0 context.Context, arg1 int32) ([]db.ListSkillsForCvExperienceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkillsForCvExperience", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListSkillsForCvExperienceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkillsForCvExperience indicates an expected call of ListSkillsForCvExperience.
+func (mr *MockStoreMockRecorder) ListSkillsForCvExperience(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkillsForCvExperience", reflect.TypeOf((*MockStore)(nil).ListSkillsForCvExperience), arg0, arg1)
+}
+
+// ListSkillsWithCategories mocks base method.
+func (m *MockStore) ListSkillsWithCategories(arg0 context.Context, arg1 int32) ([]db.ListSkillsWithCategoriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSkillsWithCategories", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListSkillsWithCategoriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSkillsWithCategories indicates an expected call of ListSkillsWithCategories.
+func (mr *MockStoreMockRecorder) ListSkillsWithCategories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSkillsWithCategories", reflect.TypeOf((*MockStore)(nil).ListSkillsWithCategories), arg0, arg1)
+}
+
+// ListTechnologiesForCvExperience mocks base method.
+func (m *MockStore) ListTechnologiesForCvExperience(arg0 context.Context, arg1 int32) ([]db.ListTechnologiesForCvExperienceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTechnologiesForCvExperience", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTechnologiesForCvExperienceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTechnologiesForCvExperience indicates an expected call of ListTechnologiesForCvExperience.
+func (mr *MockStoreMockRecorder) ListTechnologiesForCvExperience(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTechnologiesForCvExperience", reflect.TypeOf((*MockStore)(nil).ListTechnologiesForCvExperience), arg0, arg1)
+}
+
+// ListTechnologiesForProject mocks base method.
+func (m *MockStore) ListTechnologiesForProject(arg0 context.Context, arg1 int32) ([]db.ListTechnologiesForProjectRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTechnologiesForProject", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTechnologiesForProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTechnologiesForProject indicates an expected call of ListTechnologiesForProject.
+func (mr *MockStoreMockRecorder) ListTechnologiesForProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTechnologiesForProject", reflect.TypeOf((*MockStore)(nil).ListTechnologiesForProject), arg0, arg1)
+}
+
+// MigrationVersion mocks base method.
+func (m *MockStore) MigrationVersion(arg0 context.Context) (int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrationVersion", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MigrationVersion indicates an expected call of MigrationVersion.
+func (mr *MockStoreMockRecorder) MigrationVersion(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrationVersion", reflect.TypeOf((*MockStore)(nil).MigrationVersion), arg0)
+}
+
+// PatchProject mocks base method.
+func (m *MockStore) PatchProject(arg0 context.Context, arg1 db.PatchProjectParams) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchProject", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchProject indicates an expected call of PatchProject.
+func (mr *MockStoreMockRecorder) PatchProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchProject", reflect.TypeOf((*MockStore)(nil).PatchProject), arg0, arg1)
+}
+
+// PatchProjectTx mocks base method.
+func (m *MockStore) PatchProjectTx(arg0 context.Context, arg1 db.PatchProjectTxParams) (db.ListProjectsWithTechnologiesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchProjectTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ListProjectsWithTechnologiesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchProjectTx indicates an expected call of PatchProjectTx.
+func (mr *MockStoreMockRecorder) PatchProjectTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchProjectTx", reflect.TypeOf((*MockStore)(nil).PatchProjectTx), arg0, arg1)
+}
+
+// Ping mocks base method.
+func (m *MockStore) Ping(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockStoreMockRecorder) Ping(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockStore)(nil).Ping), arg0)
+}
+
+// ReleaseSkillImportance mocks base method.
+func (m *MockStore) ReleaseSkillImportance(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSkillImportance", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSkillImportance indicates an expected call of ReleaseSkillImportance.
+func (mr *MockStoreMockRecorder) ReleaseSkillImportance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSkillImportance", reflect.TypeOf((*MockStore)(nil).ReleaseSkillImportance), arg0, arg1)
+}
+
+// ReorderProjects mocks base method.
+func (m *MockStore) ReorderProjects(arg0 context.Context, arg1 db.ReorderProjectsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderProjects", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderProjects indicates an expected call of ReorderProjects.
+func (mr *MockStoreMockRecorder) ReorderProjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderProjects", reflect.TypeOf((*MockStore)(nil).ReorderProjects), arg0, arg1)
+}
+
+// ReorderProjectsTx mocks base method.
+func (m *MockStore) ReorderProjectsTx(arg0 context.Context, arg1 db.ReorderProjectsTxParams) ([]db.ListProjectsWithTechnologiesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderProjectsTx", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListProjectsWithTechnologiesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderProjectsTx indicates an expected call of ReorderProjectsTx.
+func (mr *MockStoreMockRecorder) ReorderProjectsTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderProjectsTx", reflect.TypeOf((*MockStore)(nil).ReorderProjectsTx), arg0, arg1)
+}
+
+// ReorderSkills mocks base method.
+func (m *MockStore) ReorderSkills(arg0 context.Context, arg1 db.ReorderSkillsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderSkills", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderSkills indicates an expected call of ReorderSkills.
+func (mr *MockStoreMockRecorder) ReorderSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderSkills", reflect.TypeOf((*MockStore)(nil).ReorderSkills), arg0, arg1)
+}
+
+// ReorderSkillsTx mocks base method.
+func (m *MockStore) ReorderSkillsTx(arg0 context.Context, arg1 db.ReorderSkillsTxParams) ([]db.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderSkillsTx", arg0, arg1)
+	ret0, _ := ret[0].([]db.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderSkillsTx indicates an expected call of ReorderSkillsTx.
+func (mr *MockStoreMockRecorder) ReorderSkillsTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderSkillsTx", reflect.TypeOf((*MockStore)(nil).ReorderSkillsTx), arg0, arg1)
+}
+
+// ReplaceProjectSkillsTx mocks base method.
+func (m *MockStore) ReplaceProjectSkillsTx(arg0 context.Context, arg1 db.ReplaceProjectSkillsTxParams) ([]db.ProjectSkill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceProjectSkillsTx", arg0, arg1)
+	ret0, _ := ret[0].([]db.ProjectSkill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceProjectSkillsTx indicates an expected call of ReplaceProjectSkillsTx.
+func (mr *MockStoreMockRecorder) ReplaceProjectSkillsTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceProjectSkillsTx", reflect.TypeOf((*MockStore)(nil).ReplaceProjectSkillsTx), arg0, arg1)
+}
+
+// ReplaceProjectTechnologiesTx mocks base method.
+func (m *MockStore) ReplaceProjectTechnologiesTx(arg0 context.Context, arg1 db.ReplaceProjectTechnologiesTxParams) ([]db.ListTechnologiesForProjectRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceProjectTechnologiesTx", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTechnologiesForProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceProjectTechnologiesTx indicates an expected call of ReplaceProjectTechnologiesTx.
+func (mr *MockStoreMockRecorder) ReplaceProjectTechnologiesTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceProjectTechnologiesTx", reflect.TypeOf((*MockStore)(nil).ReplaceProjectTechnologiesTx), arg0, arg1)
+}
+
+// SearchCvProfile mocks base method.
+func (m *MockStore) SearchCvProfile(arg0 context.Context, arg1 db.SearchCvProfileParams) ([]db.SearchCvProfileRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCvProfile", arg0, arg1)
+	ret0, _ := ret[0].([]db.SearchCvProfileRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCvProfile indicates an expected call of SearchCvProfile.
+func (mr *MockStoreMockRecorder) SearchCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCvProfile", reflect.TypeOf((*MockStore)(nil).SearchCvProfile), arg0, arg1)
+}
+
+// SeedCvProfileTx mocks base method.
+func (m *MockStore) SeedCvProfileTx(arg0 context.Context, arg1 db.SeedCvProfileTxParams) (db.CvProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedCvProfileTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CvProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeedCvProfileTx indicates an expected call of SeedCvProfileTx.
+func (mr *MockStoreMockRecorder) SeedCvProfileTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedCvProfileTx", reflect.TypeOf((*MockStore)(nil).SeedCvProfileTx), arg0, arg1)
+}
+
+// UpdateCvEducation mocks base method.
+func (m *MockStore) UpdateCvEducation(arg0 context.Context, arg1 db.UpdateCvEducationParams) (db.CvEducation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCvEducation", arg0, arg1)
+	ret0, _ := ret[0].(db.CvEducation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCvEducation indicates an expected call of UpdateCvEducation.
+func (mr *MockStoreMockRecorder) UpdateCvEducation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCvEducation", reflect.TypeOf((*MockStore)(nil).UpdateCvEducation), arg0, arg1)
+}
+
+// UpdateCvExperience mocks base method.
+func (m *MockStore) UpdateCvExperience(arg0 context.Context, arg1 db.UpdateCvExperienceParams) (db.CvExperience, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCvExperience", arg0, arg1)
+	ret0, _ := ret[0].(db.CvExperience)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCvExperience indicates an expected call of UpdateCvExperience.
+func (mr *MockStoreMockRecorder) UpdateCvExperience(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCvExperience", reflect.TypeOf((*MockStore)(nil).UpdateCvExperience), arg0, arg1)
+}
+
+// UpdateCvProfile mocks base method.
+func (m *MockStore) UpdateCvProfile(arg0 context.Context, arg1 db.UpdateCvProfileParams) (db.CvProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCvProfile", arg0, arg1)
+	ret0, _ := ret[0].(db.CvProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCvProfile indicates an expected call of UpdateCvProfile.
+func (mr *MockStoreMockRecorder) UpdateCvProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCvProfile", reflect.TypeOf((*MockStore)(nil).UpdateCvProfile), arg0, arg1)
+}
+
+// UpdateProject mocks base method.
+func (m *MockStore) UpdateProject(arg0 context.Context, arg1 db.UpdateProjectParams) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockStoreMockRecorder) UpdateProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockStore)(nil).UpdateProject), arg0, arg1)
+}
+
+// UpdateProjectTx mocks base method.
+func (m *MockStore) UpdateProjectTx(arg0 context.Context, arg1 db.UpdateProjectTxParams) (db.ListProjectsWithTechnologiesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ListProjectsWithTechnologiesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectTx indicates an expected call of UpdateProjectTx.
+func (mr *MockStoreMockRecorder) UpdateProjectTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectTx", reflect.TypeOf((*MockStore)(nil).UpdateProjectTx), arg0, arg1)
+}
+
+// UpdateSkill mocks base method.
+func (m *MockStore) UpdateSkill(arg0 context.Context, arg1 db.UpdateSkillParams) (db.Skill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSkill", arg0, arg1)
+	ret0, _ := ret[0].(db.Skill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSkill indicates an expected call of UpdateSkill.
+func (mr *MockStoreMockRecorder) UpdateSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSkill", reflect.TypeOf((*MockStore)(nil).UpdateSkill), arg0, arg1)
+}
+
+// UpdateTechnology mocks base method.
+func (m *MockStore) UpdateTechnology(arg0 context.Context, arg1 db.UpdateTechnologyParams) (db.Technology, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTechnology", arg0, arg1)
+	ret0, _ := ret[0].(db.Technology)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTechnology indicates an expected call of UpdateTechnology.
+func (mr *MockStoreMockRecorder) UpdateTechnology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTechnology", reflect.TypeOf((*MockStore)(nil).UpdateTechnology), arg0, arg1)
+}
+
+// UpsertSkillCategory mocks base method.
+func (m *MockStore) UpsertSkillCategory(arg0 context.Context, arg1 db.UpsertSkillCategoryParams) (db.SkillCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSkillCategory", arg0, arg1)
+	ret0, _ := ret[0].(db.SkillCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSkillCategory indicates an expected call of UpsertSkillCategory.
+func (mr *MockStoreMockRecorder) UpsertSkillCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSkillCategory", reflect.TypeOf((*MockStore)(nil).UpsertSkillCategory), arg0, arg1)
 }
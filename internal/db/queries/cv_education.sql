@@ -14,3 +14,26 @@ FROM cv_educations
 WHERE cv_profile_id = $1
 ORDER BY start_date
 LIMIT $2 OFFSET $3;
+
+-- name: DeleteCvEducationsByCvProfile :exec
+DELETE
+FROM cv_educations
+WHERE cv_profile_id = $1;
+
+-- name: GetCvEducationByInstitution :one
+SELECT *
+FROM cv_educations
+WHERE cv_profile_id = $1
+  AND institution = $2
+  AND degree = $3
+ORDER BY id
+LIMIT 1;
+
+-- name: UpdateCvEducation :one
+UPDATE cv_educations
+SET institution = $2,
+    degree      = $3,
+    start_date  = $4,
+    end_date    = $5
+WHERE id = $1
+RETURNING *;
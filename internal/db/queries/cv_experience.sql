@@ -0,0 +1,120 @@
+-- name: CreateCvExperience :one
+INSERT INTO cv_experiences (company,
+                            position,
+                            location,
+                            employment_type,
+                            start_date,
+                            end_date,
+                            achievements,
+                            cv_profile_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetCvExperience :one
+SELECT *
+FROM cv_experiences
+WHERE id = $1;
+
+-- name: ListCvExperiences :many
+SELECT *
+FROM cv_experiences
+WHERE cv_profile_id = $1
+ORDER BY start_date DESC
+LIMIT $2 OFFSET $3;
+
+-- name: ListCvExperiencesWithJSON :many
+SELECT e.id,
+       e.company,
+       e.position,
+       e.location,
+       e.employment_type,
+       e.start_date,
+       e.end_date,
+       e.achievements,
+       e.cv_profile_id,
+       COALESCE((SELECT json_agg(json_build_object('id', s.id, 'name', s.name) ORDER BY s.importance)
+                 FROM cv_experience_skills es
+                          JOIN skills s ON es.skill_id = s.id
+                 WHERE es.cv_experience_id = e.id), '[]')::json AS skills,
+       COALESCE((SELECT json_agg(json_build_object('id', t.id, 'name', t.name, 'url', t.url) ORDER BY t.order_field)
+                 FROM cv_experience_technologies et
+                          JOIN technologies t ON et.technology_id = t.id
+                 WHERE et.cv_experience_id = e.id), '[]')::json AS technologies_used
+FROM cv_experiences e
+WHERE e.cv_profile_id = $1
+ORDER BY e.start_date DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CreateCvExperienceSkill :one
+INSERT INTO cv_experience_skills (cv_experience_id, skill_id)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: ListSkillsForCvExperience :many
+SELECT s.id,
+       s.name
+FROM cv_experience_skills es
+         JOIN skills s ON es.skill_id = s.id
+WHERE es.cv_experience_id = $1
+ORDER BY s.importance;
+
+-- name: CreateCvExperienceTechnology :one
+INSERT INTO cv_experience_technologies (cv_experience_id, technology_id)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: ListTechnologiesForCvExperience :many
+SELECT t.id,
+       t.name,
+       t.url
+FROM cv_experience_technologies et
+         JOIN technologies t ON et.technology_id = t.id
+WHERE et.cv_experience_id = $1
+ORDER BY t.order_field;
+
+-- name: DeleteCvExperienceSkillsByCvProfile :exec
+DELETE
+FROM cv_experience_skills
+WHERE cv_experience_id IN (SELECT id FROM cv_experiences WHERE cv_experiences.cv_profile_id = $1);
+
+-- name: DeleteCvExperienceTechnologiesByCvProfile :exec
+DELETE
+FROM cv_experience_technologies
+WHERE cv_experience_id IN (SELECT id FROM cv_experiences WHERE cv_experiences.cv_profile_id = $1);
+
+-- name: DeleteCvExperiencesByCvProfile :exec
+DELETE
+FROM cv_experiences
+WHERE cv_profile_id = $1;
+
+-- name: GetCvExperienceByCompany :one
+SELECT *
+FROM cv_experiences
+WHERE cv_profile_id = $1
+  AND company = $2
+  AND position = $3
+  AND start_date = $4
+ORDER BY id
+LIMIT 1;
+
+-- name: UpdateCvExperience :one
+UPDATE cv_experiences
+SET company         = $2,
+    position        = $3,
+    location        = $4,
+    employment_type = $5,
+    start_date      = $6,
+    end_date        = $7,
+    achievements    = $8
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteCvExperienceSkills :exec
+DELETE
+FROM cv_experience_skills
+WHERE cv_experience_id = $1;
+
+-- name: DeleteCvExperienceTechnologies :exec
+DELETE
+FROM cv_experience_technologies
+WHERE cv_experience_id = $1;
@@ -7,3 +7,34 @@ RETURNING *;
 SELECT *
 FROM cv_profiles
 WHERE id = $1;
+
+-- name: CvProfileExists :one
+SELECT EXISTS(SELECT 1
+              FROM cv_profiles
+              WHERE id = $1);
+
+-- name: DeleteCvProfile :one
+DELETE
+FROM cv_profiles
+WHERE id = $1
+RETURNING *;
+
+-- name: GetCvProfileByEmail :one
+SELECT *
+FROM cv_profiles
+WHERE email = $1
+ORDER BY id
+LIMIT 1;
+
+-- name: UpdateCvProfile :one
+UPDATE cv_profiles
+SET name            = $2,
+    email           = $3,
+    phone           = $4,
+    address         = $5,
+    linkedin_url    = $6,
+    github_url      = $7,
+    bio             = $8,
+    profile_picture = $9
+WHERE id = $1
+RETURNING *;
@@ -5,10 +5,16 @@ INSERT INTO projects (title,
                       image,
                       hex_theme_color,
                       project_url,
+                      significance,
                       cv_profile_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING *;
 
+-- name: GetProject :one
+SELECT *
+FROM projects
+WHERE id = $1;
+
 -- name: ListProjects :many
 SELECT id,
        title,
@@ -35,7 +41,103 @@ SELECT p.id,
 FROM projects p
          JOIN project_skills ps ON p.id = ps.project_id
          JOIN skills s ON ps.skill_id = s.id
-WHERE s.name = sqlc.arg(skill_name)::text
+WHERE s.slug = sqlc.arg(skill_slug)
   AND p.cv_profile_id = $1
 ORDER BY significance
 LIMIT $2 OFFSET $3;
+
+-- name: UpdateProject :one
+UPDATE projects
+SET title             = $2,
+    short_description = $3,
+    description       = $4,
+    image             = $5,
+    hex_theme_color   = $6,
+    project_url       = $7,
+    significance      = $8
+WHERE id = $1
+RETURNING *;
+
+-- name: PatchProject :one
+UPDATE projects
+SET title             = COALESCE(sqlc.narg(title), title),
+    short_description = COALESCE(sqlc.narg(short_description), short_description),
+    description       = COALESCE(sqlc.narg(description), description),
+    image             = COALESCE(sqlc.narg(image), image),
+    hex_theme_color   = COALESCE(sqlc.narg(hex_theme_color), hex_theme_color),
+    project_url       = COALESCE(sqlc.narg(project_url), project_url),
+    significance      = COALESCE(sqlc.narg(significance), significance)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteProject :one
+DELETE
+FROM projects
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteProjectsByCvProfile :exec
+DELETE
+FROM projects
+WHERE cv_profile_id = $1;
+
+-- name: ListProjectsWithTechnologyJSON :many
+SELECT p.id,
+       p.title,
+       p.short_description,
+       p.description,
+       p.image,
+       p.hex_theme_color,
+       p.project_url,
+       p.significance,
+       COALESCE((SELECT json_agg(json_build_object('id', t.id, 'name', t.name, 'url', t.url) ORDER BY t.order_field)
+                 FROM project_technologies pt
+                          JOIN technologies t ON pt.technology_id = t.id
+                 WHERE pt.project_id = p.id), '[]')::json AS technologies_used
+FROM projects p
+WHERE p.cv_profile_id = $1
+ORDER BY p.significance
+LIMIT $2 OFFSET $3;
+
+-- name: ListProjectsWithTechnologyJSONBySkillName :many
+SELECT p.id,
+       p.title,
+       p.short_description,
+       p.description,
+       p.image,
+       p.hex_theme_color,
+       p.project_url,
+       p.significance,
+       COALESCE((SELECT json_agg(json_build_object('id', t.id, 'name', t.name, 'url', t.url) ORDER BY t.order_field)
+                 FROM project_technologies pt
+                          JOIN technologies t ON pt.technology_id = t.id
+                 WHERE pt.project_id = p.id), '[]')::json AS technologies_used
+FROM projects p
+         JOIN project_skills ps ON p.id = ps.project_id
+         JOIN skills s ON ps.skill_id = s.id
+WHERE s.slug = sqlc.arg(skill_slug)
+  AND p.cv_profile_id = $1
+ORDER BY p.significance
+LIMIT $2 OFFSET $3;
+
+-- name: GetProjectByTitle :one
+SELECT *
+FROM projects
+WHERE cv_profile_id = $1
+  AND title = $2
+ORDER BY id
+LIMIT 1;
+
+-- name: ListProjectsByCvProfile :many
+SELECT *
+FROM projects
+WHERE cv_profile_id = $1
+ORDER BY significance, id;
+
+-- name: ReorderProjects :exec
+-- every project gets its position in project_ids as the significance
+UPDATE projects
+SET significance = o.position
+FROM unnest(sqlc.arg(project_ids)::int[]) WITH ORDINALITY AS o(id, position)
+WHERE projects.id = o.id
+  AND projects.cv_profile_id = sqlc.arg(cv_profile_id);
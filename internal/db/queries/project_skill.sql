@@ -4,3 +4,20 @@ INSERT INTO project_skills
  skill_id)
 VALUES ($1, $2)
 RETURNING *;
+
+-- name: DeleteProjectSkills :exec
+DELETE
+FROM project_skills
+WHERE project_id = $1;
+
+-- name: ListProjectSkills :many
+SELECT *
+FROM project_skills
+WHERE project_id = $1
+ORDER BY skill_id;
+
+-- name: DeleteProjectSkillsByCvProfile :exec
+DELETE
+FROM project_skills
+WHERE project_id IN (SELECT id FROM projects WHERE projects.cv_profile_id = $1)
+   OR skill_id IN (SELECT id FROM skills WHERE skills.cv_profile_id = $1);
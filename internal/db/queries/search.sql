@@ -0,0 +1,43 @@
+-- name: SearchCvProfile :many
+-- the text is escaped before ts_headline, so the <mark> tags are the only markup of the snippets
+WITH search AS (SELECT websearch_to_tsquery('english', sqlc.arg(query)::text) AS query)
+SELECT 'project'::text AS kind,
+       p.id,
+       p.title,
+       ts_headline('english', replace(replace(replace(p.short_description || ' ' || p.description, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), search.query,
+                   'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10')::text AS snippet,
+       ts_rank(setweight(to_tsvector('english', p.title), 'A') ||
+               setweight(to_tsvector('english', p.short_description), 'B') ||
+               setweight(to_tsvector('english', p.description), 'C'), search.query) AS rank
+FROM projects p,
+     search
+WHERE p.cv_profile_id = $1
+  AND (setweight(to_tsvector('english', p.title), 'A') ||
+       setweight(to_tsvector('english', p.short_description), 'B') ||
+       setweight(to_tsvector('english', p.description), 'C')) @@ search.query
+UNION ALL
+SELECT 'skill'::text AS kind,
+       s.id,
+       s.name,
+       ts_headline('english', replace(replace(replace(s.description, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), search.query,
+                   'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10')::text,
+       ts_rank(setweight(to_tsvector('english', s.name), 'A') ||
+               setweight(to_tsvector('english', s.description), 'B'), search.query)
+FROM skills s,
+     search
+WHERE s.cv_profile_id = $1
+  AND (setweight(to_tsvector('english', s.name), 'A') ||
+       setweight(to_tsvector('english', s.description), 'B')) @@ search.query
+UNION ALL
+SELECT 'profile'::text AS kind,
+       c.id,
+       c.name,
+       ts_headline('english', replace(replace(replace(c.bio, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), search.query,
+                   'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10')::text,
+       ts_rank(setweight(to_tsvector('english', c.bio), 'B'), search.query)
+FROM cv_profiles c,
+     search
+WHERE c.id = $1
+  AND setweight(to_tsvector('english', c.bio), 'B') @@ search.query
+ORDER BY rank DESC, kind, id
+LIMIT $2 OFFSET $3;
@@ -1,6 +1,6 @@
 -- name: CreateSkill :one
-INSERT INTO skills (name, description, category, importance, image, hex_theme_color, cv_profile_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO skills (name, slug, description, category, importance, image, hex_theme_color, cv_profile_id)
+VALUES ($1, $8, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 
 -- name: GetSkill :one
@@ -12,6 +12,73 @@ WHERE id = $1;
 SELECT *
 FROM skills
 WHERE cv_profile_id = $1
-GROUP BY category, id
-ORDER BY importance
+ORDER BY importance, category, id
 LIMIT $2 OFFSET $3;
+
+-- name: DeleteSkillsByCvProfile :exec
+DELETE
+FROM skills
+WHERE cv_profile_id = $1;
+
+-- name: GetSkillByName :one
+SELECT *
+FROM skills
+WHERE cv_profile_id = $1
+  AND name = $2;
+
+-- name: GetSkillBySlug :one
+-- the callers slug a name with utils.Slugify, so both a slug and a name find the skill
+SELECT *
+FROM skills
+WHERE cv_profile_id = $1
+  AND slug = $2;
+
+-- name: UpdateSkill :one
+UPDATE skills
+SET name            = $2,
+    slug            = $8,
+    description     = $3,
+    category        = $4,
+    importance      = $5,
+    image           = $6,
+    hex_theme_color = $7
+WHERE id = $1
+RETURNING *;
+
+-- name: ReleaseSkillImportance :exec
+-- the skill gets its negated ID as the importance, which no other skill can hold, so that skills updated one by one
+-- can swap their importances
+UPDATE skills
+SET importance = -id
+WHERE id = $1;
+
+-- name: ListSkillsByCategory :many
+SELECT *
+FROM skills
+WHERE cv_profile_id = $1
+  AND category = $2
+ORDER BY importance, id;
+
+-- name: ReorderSkills :exec
+-- every skill gets its position in skill_ids as the importance, the deferrable unique constraint
+-- is checked after the whole statement, so the skills can swap their importances
+UPDATE skills
+SET importance = o.position
+FROM unnest(sqlc.arg(skill_ids)::int[]) WITH ORDINALITY AS o(id, position)
+WHERE skills.id = o.id
+  AND skills.cv_profile_id = sqlc.arg(cv_profile_id)
+  AND skills.category = sqlc.arg(category);
+
+-- name: ListSkillsWithCategories :many
+-- the skills of a cv profile in the display order of their categories, and by importance within a category.
+-- The categories without a row in skill_categories come last, ordered by name.
+SELECT skills.*,
+       COALESCE(skill_categories.description, '')::text        AS category_description,
+       COALESCE(skill_categories.hex_theme_color, '')::varchar AS category_hex_theme_color,
+       COALESCE(skill_categories.display_order, 0)::int        AS category_display_order
+FROM skills
+         LEFT JOIN skill_categories
+                   ON skill_categories.cv_profile_id = skills.cv_profile_id
+                       AND skill_categories.name = skills.category
+WHERE skills.cv_profile_id = $1
+ORDER BY skill_categories.id IS NULL, skill_categories.display_order, skills.category, skills.importance, skills.id;
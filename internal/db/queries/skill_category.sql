@@ -0,0 +1,9 @@
+-- name: UpsertSkillCategory :one
+-- the category is created, or its description, theme color and display order are replaced
+INSERT INTO skill_categories (cv_profile_id, name, description, hex_theme_color, display_order)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (cv_profile_id, name) DO UPDATE
+    SET description     = excluded.description,
+        hex_theme_color = excluded.hex_theme_color,
+        display_order   = excluded.display_order
+RETURNING *;
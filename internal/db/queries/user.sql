@@ -0,0 +1,9 @@
+-- name: CreateUser :one
+INSERT INTO users (username, hashed_password)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetUser :one
+SELECT *
+FROM users
+WHERE username = $1;
@@ -0,0 +1,14 @@
+package db_test
+
+import (
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/internal/db/storetest"
+	"testing"
+)
+
+// storetest imports this package, so the contract tests are run from an external test package
+func TestSQLStore_Contract(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) db.Store {
+		return db.NewTestStore()
+	})
+}
@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.20.0
+//   sqlc v1.30.0
 // source: cv_education.sql
 
 package db
@@ -17,11 +17,11 @@ RETURNING id, institution, degree, start_date, end_date, cv_profile_id
 `
 
 type CreateCvEducationParams struct {
-	Institution string    `json:"institution"`
-	Degree      string    `json:"degree"`
-	StartDate   time.Time `json:"start_date"`
-	EndDate     time.Time `json:"end_date"`
-	CvProfileID int32     `json:"cv_profile_id"`
+	Institution string     `json:"institution"`
+	Degree      string     `json:"degree"`
+	StartDate   time.Time  `json:"start_date"`
+	EndDate     *time.Time `json:"end_date"`
+	CvProfileID int32      `json:"cv_profile_id"`
 }
 
 func (q *Queries) CreateCvEducation(ctx context.Context, arg CreateCvEducationParams) (CvEducation, error) {
@@ -44,6 +44,17 @@ func (q *Queries) CreateCvEducation(ctx context.Context, arg CreateCvEducationPa
 	return i, err
 }
 
+const deleteCvEducationsByCvProfile = `-- name: DeleteCvEducationsByCvProfile :exec
+DELETE
+FROM cv_educations
+WHERE cv_profile_id = $1
+`
+
+func (q *Queries) DeleteCvEducationsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteCvEducationsByCvProfile, cvProfileID)
+	return err
+}
+
 const getCvEducation = `-- name: GetCvEducation :one
 SELECT id, institution, degree, start_date, end_date, cv_profile_id
 FROM cv_educations
@@ -64,6 +75,36 @@ func (q *Queries) GetCvEducation(ctx context.Context, id int32) (CvEducation, er
 	return i, err
 }
 
+const getCvEducationByInstitution = `-- name: GetCvEducationByInstitution :one
+SELECT id, institution, degree, start_date, end_date, cv_profile_id
+FROM cv_educations
+WHERE cv_profile_id = $1
+  AND institution = $2
+  AND degree = $3
+ORDER BY id
+LIMIT 1
+`
+
+type GetCvEducationByInstitutionParams struct {
+	CvProfileID int32  `json:"cv_profile_id"`
+	Institution string `json:"institution"`
+	Degree      string `json:"degree"`
+}
+
+func (q *Queries) GetCvEducationByInstitution(ctx context.Context, arg GetCvEducationByInstitutionParams) (CvEducation, error) {
+	row := q.db.QueryRowContext(ctx, getCvEducationByInstitution, arg.CvProfileID, arg.Institution, arg.Degree)
+	var i CvEducation
+	err := row.Scan(
+		&i.ID,
+		&i.Institution,
+		&i.Degree,
+		&i.StartDate,
+		&i.EndDate,
+		&i.CvProfileID,
+	)
+	return i, err
+}
+
 const listCvEducations = `-- name: ListCvEducations :many
 SELECT id, institution, degree, start_date, end_date, cv_profile_id
 FROM cv_educations
@@ -107,3 +148,41 @@ func (q *Queries) ListCvEducations(ctx context.Context, arg ListCvEducationsPara
 	}
 	return items, nil
 }
+
+const updateCvEducation = `-- name: UpdateCvEducation :one
+UPDATE cv_educations
+SET institution = $2,
+    degree      = $3,
+    start_date  = $4,
+    end_date    = $5
+WHERE id = $1
+RETURNING id, institution, degree, start_date, end_date, cv_profile_id
+`
+
+type UpdateCvEducationParams struct {
+	ID          int32      `json:"id"`
+	Institution string     `json:"institution"`
+	Degree      string     `json:"degree"`
+	StartDate   time.Time  `json:"start_date"`
+	EndDate     *time.Time `json:"end_date"`
+}
+
+func (q *Queries) UpdateCvEducation(ctx context.Context, arg UpdateCvEducationParams) (CvEducation, error) {
+	row := q.db.QueryRowContext(ctx, updateCvEducation,
+		arg.ID,
+		arg.Institution,
+		arg.Degree,
+		arg.StartDate,
+		arg.EndDate,
+	)
+	var i CvEducation
+	err := row.Scan(
+		&i.ID,
+		&i.Institution,
+		&i.Degree,
+		&i.StartDate,
+		&i.EndDate,
+		&i.CvProfileID,
+	)
+	return i, err
+}
@@ -13,11 +13,12 @@ func createRandomCvEducation(t *testing.T, cvProfileID int32) CvEducation {
 	if cvProfileID == 0 {
 		cvProfileID = createRandomCvProfile(t).ID
 	}
+	endDate := time.Now()
 	params := CreateCvEducationParams{
 		Institution: utils.RandomString(5),
 		Degree:      utils.RandomString(5),
 		StartDate:   time.Now(),
-		EndDate:     time.Now(),
+		EndDate:     &endDate,
 		CvProfileID: cvProfileID,
 	}
 
@@ -47,7 +48,7 @@ func TestQueries_GetCvEducation(t *testing.T) {
 	require.Equal(t, cvEducation.Institution, cvEducation2.Institution)
 	require.Equal(t, cvEducation.Degree, cvEducation2.Degree)
 	require.WithinDuration(t, cvEducation.StartDate, cvEducation2.StartDate, 24*time.Hour)
-	require.WithinDuration(t, cvEducation.EndDate, cvEducation2.EndDate, 24*time.Hour)
+	require.WithinDuration(t, *cvEducation.EndDate, *cvEducation2.EndDate, 24*time.Hour)
 	require.Equal(t, cvEducation.StartDate.Format("2006-01-02"), cvEducation2.StartDate.Format("2006-01-02"))
 	require.Equal(t, cvEducation.EndDate.Format("2006-01-02"), cvEducation2.EndDate.Format("2006-01-02"))
 	require.Equal(t, cvEducation.CvProfileID, cvEducation2.CvProfileID)
@@ -0,0 +1,139 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+// createRandomCvExperience create and return a random cv work experience
+func createRandomCvExperience(t *testing.T, cvProfileID int32) CvExperience {
+	if cvProfileID == 0 {
+		cvProfileID = createRandomCvProfile(t).ID
+	}
+	params := CreateCvExperienceParams{
+		Company:        utils.RandomString(6),
+		Position:       utils.RandomString(8),
+		Location:       utils.RandomString(5),
+		EmploymentType: "full-time",
+		StartDate:      time.Now().Add(-time.Hour * 24 * 365),
+		EndDate: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		Achievements: []string{utils.RandomString(10), utils.RandomString(12)},
+		CvProfileID:  cvProfileID,
+	}
+
+	cvExperience, err := testQueries.CreateCvExperience(context.Background(), params)
+	require.NoError(t, err)
+	require.NotEmpty(t, cvExperience)
+	require.Equal(t, params.Company, cvExperience.Company)
+	require.Equal(t, params.Position, cvExperience.Position)
+	require.Equal(t, params.Location, cvExperience.Location)
+	require.Equal(t, params.EmploymentType, cvExperience.EmploymentType)
+	require.Equal(t, params.StartDate.Format("2006-01-02"), cvExperience.StartDate.Format("2006-01-02"))
+	require.True(t, cvExperience.EndDate.Valid)
+	require.Equal(t, params.EndDate.Time.Format("2006-01-02"), cvExperience.EndDate.Time.Format("2006-01-02"))
+	require.Equal(t, params.Achievements, cvExperience.Achievements)
+	require.Equal(t, params.CvProfileID, cvExperience.CvProfileID)
+	require.NotZero(t, cvExperience.ID)
+
+	return cvExperience
+}
+
+func TestQueries_CreateCvExperience(t *testing.T) {
+	createRandomCvExperience(t, 0)
+}
+
+func TestQueries_CreateCvExperienceCurrentJob(t *testing.T) {
+	cvProfile := createRandomCvProfile(t)
+	cvExperience, err := testQueries.CreateCvExperience(context.Background(), CreateCvExperienceParams{
+		Company:        utils.RandomString(6),
+		Position:       utils.RandomString(8),
+		Location:       utils.RandomString(5),
+		EmploymentType: "contract",
+		StartDate:      time.Now(),
+		Achievements:   []string{},
+		CvProfileID:    cvProfile.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, cvExperience.EndDate.Valid)
+	require.Empty(t, cvExperience.Achievements)
+}
+
+func TestQueries_GetCvExperience(t *testing.T) {
+	cvExperience := createRandomCvExperience(t, 0)
+	cvExperience2, err := testQueries.GetCvExperience(context.Background(), cvExperience.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, cvExperience2)
+	require.Equal(t, cvExperience.ID, cvExperience2.ID)
+	require.Equal(t, cvExperience.Company, cvExperience2.Company)
+	require.Equal(t, cvExperience.Position, cvExperience2.Position)
+	require.Equal(t, cvExperience.Location, cvExperience2.Location)
+	require.Equal(t, cvExperience.EmploymentType, cvExperience2.EmploymentType)
+	require.Equal(t, cvExperience.StartDate.Format("2006-01-02"), cvExperience2.StartDate.Format("2006-01-02"))
+	require.Equal(t, cvExperience.EndDate.Time.Format("2006-01-02"), cvExperience2.EndDate.Time.Format("2006-01-02"))
+	require.Equal(t, cvExperience.Achievements, cvExperience2.Achievements)
+	require.Equal(t, cvExperience.CvProfileID, cvExperience2.CvProfileID)
+}
+
+func TestQueries_ListCvExperiences(t *testing.T) {
+	cvProfile := createRandomCvProfile(t)
+	for i := 0; i < 5; i++ {
+		createRandomCvExperience(t, cvProfile.ID)
+	}
+
+	params := ListCvExperiencesParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+	}
+
+	cvExperiences, err := testQueries.ListCvExperiences(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, cvExperiences, 5)
+
+	for _, cvExperience := range cvExperiences {
+		require.NotEmpty(t, cvExperience)
+		require.Equal(t, cvProfile.ID, cvExperience.CvProfileID)
+	}
+}
+
+func TestQueries_ListSkillsForCvExperience(t *testing.T) {
+	cvExperience := createRandomCvExperience(t, 0)
+	skill := createRandomSkill(t, cvExperience.CvProfileID)
+
+	_, err := testQueries.CreateCvExperienceSkill(context.Background(), CreateCvExperienceSkillParams{
+		CvExperienceID: cvExperience.ID,
+		SkillID:        skill.ID,
+	})
+	require.NoError(t, err)
+
+	skills, err := testQueries.ListSkillsForCvExperience(context.Background(), cvExperience.ID)
+	require.NoError(t, err)
+	require.Len(t, skills, 1)
+	require.Equal(t, skill.ID, skills[0].ID)
+	require.Equal(t, skill.Name, skills[0].Name)
+}
+
+func TestQueries_ListTechnologiesForCvExperience(t *testing.T) {
+	cvExperience := createRandomCvExperience(t, 0)
+	technology := createRandomTechnology(t)
+
+	_, err := testQueries.CreateCvExperienceTechnology(context.Background(), CreateCvExperienceTechnologyParams{
+		CvExperienceID: cvExperience.ID,
+		TechnologyID:   technology.ID,
+	})
+	require.NoError(t, err)
+
+	technologies, err := testQueries.ListTechnologiesForCvExperience(context.Background(), cvExperience.ID)
+	require.NoError(t, err)
+	require.Len(t, technologies, 1)
+	require.Equal(t, technology.ID, technologies[0].ID)
+	require.Equal(t, technology.Name, technologies[0].Name)
+	require.Equal(t, technology.Url, technologies[0].Url)
+}
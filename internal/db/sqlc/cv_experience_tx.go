@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+)
+
+type CreateCvExperienceTxParams struct {
+	CreateCvExperienceParams
+	SkillIDs      []int32 `json:"skill_ids"`
+	TechnologyIDs []int32 `json:"technology_ids"`
+}
+
+// CreateCvExperienceTx creates a work experience together with its skill and technology links in one transaction
+func (store *SQLStore) CreateCvExperienceTx(ctx context.Context, arg CreateCvExperienceTxParams) (ListCvExperiencesWithDetailsRow, error) {
+	var result ListCvExperiencesWithDetailsRow
+
+	err := store.execTx(ctx, func(q Querier) error {
+		experience, err := q.CreateCvExperience(ctx, arg.CreateCvExperienceParams)
+		if err != nil {
+			return err
+		}
+
+		for _, skillID := range arg.SkillIDs {
+			_, err = q.CreateCvExperienceSkill(ctx, CreateCvExperienceSkillParams{
+				CvExperienceID: experience.ID,
+				SkillID:        skillID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, technologyID := range arg.TechnologyIDs {
+			_, err = q.CreateCvExperienceTechnology(ctx, CreateCvExperienceTechnologyParams{
+				CvExperienceID: experience.ID,
+				TechnologyID:   technologyID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		skills, err := q.ListSkillsForCvExperience(ctx, experience.ID)
+		if err != nil {
+			return err
+		}
+
+		technologies, err := q.ListTechnologiesForCvExperience(ctx, experience.ID)
+		if err != nil {
+			return err
+		}
+
+		result = ListCvExperiencesWithDetailsRow{
+			ID:               experience.ID,
+			Company:          experience.Company,
+			Position:         experience.Position,
+			Location:         experience.Location,
+			EmploymentType:   experience.EmploymentType,
+			StartDate:        experience.StartDate,
+			EndDate:          nullTimePtr(experience.EndDate),
+			Achievements:     experience.Achievements,
+			Skills:           skills,
+			TechnologiesUsed: technologies,
+		}
+		return nil
+	})
+
+	return result, err
+}
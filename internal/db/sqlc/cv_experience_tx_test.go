@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestSQLStore_CreateCvExperienceTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	cvProfile := createRandomCvProfile(t)
+	skill := createRandomSkill(t, cvProfile.ID)
+	technology := createRandomTechnology(t)
+
+	params := CreateCvExperienceTxParams{
+		CreateCvExperienceParams: CreateCvExperienceParams{
+			Company:        utils.RandomString(6),
+			Position:       utils.RandomString(8),
+			Location:       utils.RandomString(5),
+			EmploymentType: "full-time",
+			StartDate:      time.Now(),
+			Achievements:   []string{utils.RandomString(10)},
+			CvProfileID:    cvProfile.ID,
+		},
+		SkillIDs:      []int32{skill.ID},
+		TechnologyIDs: []int32{technology.ID},
+	}
+
+	experience, err := store.CreateCvExperienceTx(context.Background(), params)
+	require.NoError(t, err)
+	require.NotZero(t, experience.ID)
+	require.Equal(t, params.Company, experience.Company)
+	require.Nil(t, experience.EndDate)
+	require.Equal(t, params.Achievements, experience.Achievements)
+	require.Len(t, experience.Skills, 1)
+	require.Equal(t, skill.ID, experience.Skills[0].ID)
+	require.Len(t, experience.TechnologiesUsed, 1)
+	require.Equal(t, technology.ID, experience.TechnologiesUsed[0].ID)
+
+	// the list query returns the same data
+	experiences, err := store.ListCvExperiencesWithDetails(context.Background(), ListCvExperiencesWithDetailsParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.Len(t, experiences, 1)
+	require.Equal(t, experience.ID, experiences[0].ID)
+	require.Nil(t, experiences[0].EndDate)
+	require.Equal(t, experience.Skills, experiences[0].Skills)
+	require.Equal(t, experience.TechnologiesUsed, experiences[0].TechnologiesUsed)
+}
+
+func TestSQLStore_CreateCvExperienceTxRollback(t *testing.T) {
+	store := NewStore(testDB)
+
+	cvProfile := createRandomCvProfile(t)
+
+	_, err := store.CreateCvExperienceTx(context.Background(), CreateCvExperienceTxParams{
+		CreateCvExperienceParams: CreateCvExperienceParams{
+			Company:        utils.RandomString(6),
+			Position:       utils.RandomString(8),
+			Location:       utils.RandomString(5),
+			EmploymentType: "full-time",
+			StartDate:      time.Now(),
+			Achievements:   []string{},
+			CvProfileID:    cvProfile.ID,
+		},
+		// the skill does not exist, so the whole transaction has to be rolled back
+		SkillIDs: []int32{-1},
+	})
+	require.Error(t, err)
+
+	experiences, err := store.ListCvExperiences(context.Background(), ListCvExperiencesParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, experiences)
+}
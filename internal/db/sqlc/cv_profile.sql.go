@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.20.0
+//   sqlc v1.30.0
 // source: cv_profile.sql
 
 package db
@@ -54,6 +54,44 @@ func (q *Queries) CreateCvProfile(ctx context.Context, arg CreateCvProfileParams
 	return i, err
 }
 
+const cvProfileExists = `-- name: CvProfileExists :one
+SELECT EXISTS(SELECT 1
+              FROM cv_profiles
+              WHERE id = $1)
+`
+
+func (q *Queries) CvProfileExists(ctx context.Context, id int32) (bool, error) {
+	row := q.db.QueryRowContext(ctx, cvProfileExists, id)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const deleteCvProfile = `-- name: DeleteCvProfile :one
+DELETE
+FROM cv_profiles
+WHERE id = $1
+RETURNING id, name, email, phone, address, linkedin_url, github_url, bio, created_at, profile_picture
+`
+
+func (q *Queries) DeleteCvProfile(ctx context.Context, id int32) (CvProfile, error) {
+	row := q.db.QueryRowContext(ctx, deleteCvProfile, id)
+	var i CvProfile
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Phone,
+		&i.Address,
+		&i.LinkedinUrl,
+		&i.GithubUrl,
+		&i.Bio,
+		&i.CreatedAt,
+		&i.ProfilePicture,
+	)
+	return i, err
+}
+
 const getCvProfile = `-- name: GetCvProfile :one
 SELECT id, name, email, phone, address, linkedin_url, github_url, bio, created_at, profile_picture
 FROM cv_profiles
@@ -77,3 +115,83 @@ func (q *Queries) GetCvProfile(ctx context.Context, id int32) (CvProfile, error)
 	)
 	return i, err
 }
+
+const getCvProfileByEmail = `-- name: GetCvProfileByEmail :one
+SELECT id, name, email, phone, address, linkedin_url, github_url, bio, created_at, profile_picture
+FROM cv_profiles
+WHERE email = $1
+ORDER BY id
+LIMIT 1
+`
+
+func (q *Queries) GetCvProfileByEmail(ctx context.Context, email string) (CvProfile, error) {
+	row := q.db.QueryRowContext(ctx, getCvProfileByEmail, email)
+	var i CvProfile
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Phone,
+		&i.Address,
+		&i.LinkedinUrl,
+		&i.GithubUrl,
+		&i.Bio,
+		&i.CreatedAt,
+		&i.ProfilePicture,
+	)
+	return i, err
+}
+
+const updateCvProfile = `-- name: UpdateCvProfile :one
+UPDATE cv_profiles
+SET name            = $2,
+    email           = $3,
+    phone           = $4,
+    address         = $5,
+    linkedin_url    = $6,
+    github_url      = $7,
+    bio             = $8,
+    profile_picture = $9
+WHERE id = $1
+RETURNING id, name, email, phone, address, linkedin_url, github_url, bio, created_at, profile_picture
+`
+
+type UpdateCvProfileParams struct {
+	ID             int32          `json:"id"`
+	Name           string         `json:"name"`
+	Email          string         `json:"email"`
+	Phone          string         `json:"phone"`
+	Address        string         `json:"address"`
+	LinkedinUrl    sql.NullString `json:"linkedin_url"`
+	GithubUrl      string         `json:"github_url"`
+	Bio            string         `json:"bio"`
+	ProfilePicture string         `json:"profile_picture"`
+}
+
+func (q *Queries) UpdateCvProfile(ctx context.Context, arg UpdateCvProfileParams) (CvProfile, error) {
+	row := q.db.QueryRowContext(ctx, updateCvProfile,
+		arg.ID,
+		arg.Name,
+		arg.Email,
+		arg.Phone,
+		arg.Address,
+		arg.LinkedinUrl,
+		arg.GithubUrl,
+		arg.Bio,
+		arg.ProfilePicture,
+	)
+	var i CvProfile
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.Phone,
+		&i.Address,
+		&i.LinkedinUrl,
+		&i.GithubUrl,
+		&i.Bio,
+		&i.CreatedAt,
+		&i.ProfilePicture,
+	)
+	return i, err
+}
@@ -9,7 +9,7 @@ import (
 )
 
 // createRandomCvProfile creates and return a random cv profile
-func createRandomCvProfile(t *testing.T) CvProfile {
+func createRandomCvProfile(t testing.TB) CvProfile {
 	params := CreateCvProfileParams{
 		Name:    utils.RandomString(5),
 		Email:   utils.RandomEmail(),
@@ -49,6 +49,21 @@ func TestQueries_CreateCvProfile(t *testing.T) {
 	createRandomCvProfile(t)
 }
 
+func TestQueries_CvProfileExists(t *testing.T) {
+	cvProfile := createRandomCvProfile(t)
+
+	exists, err := testQueries.CvProfileExists(context.Background(), cvProfile.ID)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	_, err = testQueries.DeleteCvProfile(context.Background(), cvProfile.ID)
+	require.NoError(t, err)
+
+	exists, err = testQueries.CvProfileExists(context.Background(), cvProfile.ID)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
 func TestQueries_GetCvProfile(t *testing.T) {
 	cvProfile := createRandomCvProfile(t)
 	cvProfile2, err := testQueries.GetCvProfile(context.Background(), cvProfile.ID)
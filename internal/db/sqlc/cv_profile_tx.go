@@ -0,0 +1,539 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+)
+
+// DeleteCvProfileTx deletes a cv profile together with everything that belongs to it in one transaction
+func (store *SQLStore) DeleteCvProfileTx(ctx context.Context, cvProfileID int32) error {
+	return store.execTx(ctx, func(q Querier) error {
+		// links have to go first, as they reference both projects and skills
+		err := q.DeleteProjectSkillsByCvProfile(ctx, cvProfileID)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteProjectTechnologiesByCvProfile(ctx, cvProfileID)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteCvExperienceSkillsByCvProfile(ctx, cvProfileID)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteCvExperienceTechnologiesByCvProfile(ctx, cvProfileID)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteProjectsByCvProfile(ctx, cvProfileID)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteSkillsByCvProfile(ctx, cvProfileID)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteCvExperiencesByCvProfile(ctx, cvProfileID)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteCvEducationsByCvProfile(ctx, cvProfileID)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.DeleteCvProfile(ctx, cvProfileID)
+		return err
+	})
+}
+
+type ImportCvProfileTxParams struct {
+	CreateCvProfileParams
+	// CvProfileID of the children is ignored, they are always linked to the new profile
+	Educations  []CreateCvEducationParams  `json:"educations"`
+	Experiences []CreateCvExperienceParams `json:"experiences"`
+	Skills      []CreateSkillParams        `json:"skills"`
+	Projects    []ImportProjectParams      `json:"projects"`
+}
+
+type ImportProjectParams struct {
+	CreateProjectParams
+	// TechnologyNames are linked to existing technologies with the same name, missing ones are created
+	TechnologyNames []string `json:"technology_names"`
+}
+
+// ImportCvProfileTx creates a cv profile together with all of its children in one transaction
+func (store *SQLStore) ImportCvProfileTx(ctx context.Context, arg ImportCvProfileTxParams) (CvProfile, error) {
+	var result CvProfile
+
+	err := store.execTx(ctx, func(q Querier) error {
+		var err error
+		result, err = q.CreateCvProfile(ctx, arg.CreateCvProfileParams)
+		if err != nil {
+			return err
+		}
+
+		for _, education := range arg.Educations {
+			education.CvProfileID = result.ID
+			if _, err = q.CreateCvEducation(ctx, education); err != nil {
+				return err
+			}
+		}
+
+		for _, experience := range arg.Experiences {
+			experience.CvProfileID = result.ID
+			if _, err = q.CreateCvExperience(ctx, experience); err != nil {
+				return err
+			}
+		}
+
+		for _, skill := range arg.Skills {
+			skill.CvProfileID = result.ID
+			skill.Slug = utils.Slugify(skill.Name)
+			if _, err = q.CreateSkill(ctx, skill); err != nil {
+				return err
+			}
+		}
+
+		// technologies are shared, so every name is looked up only once
+		technologyIDs := make(map[string]int32)
+		for _, project := range arg.Projects {
+			project.CvProfileID = result.ID
+			created, err := q.CreateProject(ctx, project.CreateProjectParams)
+			if err != nil {
+				return err
+			}
+
+			// a technology can only be linked to the project once
+			for _, name := range UniqueNames(project.TechnologyNames) {
+				technologyID, ok := technologyIDs[name]
+				if !ok {
+					technologyID, err = getOrCreateTechnology(ctx, q, name)
+					if err != nil {
+						return err
+					}
+					technologyIDs[name] = technologyID
+				}
+
+				_, err = q.CreateProjectTechnology(ctx, CreateProjectTechnologyParams{
+					ProjectID:    created.ID,
+					TechnologyID: technologyID,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// UniqueNames returns names without the repeated ones, in the order of their first occurrence
+func UniqueNames(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	unique := make([]string, 0, len(names))
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+
+	return unique
+}
+
+// getOrCreateTechnology returns the ID of the technology with the given name, creating it when it does not exist
+func getOrCreateTechnology(ctx context.Context, q Querier, name string) (int32, error) {
+	technology, err := q.GetTechnologyByName(ctx, name)
+	if err == nil {
+		return technology.ID, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	technology, err = q.CreateTechnology(ctx, CreateTechnologyParams{
+		Name: name,
+	})
+	return technology.ID, err
+}
+
+type SeedCvProfileTxParams struct {
+	// CreateCvProfileParams are matched with an existing profile by email
+	CreateCvProfileParams
+	// CvProfileID of the children is ignored, they are always linked to the seeded profile
+	Technologies []CreateTechnologyParams  `json:"technologies"`
+	Skills       []CreateSkillParams       `json:"skills"`
+	Educations   []CreateCvEducationParams `json:"educations"`
+	Experiences  []SeedCvExperienceParams  `json:"experiences"`
+	Projects     []SeedProjectParams       `json:"projects"`
+}
+
+type SeedCvExperienceParams struct {
+	CreateCvExperienceParams
+	// SkillNames replace the skills of the experience, they have to belong to the seeded profile
+	SkillNames []string `json:"skill_names"`
+	// TechnologyNames replace the technologies of the experience
+	TechnologyNames []string `json:"technology_names"`
+}
+
+type SeedProjectParams struct {
+	CreateProjectParams
+	// SkillNames replace the skills of the project, they have to belong to the seeded profile
+	SkillNames []string `json:"skill_names"`
+	// TechnologyNames replace the technologies of the project
+	TechnologyNames []string `json:"technology_names"`
+}
+
+// SeedCvProfileTx creates or updates a cv profile together with all of its children in one transaction.
+// Every row is matched by its natural key - profiles by email, skills by name, projects by title, educations by
+// institution and degree, experiences by company, position and start date and technologies by name - so seeding
+// the same data again changes nothing. Rows that are not in arg are left untouched.
+func (store *SQLStore) SeedCvProfileTx(ctx context.Context, arg SeedCvProfileTxParams) (CvProfile, error) {
+	var result CvProfile
+
+	err := store.execTx(ctx, func(q Querier) error {
+		var err error
+		result, err = upsertCvProfile(ctx, q, arg.CreateCvProfileParams)
+		if err != nil {
+			return err
+		}
+
+		technologyIDs := make(map[string]int32)
+		for _, technology := range arg.Technologies {
+			technologyIDs[technology.Name], err = upsertTechnology(ctx, q, technology)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err = releaseSkillImportances(ctx, q, result.ID, arg.Skills); err != nil {
+			return err
+		}
+
+		skillIDs := make(map[string]int32)
+		for _, skill := range arg.Skills {
+			skill.CvProfileID = result.ID
+			skillIDs[skill.Name], err = upsertSkill(ctx, q, skill)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, education := range arg.Educations {
+			education.CvProfileID = result.ID
+			if err = upsertCvEducation(ctx, q, education); err != nil {
+				return err
+			}
+		}
+
+		for _, experience := range arg.Experiences {
+			experience.CvProfileID = result.ID
+			experienceID, err := upsertCvExperience(ctx, q, experience.CreateCvExperienceParams)
+			if err != nil {
+				return err
+			}
+
+			err = seedCvExperienceLinks(ctx, q, experienceID, skillIDs, technologyIDs, experience)
+			if err != nil {
+				return fmt.Errorf("experience %q: %w", experience.Company, err)
+			}
+		}
+
+		for _, project := range arg.Projects {
+			project.CvProfileID = result.ID
+			projectID, err := upsertProject(ctx, q, project.CreateProjectParams)
+			if err != nil {
+				return err
+			}
+
+			ids, err := resolveSkillIDs(ctx, q, result.ID, skillIDs, project.SkillNames)
+			if err != nil {
+				return fmt.Errorf("project %q: %w", project.Title, err)
+			}
+			if err = replaceProjectSkills(ctx, q, projectID, ids); err != nil {
+				return err
+			}
+
+			ids, err = resolveTechnologyIDs(ctx, q, technologyIDs, project.TechnologyNames)
+			if err != nil {
+				return fmt.Errorf("project %q: %w", project.Title, err)
+			}
+			if err = replaceProjectTechnologies(ctx, q, projectID, ids); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// upsertCvProfile updates the profile with the same email, or creates a new one
+func upsertCvProfile(ctx context.Context, q Querier, arg CreateCvProfileParams) (CvProfile, error) {
+	existing, err := q.GetCvProfileByEmail(ctx, arg.Email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return q.CreateCvProfile(ctx, arg)
+	}
+	if err != nil {
+		return CvProfile{}, err
+	}
+
+	return q.UpdateCvProfile(ctx, UpdateCvProfileParams{
+		ID:             existing.ID,
+		Name:           arg.Name,
+		Email:          arg.Email,
+		Phone:          arg.Phone,
+		Address:        arg.Address,
+		LinkedinUrl:    arg.LinkedinUrl,
+		GithubUrl:      arg.GithubUrl,
+		Bio:            arg.Bio,
+		ProfilePicture: arg.ProfilePicture,
+	})
+}
+
+// upsertTechnology updates the technology with the same name, or creates a new one, and returns its ID
+func upsertTechnology(ctx context.Context, q Querier, arg CreateTechnologyParams) (int32, error) {
+	existing, err := q.GetTechnologyByName(ctx, arg.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		technology, err := q.CreateTechnology(ctx, arg)
+		return technology.ID, err
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	technology, err := q.UpdateTechnology(ctx, UpdateTechnologyParams{
+		ID:         existing.ID,
+		Url:        arg.Url,
+		OrderField: arg.OrderField,
+	})
+	return technology.ID, err
+}
+
+// releaseSkillImportances releases the importances of the existing skills that the seed moves, so that the skills
+// can swap their importances or categories while they are updated one by one
+func releaseSkillImportances(ctx context.Context, q Querier, cvProfileID int32, skills []CreateSkillParams) error {
+	for _, skill := range skills {
+		existing, err := q.GetSkillByName(ctx, GetSkillByNameParams{CvProfileID: cvProfileID, Name: skill.Name})
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if existing.Category != skill.Category || existing.Importance != skill.Importance {
+			if err = q.ReleaseSkillImportance(ctx, existing.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// upsertSkill updates the skill of the profile with the same name, or creates a new one, and returns its ID
+func upsertSkill(ctx context.Context, q Querier, arg CreateSkillParams) (int32, error) {
+	arg.Slug = utils.Slugify(arg.Name)
+	existing, err := q.GetSkillByName(ctx, GetSkillByNameParams{
+		CvProfileID: arg.CvProfileID,
+		Name:        arg.Name,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		skill, err := q.CreateSkill(ctx, arg)
+		return skill.ID, err
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	skill, err := q.UpdateSkill(ctx, UpdateSkillParams{
+		ID:            existing.ID,
+		Name:          arg.Name,
+		Description:   arg.Description,
+		Category:      arg.Category,
+		Importance:    arg.Importance,
+		Image:         arg.Image,
+		HexThemeColor: arg.HexThemeColor,
+		Slug:          arg.Slug,
+	})
+	return skill.ID, err
+}
+
+// upsertCvEducation updates the education of the profile with the same institution and degree, or creates a new one
+func upsertCvEducation(ctx context.Context, q Querier, arg CreateCvEducationParams) error {
+	existing, err := q.GetCvEducationByInstitution(ctx, GetCvEducationByInstitutionParams{
+		CvProfileID: arg.CvProfileID,
+		Institution: arg.Institution,
+		Degree:      arg.Degree,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		_, err = q.CreateCvEducation(ctx, arg)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = q.UpdateCvEducation(ctx, UpdateCvEducationParams{
+		ID:          existing.ID,
+		Institution: arg.Institution,
+		Degree:      arg.Degree,
+		StartDate:   arg.StartDate,
+		EndDate:     arg.EndDate,
+	})
+	return err
+}
+
+// upsertCvExperience updates the experience of the profile with the same company, position and start date,
+// or creates a new one, and returns its ID
+func upsertCvExperience(ctx context.Context, q Querier, arg CreateCvExperienceParams) (int32, error) {
+	existing, err := q.GetCvExperienceByCompany(ctx, GetCvExperienceByCompanyParams{
+		CvProfileID: arg.CvProfileID,
+		Company:     arg.Company,
+		Position:    arg.Position,
+		StartDate:   arg.StartDate,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		experience, err := q.CreateCvExperience(ctx, arg)
+		return experience.ID, err
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	experience, err := q.UpdateCvExperience(ctx, UpdateCvExperienceParams{
+		ID:             existing.ID,
+		Company:        arg.Company,
+		Position:       arg.Position,
+		Location:       arg.Location,
+		EmploymentType: arg.EmploymentType,
+		StartDate:      arg.StartDate,
+		EndDate:        arg.EndDate,
+		Achievements:   arg.Achievements,
+	})
+	return experience.ID, err
+}
+
+// upsertProject updates the project of the profile with the same title, or creates a new one, and returns its ID
+func upsertProject(ctx context.Context, q Querier, arg CreateProjectParams) (int32, error) {
+	existing, err := q.GetProjectByTitle(ctx, GetProjectByTitleParams{
+		CvProfileID: arg.CvProfileID,
+		Title:       arg.Title,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		project, err := q.CreateProject(ctx, arg)
+		return project.ID, err
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	project, err := q.UpdateProject(ctx, UpdateProjectParams{
+		ID:               existing.ID,
+		Title:            arg.Title,
+		ShortDescription: arg.ShortDescription,
+		Description:      arg.Description,
+		Image:            arg.Image,
+		HexThemeColor:    arg.HexThemeColor,
+		ProjectUrl:       arg.ProjectUrl,
+		Significance:     arg.Significance,
+	})
+	return project.ID, err
+}
+
+// seedCvExperienceLinks replaces the skills and technologies of the experience with the ones named in arg
+func seedCvExperienceLinks(ctx context.Context, q Querier, experienceID int32, skillIDs, technologyIDs map[string]int32, arg SeedCvExperienceParams) error {
+	ids, err := resolveSkillIDs(ctx, q, arg.CvProfileID, skillIDs, arg.SkillNames)
+	if err != nil {
+		return err
+	}
+
+	err = q.DeleteCvExperienceSkills(ctx, experienceID)
+	if err != nil {
+		return err
+	}
+
+	for _, skillID := range ids {
+		_, err = q.CreateCvExperienceSkill(ctx, CreateCvExperienceSkillParams{
+			CvExperienceID: experienceID,
+			SkillID:        skillID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	ids, err = resolveTechnologyIDs(ctx, q, technologyIDs, arg.TechnologyNames)
+	if err != nil {
+		return err
+	}
+
+	err = q.DeleteCvExperienceTechnologies(ctx, experienceID)
+	if err != nil {
+		return err
+	}
+
+	for _, technologyID := range ids {
+		_, err = q.CreateCvExperienceTechnology(ctx, CreateCvExperienceTechnologyParams{
+			CvExperienceID: experienceID,
+			TechnologyID:   technologyID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveSkillIDs returns the IDs of the named skills of the profile, looking in seeded first, a repeated name is returned once
+func resolveSkillIDs(ctx context.Context, q Querier, cvProfileID int32, seeded map[string]int32, names []string) ([]int32, error) {
+	ids := make([]int32, 0, len(names))
+	for _, name := range UniqueNames(names) {
+		id, ok := seeded[name]
+		if !ok {
+			skill, err := q.GetSkillByName(ctx, GetSkillByNameParams{CvProfileID: cvProfileID, Name: name})
+			if err != nil {
+				return nil, fmt.Errorf("skill %q: %w", name, err)
+			}
+			id = skill.ID
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// resolveTechnologyIDs returns the IDs of the named technologies, looking in seeded first, a repeated name is returned once
+func resolveTechnologyIDs(ctx context.Context, q Querier, seeded map[string]int32, names []string) ([]int32, error) {
+	ids := make([]int32, 0, len(names))
+	for _, name := range UniqueNames(names) {
+		id, ok := seeded[name]
+		if !ok {
+			technology, err := q.GetTechnologyByName(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("technology %q: %w", name, err)
+			}
+			id = technology.ID
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,272 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestSQLStore_DeleteCvProfileTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	// create a profile with every kind of child row
+	cvProfile := createRandomCvProfile(t)
+	createRandomCvEducation(t, cvProfile.ID)
+	skill := createRandomSkill(t, cvProfile.ID)
+	project := createRandomProject(t, cvProfile.ID)
+	createTestProjectSkill(t, project.ID, skill.ID)
+	technology := createRandomTechnology(t)
+	_, err := store.CreateProjectTechnology(context.Background(), CreateProjectTechnologyParams{
+		ProjectID:    project.ID,
+		TechnologyID: technology.ID,
+	})
+	require.NoError(t, err)
+	_, err = store.CreateCvExperienceTx(context.Background(), CreateCvExperienceTxParams{
+		CreateCvExperienceParams: CreateCvExperienceParams{
+			Company:        "company",
+			Position:       "position",
+			Location:       "location",
+			EmploymentType: "full-time",
+			StartDate:      cvProfile.CreatedAt,
+			Achievements:   []string{},
+			CvProfileID:    cvProfile.ID,
+		},
+		SkillIDs:      []int32{skill.ID},
+		TechnologyIDs: []int32{technology.ID},
+	})
+	require.NoError(t, err)
+
+	err = store.DeleteCvProfileTx(context.Background(), cvProfile.ID)
+	require.NoError(t, err)
+
+	_, err = store.GetCvProfile(context.Background(), cvProfile.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = store.GetProject(context.Background(), project.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = store.GetSkill(context.Background(), skill.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	educations, err := store.ListCvEducations(context.Background(), ListCvEducationsParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, educations)
+
+	experiences, err := store.ListCvExperiences(context.Background(), ListCvExperiencesParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, experiences)
+
+	// technologies are shared between profiles, so they are kept
+	var count int
+	err = testDB.QueryRow("SELECT COUNT(*) FROM technologies WHERE id = $1", technology.ID).Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+func TestSQLStore_DeleteCvProfileTxNotFound(t *testing.T) {
+	store := NewStore(testDB)
+
+	err := store.DeleteCvProfileTx(context.Background(), -1)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestSQLStore_ImportCvProfileTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	existingTechnology := createRandomTechnology(t)
+	newTechnologyName := utils.RandomString(8)
+	category := utils.RandomString(8)
+
+	params := ImportCvProfileTxParams{
+		CreateCvProfileParams: CreateCvProfileParams{
+			Name:      utils.RandomString(6),
+			Email:     utils.RandomEmail(),
+			GithubUrl: utils.RandomString(10),
+		},
+		Educations: []CreateCvEducationParams{
+			{Institution: utils.RandomString(6), Degree: utils.RandomString(6), StartDate: time.Now()},
+		},
+		Experiences: []CreateCvExperienceParams{
+			{Company: utils.RandomString(6), Position: utils.RandomString(6), StartDate: time.Now(), Achievements: []string{}},
+		},
+		Skills: []CreateSkillParams{
+			{Name: utils.RandomString(8), Category: category, Importance: 1, HexThemeColor: "#000000"},
+			{Name: utils.RandomString(8), Category: category, Importance: 2, HexThemeColor: "#000000"},
+		},
+		Projects: []ImportProjectParams{
+			{
+				CreateProjectParams: CreateProjectParams{Title: utils.RandomString(6), Significance: 1},
+				TechnologyNames:     []string{existingTechnology.Name, newTechnologyName},
+			},
+			{
+				CreateProjectParams: CreateProjectParams{Title: utils.RandomString(6), Significance: 2},
+				TechnologyNames:     []string{newTechnologyName},
+			},
+		},
+	}
+
+	cvProfile, err := store.ImportCvProfileTx(context.Background(), params)
+	require.NoError(t, err)
+	require.NotZero(t, cvProfile.ID)
+	require.Equal(t, params.Name, cvProfile.Name)
+
+	skills, err := store.ListSkills(context.Background(), ListSkillsParams{CvProfileID: cvProfile.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, skills, 2)
+
+	experiences, err := store.ListCvExperiences(context.Background(), ListCvExperiencesParams{CvProfileID: cvProfile.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, experiences, 1)
+
+	projects, err := store.ListProjectsWithTechnologies(context.Background(), ListProjectsWithTechnologiesParams{CvProfileID: cvProfile.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, projects, 2)
+	require.Len(t, projects[0].TechnologiesUsed, 2)
+	require.Len(t, projects[1].TechnologiesUsed, 1)
+
+	// existing technologies are reused and a new one is created only once
+	var ids []int32
+	for _, technology := range projects[0].TechnologiesUsed {
+		ids = append(ids, technology.ID)
+	}
+	require.Contains(t, ids, existingTechnology.ID)
+	require.Contains(t, ids, projects[1].TechnologiesUsed[0].ID)
+}
+
+func TestSQLStore_ImportCvProfileTxRollback(t *testing.T) {
+	store := NewStore(testDB)
+
+	skillName := utils.RandomString(12)
+	name := utils.RandomString(10)
+
+	// the second skill takes the name of the first one, so nothing should be created
+	_, err := store.ImportCvProfileTx(context.Background(), ImportCvProfileTxParams{
+		CreateCvProfileParams: CreateCvProfileParams{Name: name, Email: utils.RandomEmail()},
+		Skills: []CreateSkillParams{
+			{Name: skillName, Category: utils.RandomString(8), Importance: 1, HexThemeColor: "#000000"},
+			{Name: skillName, Category: utils.RandomString(8), Importance: 1, HexThemeColor: "#000000"},
+		},
+	})
+	require.Error(t, err)
+
+	var count int
+	err = testDB.QueryRow("SELECT COUNT(*) FROM cv_profiles WHERE name = $1", name).Scan(&count)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestSQLStore_SeedCvProfileTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	technologyName := utils.RandomString(8)
+	skillNames := []string{utils.RandomString(8), utils.RandomString(8)}
+	category := utils.RandomString(8)
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	params := SeedCvProfileTxParams{
+		CreateCvProfileParams: CreateCvProfileParams{
+			Name:  utils.RandomString(6),
+			Email: utils.RandomEmail(),
+		},
+		Technologies: []CreateTechnologyParams{
+			{Name: technologyName, Url: utils.RandomString(10), OrderField: 1},
+		},
+		Skills: []CreateSkillParams{
+			{Name: skillNames[0], Category: category, Importance: 1, HexThemeColor: "#000000"},
+			{Name: skillNames[1], Category: category, Importance: 2, HexThemeColor: "#000000"},
+		},
+		Educations: []CreateCvEducationParams{
+			{Institution: utils.RandomString(6), Degree: utils.RandomString(6), StartDate: startDate, EndDate: &startDate},
+		},
+		Experiences: []SeedCvExperienceParams{
+			{
+				CreateCvExperienceParams: CreateCvExperienceParams{Company: utils.RandomString(6), Position: utils.RandomString(6), StartDate: startDate, Achievements: []string{}},
+				SkillNames:               skillNames,
+				TechnologyNames:          []string{technologyName},
+			},
+		},
+		Projects: []SeedProjectParams{
+			{
+				CreateProjectParams: CreateProjectParams{Title: utils.RandomString(6), Significance: 1},
+				SkillNames:          skillNames[:1],
+				TechnologyNames:     []string{technologyName},
+			},
+		},
+	}
+
+	cvProfile, err := store.SeedCvProfileTx(context.Background(), params)
+	require.NoError(t, err)
+	require.NotZero(t, cvProfile.ID)
+
+	// seeding changed data again updates the same rows
+	params.Bio = utils.RandomString(20)
+	params.Technologies[0].Url = utils.RandomString(10)
+	params.Skills[0].Description = utils.RandomString(20)
+	params.Experiences[0].Location = utils.RandomString(6)
+	params.Projects[0].Description = utils.RandomString(20)
+	params.Projects[0].SkillNames = skillNames
+
+	seeded, err := store.SeedCvProfileTx(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, cvProfile.ID, seeded.ID)
+	require.Equal(t, params.Bio, seeded.Bio)
+
+	technology, err := store.GetTechnologyByName(context.Background(), technologyName)
+	require.NoError(t, err)
+	require.Equal(t, params.Technologies[0].Url, technology.Url)
+
+	skills, err := store.ListSkills(context.Background(), ListSkillsParams{CvProfileID: cvProfile.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, skills, 2)
+	require.Equal(t, params.Skills[0].Description, skills[0].Description)
+
+	educations, err := store.ListCvEducations(context.Background(), ListCvEducationsParams{CvProfileID: cvProfile.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, educations, 1)
+
+	experiences, err := store.ListCvExperiencesWithDetails(context.Background(), ListCvExperiencesWithDetailsParams{CvProfileID: cvProfile.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, experiences, 1)
+	require.Equal(t, params.Experiences[0].Location, experiences[0].Location)
+	require.Len(t, experiences[0].Skills, 2)
+	require.Len(t, experiences[0].TechnologiesUsed, 1)
+
+	projects, err := store.ListProjectsWithTechnologies(context.Background(), ListProjectsWithTechnologiesParams{CvProfileID: cvProfile.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	require.Equal(t, params.Projects[0].Description, projects[0].Description)
+	require.Len(t, projects[0].TechnologiesUsed, 1)
+
+	projectSkills, err := store.ListProjectSkills(context.Background(), projects[0].ID)
+	require.NoError(t, err)
+	require.Len(t, projectSkills, 2)
+}
+
+func TestSQLStore_SeedCvProfileTxUnknownSkill(t *testing.T) {
+	store := NewStore(testDB)
+	email := utils.RandomEmail()
+
+	_, err := store.SeedCvProfileTx(context.Background(), SeedCvProfileTxParams{
+		CreateCvProfileParams: CreateCvProfileParams{Name: utils.RandomString(6), Email: email},
+		Projects: []SeedProjectParams{
+			{
+				CreateProjectParams: CreateProjectParams{Title: utils.RandomString(6)},
+				SkillNames:          []string{utils.RandomString(8)},
+			},
+		},
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// the profile is rolled back together with the project
+	_, err = store.GetCvProfileByEmail(context.Background(), email)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,6 @@
+package db
+
+// NewTestStore returns a store on the test database, for the tests of package db_test
+func NewTestStore() Store {
+	return NewStore(testDB)
+}
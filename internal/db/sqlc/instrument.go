@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// QueryObserver is called after every query executed through the store,
+// name is the sqlc query name (e.g. "GetProject") or "unnamed" for raw SQL
+type QueryObserver func(name string, duration time.Duration, err error)
+
+// instrumentedDBTX wraps a connection or a transaction and reports the duration of every query
+type instrumentedDBTX struct {
+	DBTX
+	observe QueryObserver
+}
+
+// instrument wraps db with the observer, db is returned unchanged when there is no observer
+func instrument(db DBTX, observe QueryObserver) DBTX {
+	if observe == nil {
+		return db
+	}
+	return instrumentedDBTX{DBTX: db, observe: observe}
+}
+
+func (i instrumentedDBTX) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	start := time.Now()
+	result, err := i.DBTX.ExecContext(ctx, query, args...)
+	i.observe(queryName(query), time.Since(start), err)
+	return result, err
+}
+
+// QueryContext measures the time until the first rows are available, reading them is not included
+func (i instrumentedDBTX) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	start := time.Now()
+	rows, err := i.DBTX.QueryContext(ctx, query, args...)
+	i.observe(queryName(query), time.Since(start), err)
+	return rows, err
+}
+
+// QueryRowContext reports the row error, errors returned only by Scan (like sql.ErrNoRows) are not reported
+func (i instrumentedDBTX) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	start := time.Now()
+	row := i.DBTX.QueryRowContext(ctx, query, args...)
+	i.observe(queryName(query), time.Since(start), row.Err())
+	return row
+}
+
+// queryName returns the name from the "-- name: X :kind" comment that sqlc puts at the start of every query
+func queryName(query string) string {
+	const prefix = "-- name: "
+	if !strings.HasPrefix(query, prefix) {
+		return "unnamed"
+	}
+
+	fields := strings.Fields(query[len(prefix):])
+	if len(fields) == 0 {
+		return "unnamed"
+	}
+	return fields[0]
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueryName(t *testing.T) {
+	require.Equal(t, "GetProject", queryName(getProject))
+	require.Equal(t, "ListCvExperiencesWithJSON", queryName(listCvExperiencesWithJSON))
+	require.Equal(t, "unnamed", queryName(migrationVersion))
+	require.Equal(t, "unnamed", queryName("-- name: "))
+}
+
+func TestSQLStore_Instrumented(t *testing.T) {
+	var mu sync.Mutex
+	observed := make(map[string]int)
+	var errs []error
+	store := NewInstrumentedStore(testDB, func(name string, duration time.Duration, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		observed[name]++
+		if err != nil {
+			errs = append(errs, err)
+		}
+		require.Greater(t, duration, time.Duration(0))
+	})
+
+	cvProfile := createRandomCvProfile(t)
+	_, err := store.GetCvProfile(context.Background(), cvProfile.ID)
+	require.NoError(t, err)
+
+	// queries executed in transactions are observed as well
+	err = store.DeleteCvProfileTx(context.Background(), cvProfile.ID)
+	require.NoError(t, err)
+
+	_, err = store.GetCvProfile(context.Background(), cvProfile.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 2, observed["GetCvProfile"])
+	require.Equal(t, 1, observed["DeleteCvProfile"])
+	require.Empty(t, errs)
+}
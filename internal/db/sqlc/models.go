@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.20.0
+//   sqlc v1.30.0
 
 package db
 
@@ -10,12 +10,34 @@ import (
 )
 
 type CvEducation struct {
-	ID          int32     `json:"id"`
-	Institution string    `json:"institution"`
-	Degree      string    `json:"degree"`
-	StartDate   time.Time `json:"start_date"`
-	EndDate     time.Time `json:"end_date"`
-	CvProfileID int32     `json:"cv_profile_id"`
+	ID          int32      `json:"id"`
+	Institution string     `json:"institution"`
+	Degree      string     `json:"degree"`
+	StartDate   time.Time  `json:"start_date"`
+	EndDate     *time.Time `json:"end_date"`
+	CvProfileID int32      `json:"cv_profile_id"`
+}
+
+type CvExperience struct {
+	ID             int32        `json:"id"`
+	Company        string       `json:"company"`
+	Position       string       `json:"position"`
+	Location       string       `json:"location"`
+	EmploymentType string       `json:"employment_type"`
+	StartDate      time.Time    `json:"start_date"`
+	EndDate        sql.NullTime `json:"end_date"`
+	Achievements   []string     `json:"achievements"`
+	CvProfileID    int32        `json:"cv_profile_id"`
+}
+
+type CvExperienceSkill struct {
+	CvExperienceID int32 `json:"cv_experience_id"`
+	SkillID        int32 `json:"skill_id"`
+}
+
+type CvExperienceTechnology struct {
+	CvExperienceID int32 `json:"cv_experience_id"`
+	TechnologyID   int32 `json:"technology_id"`
 }
 
 type CvProfile struct {
@@ -62,6 +84,16 @@ type Skill struct {
 	HexThemeColor string `json:"hex_theme_color"`
 	CvProfileID   int32  `json:"cv_profile_id"`
 	Importance    int32  `json:"importance"`
+	Slug          string `json:"slug"`
+}
+
+type SkillCategory struct {
+	ID            int32  `json:"id"`
+	CvProfileID   int32  `json:"cv_profile_id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	HexThemeColor string `json:"hex_theme_color"`
+	DisplayOrder  int32  `json:"display_order"`
 }
 
 type Technology struct {
@@ -70,3 +102,10 @@ type Technology struct {
 	Url        string `json:"url"`
 	OrderField int32  `json:"order_field"`
 }
+
+type User struct {
+	ID             int32     `json:"id"`
+	Username       string    `json:"username"`
+	HashedPassword string    `json:"hashed_password"`
+	CreatedAt      time.Time `json:"created_at"`
+}
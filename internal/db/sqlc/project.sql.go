@@ -1,12 +1,16 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.20.0
+//   sqlc v1.30.0
 // source: project.sql
 
 package db
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/lib/pq"
 )
 
 const createProject = `-- name: CreateProject :one
@@ -16,8 +20,9 @@ INSERT INTO projects (title,
                       image,
                       hex_theme_color,
                       project_url,
+                      significance,
                       cv_profile_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING id, title, short_description, description, image, hex_theme_color, project_url, cv_profile_id, significance
 `
 
@@ -28,6 +33,7 @@ type CreateProjectParams struct {
 	Image            string `json:"image"`
 	HexThemeColor    string `json:"hex_theme_color"`
 	ProjectUrl       string `json:"project_url"`
+	Significance     int32  `json:"significance"`
 	CvProfileID      int32  `json:"cv_profile_id"`
 }
 
@@ -39,6 +45,7 @@ func (q *Queries) CreateProject(ctx context.Context, arg CreateProjectParams) (P
 		arg.Image,
 		arg.HexThemeColor,
 		arg.ProjectUrl,
+		arg.Significance,
 		arg.CvProfileID,
 	)
 	var i Project
@@ -56,6 +63,95 @@ func (q *Queries) CreateProject(ctx context.Context, arg CreateProjectParams) (P
 	return i, err
 }
 
+const deleteProject = `-- name: DeleteProject :one
+DELETE
+FROM projects
+WHERE id = $1
+RETURNING id, title, short_description, description, image, hex_theme_color, project_url, cv_profile_id, significance
+`
+
+func (q *Queries) DeleteProject(ctx context.Context, id int32) (Project, error) {
+	row := q.db.QueryRowContext(ctx, deleteProject, id)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.ShortDescription,
+		&i.Description,
+		&i.Image,
+		&i.HexThemeColor,
+		&i.ProjectUrl,
+		&i.CvProfileID,
+		&i.Significance,
+	)
+	return i, err
+}
+
+const deleteProjectsByCvProfile = `-- name: DeleteProjectsByCvProfile :exec
+DELETE
+FROM projects
+WHERE cv_profile_id = $1
+`
+
+func (q *Queries) DeleteProjectsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteProjectsByCvProfile, cvProfileID)
+	return err
+}
+
+const getProject = `-- name: GetProject :one
+SELECT id, title, short_description, description, image, hex_theme_color, project_url, cv_profile_id, significance
+FROM projects
+WHERE id = $1
+`
+
+func (q *Queries) GetProject(ctx context.Context, id int32) (Project, error) {
+	row := q.db.QueryRowContext(ctx, getProject, id)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.ShortDescription,
+		&i.Description,
+		&i.Image,
+		&i.HexThemeColor,
+		&i.ProjectUrl,
+		&i.CvProfileID,
+		&i.Significance,
+	)
+	return i, err
+}
+
+const getProjectByTitle = `-- name: GetProjectByTitle :one
+SELECT id, title, short_description, description, image, hex_theme_color, project_url, cv_profile_id, significance
+FROM projects
+WHERE cv_profile_id = $1
+  AND title = $2
+ORDER BY id
+LIMIT 1
+`
+
+type GetProjectByTitleParams struct {
+	CvProfileID int32  `json:"cv_profile_id"`
+	Title       string `json:"title"`
+}
+
+func (q *Queries) GetProjectByTitle(ctx context.Context, arg GetProjectByTitleParams) (Project, error) {
+	row := q.db.QueryRowContext(ctx, getProjectByTitle, arg.CvProfileID, arg.Title)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.ShortDescription,
+		&i.Description,
+		&i.Image,
+		&i.HexThemeColor,
+		&i.ProjectUrl,
+		&i.CvProfileID,
+		&i.Significance,
+	)
+	return i, err
+}
+
 const listProjects = `-- name: ListProjects :many
 SELECT id,
        title,
@@ -120,6 +216,46 @@ func (q *Queries) ListProjects(ctx context.Context, arg ListProjectsParams) ([]L
 	return items, nil
 }
 
+const listProjectsByCvProfile = `-- name: ListProjectsByCvProfile :many
+SELECT id, title, short_description, description, image, hex_theme_color, project_url, cv_profile_id, significance
+FROM projects
+WHERE cv_profile_id = $1
+ORDER BY significance, id
+`
+
+func (q *Queries) ListProjectsByCvProfile(ctx context.Context, cvProfileID int32) ([]Project, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectsByCvProfile, cvProfileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Project{}
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.ShortDescription,
+			&i.Description,
+			&i.Image,
+			&i.HexThemeColor,
+			&i.ProjectUrl,
+			&i.CvProfileID,
+			&i.Significance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProjectsBySkillName = `-- name: ListProjectsBySkillName :many
 SELECT p.id,
        p.title,
@@ -132,7 +268,7 @@ SELECT p.id,
 FROM projects p
          JOIN project_skills ps ON p.id = ps.project_id
          JOIN skills s ON ps.skill_id = s.id
-WHERE s.name = $4::text
+WHERE s.slug = $4
   AND p.cv_profile_id = $1
 ORDER BY significance
 LIMIT $2 OFFSET $3
@@ -142,7 +278,7 @@ type ListProjectsBySkillNameParams struct {
 	CvProfileID int32  `json:"cv_profile_id"`
 	Limit       int32  `json:"limit"`
 	Offset      int32  `json:"offset"`
-	SkillName   string `json:"skill_name"`
+	SkillSlug   string `json:"skill_slug"`
 }
 
 type ListProjectsBySkillNameRow struct {
@@ -161,7 +297,7 @@ func (q *Queries) ListProjectsBySkillName(ctx context.Context, arg ListProjectsB
 		arg.CvProfileID,
 		arg.Limit,
 		arg.Offset,
-		arg.SkillName,
+		arg.SkillSlug,
 	)
 	if err != nil {
 		return nil, err
@@ -192,3 +328,271 @@ func (q *Queries) ListProjectsBySkillName(ctx context.Context, arg ListProjectsB
 	}
 	return items, nil
 }
+
+const listProjectsWithTechnologyJSON = `-- name: ListProjectsWithTechnologyJSON :many
+SELECT p.id,
+       p.title,
+       p.short_description,
+       p.description,
+       p.image,
+       p.hex_theme_color,
+       p.project_url,
+       p.significance,
+       COALESCE((SELECT json_agg(json_build_object('id', t.id, 'name', t.name, 'url', t.url) ORDER BY t.order_field)
+                 FROM project_technologies pt
+                          JOIN technologies t ON pt.technology_id = t.id
+                 WHERE pt.project_id = p.id), '[]')::json AS technologies_used
+FROM projects p
+WHERE p.cv_profile_id = $1
+ORDER BY p.significance
+LIMIT $2 OFFSET $3
+`
+
+type ListProjectsWithTechnologyJSONParams struct {
+	CvProfileID int32 `json:"cv_profile_id"`
+	Limit       int32 `json:"limit"`
+	Offset      int32 `json:"offset"`
+}
+
+type ListProjectsWithTechnologyJSONRow struct {
+	ID               int32           `json:"id"`
+	Title            string          `json:"title"`
+	ShortDescription string          `json:"short_description"`
+	Description      string          `json:"description"`
+	Image            string          `json:"image"`
+	HexThemeColor    string          `json:"hex_theme_color"`
+	ProjectUrl       string          `json:"project_url"`
+	Significance     int32           `json:"significance"`
+	TechnologiesUsed json.RawMessage `json:"technologies_used"`
+}
+
+func (q *Queries) ListProjectsWithTechnologyJSON(ctx context.Context, arg ListProjectsWithTechnologyJSONParams) ([]ListProjectsWithTechnologyJSONRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectsWithTechnologyJSON, arg.CvProfileID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListProjectsWithTechnologyJSONRow{}
+	for rows.Next() {
+		var i ListProjectsWithTechnologyJSONRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.ShortDescription,
+			&i.Description,
+			&i.Image,
+			&i.HexThemeColor,
+			&i.ProjectUrl,
+			&i.Significance,
+			&i.TechnologiesUsed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProjectsWithTechnologyJSONBySkillName = `-- name: ListProjectsWithTechnologyJSONBySkillName :many
+SELECT p.id,
+       p.title,
+       p.short_description,
+       p.description,
+       p.image,
+       p.hex_theme_color,
+       p.project_url,
+       p.significance,
+       COALESCE((SELECT json_agg(json_build_object('id', t.id, 'name', t.name, 'url', t.url) ORDER BY t.order_field)
+                 FROM project_technologies pt
+                          JOIN technologies t ON pt.technology_id = t.id
+                 WHERE pt.project_id = p.id), '[]')::json AS technologies_used
+FROM projects p
+         JOIN project_skills ps ON p.id = ps.project_id
+         JOIN skills s ON ps.skill_id = s.id
+WHERE s.slug = $4
+  AND p.cv_profile_id = $1
+ORDER BY p.significance
+LIMIT $2 OFFSET $3
+`
+
+type ListProjectsWithTechnologyJSONBySkillNameParams struct {
+	CvProfileID int32  `json:"cv_profile_id"`
+	Limit       int32  `json:"limit"`
+	Offset      int32  `json:"offset"`
+	SkillSlug   string `json:"skill_slug"`
+}
+
+type ListProjectsWithTechnologyJSONBySkillNameRow struct {
+	ID               int32           `json:"id"`
+	Title            string          `json:"title"`
+	ShortDescription string          `json:"short_description"`
+	Description      string          `json:"description"`
+	Image            string          `json:"image"`
+	HexThemeColor    string          `json:"hex_theme_color"`
+	ProjectUrl       string          `json:"project_url"`
+	Significance     int32           `json:"significance"`
+	TechnologiesUsed json.RawMessage `json:"technologies_used"`
+}
+
+func (q *Queries) ListProjectsWithTechnologyJSONBySkillName(ctx context.Context, arg ListProjectsWithTechnologyJSONBySkillNameParams) ([]ListProjectsWithTechnologyJSONBySkillNameRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectsWithTechnologyJSONBySkillName,
+		arg.CvProfileID,
+		arg.Limit,
+		arg.Offset,
+		arg.SkillSlug,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListProjectsWithTechnologyJSONBySkillNameRow{}
+	for rows.Next() {
+		var i ListProjectsWithTechnologyJSONBySkillNameRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.ShortDescription,
+			&i.Description,
+			&i.Image,
+			&i.HexThemeColor,
+			&i.ProjectUrl,
+			&i.Significance,
+			&i.TechnologiesUsed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const patchProject = `-- name: PatchProject :one
+UPDATE projects
+SET title             = COALESCE($1, title),
+    short_description = COALESCE($2, short_description),
+    description       = COALESCE($3, description),
+    image             = COALESCE($4, image),
+    hex_theme_color   = COALESCE($5, hex_theme_color),
+    project_url       = COALESCE($6, project_url),
+    significance      = COALESCE($7, significance)
+WHERE id = $8
+RETURNING id, title, short_description, description, image, hex_theme_color, project_url, cv_profile_id, significance
+`
+
+type PatchProjectParams struct {
+	Title            sql.NullString `json:"title"`
+	ShortDescription sql.NullString `json:"short_description"`
+	Description      sql.NullString `json:"description"`
+	Image            sql.NullString `json:"image"`
+	HexThemeColor    sql.NullString `json:"hex_theme_color"`
+	ProjectUrl       sql.NullString `json:"project_url"`
+	Significance     sql.NullInt32  `json:"significance"`
+	ID               int32          `json:"id"`
+}
+
+func (q *Queries) PatchProject(ctx context.Context, arg PatchProjectParams) (Project, error) {
+	row := q.db.QueryRowContext(ctx, patchProject,
+		arg.Title,
+		arg.ShortDescription,
+		arg.Description,
+		arg.Image,
+		arg.HexThemeColor,
+		arg.ProjectUrl,
+		arg.Significance,
+		arg.ID,
+	)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.ShortDescription,
+		&i.Description,
+		&i.Image,
+		&i.HexThemeColor,
+		&i.ProjectUrl,
+		&i.CvProfileID,
+		&i.Significance,
+	)
+	return i, err
+}
+
+const reorderProjects = `-- name: ReorderProjects :exec
+UPDATE projects
+SET significance = o.position
+FROM unnest($2::int[]) WITH ORDINALITY AS o(id, position)
+WHERE projects.id = o.id
+  AND projects.cv_profile_id = $1
+`
+
+type ReorderProjectsParams struct {
+	CvProfileID int32   `json:"cv_profile_id"`
+	ProjectIds  []int32 `json:"project_ids"`
+}
+
+// every project gets its position in project_ids as the significance
+func (q *Queries) ReorderProjects(ctx context.Context, arg ReorderProjectsParams) error {
+	_, err := q.db.ExecContext(ctx, reorderProjects, arg.CvProfileID, pq.Array(arg.ProjectIds))
+	return err
+}
+
+const updateProject = `-- name: UpdateProject :one
+UPDATE projects
+SET title             = $2,
+    short_description = $3,
+    description       = $4,
+    image             = $5,
+    hex_theme_color   = $6,
+    project_url       = $7,
+    significance      = $8
+WHERE id = $1
+RETURNING id, title, short_description, description, image, hex_theme_color, project_url, cv_profile_id, significance
+`
+
+type UpdateProjectParams struct {
+	ID               int32  `json:"id"`
+	Title            string `json:"title"`
+	ShortDescription string `json:"short_description"`
+	Description      string `json:"description"`
+	Image            string `json:"image"`
+	HexThemeColor    string `json:"hex_theme_color"`
+	ProjectUrl       string `json:"project_url"`
+	Significance     int32  `json:"significance"`
+}
+
+func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) (Project, error) {
+	row := q.db.QueryRowContext(ctx, updateProject,
+		arg.ID,
+		arg.Title,
+		arg.ShortDescription,
+		arg.Description,
+		arg.Image,
+		arg.HexThemeColor,
+		arg.ProjectUrl,
+		arg.Significance,
+	)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.ShortDescription,
+		&i.Description,
+		&i.Image,
+		&i.HexThemeColor,
+		&i.ProjectUrl,
+		&i.CvProfileID,
+		&i.Significance,
+	)
+	return i, err
+}
@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.20.0
+//   sqlc v1.30.0
 // source: project_skill.sql
 
 package db
@@ -28,3 +28,56 @@ func (q *Queries) CreateProjectSkill(ctx context.Context, arg CreateProjectSkill
 	err := row.Scan(&i.ProjectID, &i.SkillID)
 	return i, err
 }
+
+const deleteProjectSkills = `-- name: DeleteProjectSkills :exec
+DELETE
+FROM project_skills
+WHERE project_id = $1
+`
+
+func (q *Queries) DeleteProjectSkills(ctx context.Context, projectID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteProjectSkills, projectID)
+	return err
+}
+
+const deleteProjectSkillsByCvProfile = `-- name: DeleteProjectSkillsByCvProfile :exec
+DELETE
+FROM project_skills
+WHERE project_id IN (SELECT id FROM projects WHERE projects.cv_profile_id = $1)
+   OR skill_id IN (SELECT id FROM skills WHERE skills.cv_profile_id = $1)
+`
+
+func (q *Queries) DeleteProjectSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteProjectSkillsByCvProfile, cvProfileID)
+	return err
+}
+
+const listProjectSkills = `-- name: ListProjectSkills :many
+SELECT project_id, skill_id
+FROM project_skills
+WHERE project_id = $1
+ORDER BY skill_id
+`
+
+func (q *Queries) ListProjectSkills(ctx context.Context, projectID int32) ([]ProjectSkill, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectSkills, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProjectSkill{}
+	for rows.Next() {
+		var i ProjectSkill
+		if err := rows.Scan(&i.ProjectID, &i.SkillID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -28,3 +28,34 @@ func TestQueries_CreateProjectSkill(t *testing.T) {
 
 	createTestProjectSkill(t, project.ID, skill.ID)
 }
+
+func TestQueries_DeleteProjectSkills(t *testing.T) {
+	project := createRandomProject(t, 0)
+	for i := 0; i < 3; i++ {
+		skill := createRandomSkill(t, project.CvProfileID)
+		createTestProjectSkill(t, project.ID, skill.ID)
+	}
+
+	err := testQueries.DeleteProjectSkills(context.Background(), project.ID)
+	require.NoError(t, err)
+
+	projectSkills, err := testQueries.ListProjectSkills(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Empty(t, projectSkills)
+}
+
+func TestQueries_ListProjectSkills(t *testing.T) {
+	project := createRandomProject(t, 0)
+	for i := 0; i < 3; i++ {
+		skill := createRandomSkill(t, project.CvProfileID)
+		createTestProjectSkill(t, project.ID, skill.ID)
+	}
+
+	projectSkills, err := testQueries.ListProjectSkills(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Len(t, projectSkills, 3)
+
+	for _, projectSkill := range projectSkills {
+		require.Equal(t, project.ID, projectSkill.ProjectID)
+	}
+}
@@ -2,13 +2,14 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"github.com/aalug/cv-backend-go/pkg/utils"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
 
 // createRandomProject create and return a random project
-func createRandomProject(t *testing.T, cvProfileID int32) Project {
+func createRandomProject(t testing.TB, cvProfileID int32) Project {
 	if cvProfileID == 0 {
 		cvProfileID = createRandomCvProfile(t).ID
 	}
@@ -18,8 +19,9 @@ func createRandomProject(t *testing.T, cvProfileID int32) Project {
 		ShortDescription: utils.RandomString(5),
 		Description:      utils.RandomString(10),
 		Image:            utils.RandomString(5),
-		HexThemeColor:    utils.RandomString(5),
+		HexThemeColor:    utils.RandomHexColor(),
 		ProjectUrl:       utils.RandomString(5),
+		Significance:     utils.RandomInt(0, 100),
 		CvProfileID:      cvProfileID,
 	}
 
@@ -32,6 +34,7 @@ func createRandomProject(t *testing.T, cvProfileID int32) Project {
 	require.Equal(t, params.Image, project.Image)
 	require.Equal(t, params.HexThemeColor, project.HexThemeColor)
 	require.Equal(t, params.ProjectUrl, project.ProjectUrl)
+	require.Equal(t, params.Significance, project.Significance)
 	require.Equal(t, params.CvProfileID, project.CvProfileID)
 	require.NotZero(t, project.ID)
 
@@ -42,6 +45,13 @@ func TestQueries_CreateProject(t *testing.T) {
 	createRandomProject(t, 0)
 }
 
+func TestQueries_GetProject(t *testing.T) {
+	project := createRandomProject(t, 0)
+	project2, err := testQueries.GetProject(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Equal(t, project, project2)
+}
+
 func TestQueries_ListProjects(t *testing.T) {
 	cvProfile := createRandomCvProfile(t)
 	for i := 0; i < 5; i++ {
@@ -75,7 +85,7 @@ func TestQueries_ListProjectsBySkillName(t *testing.T) {
 		CvProfileID: cvProfile.ID,
 		Limit:       5,
 		Offset:      0,
-		SkillName:   skill.Name,
+		SkillSlug:   skill.Slug,
 	}
 
 	projects, err := testQueries.ListProjectsBySkillName(context.Background(), params)
@@ -86,3 +96,41 @@ func TestQueries_ListProjectsBySkillName(t *testing.T) {
 		require.NotEmpty(t, project)
 	}
 }
+
+func TestQueries_UpdateProject(t *testing.T) {
+	project := createRandomProject(t, 0)
+
+	params := UpdateProjectParams{
+		ID:               project.ID,
+		Title:            utils.RandomString(6),
+		ShortDescription: utils.RandomString(6),
+		Description:      utils.RandomString(12),
+		Image:            utils.RandomString(6),
+		HexThemeColor:    utils.RandomHexColor(),
+		ProjectUrl:       utils.RandomString(6),
+		Significance:     utils.RandomInt(0, 100),
+	}
+
+	updatedProject, err := testQueries.UpdateProject(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, project.ID, updatedProject.ID)
+	require.Equal(t, params.Title, updatedProject.Title)
+	require.Equal(t, params.ShortDescription, updatedProject.ShortDescription)
+	require.Equal(t, params.Description, updatedProject.Description)
+	require.Equal(t, params.Image, updatedProject.Image)
+	require.Equal(t, params.HexThemeColor, updatedProject.HexThemeColor)
+	require.Equal(t, params.ProjectUrl, updatedProject.ProjectUrl)
+	require.Equal(t, params.Significance, updatedProject.Significance)
+	require.Equal(t, project.CvProfileID, updatedProject.CvProfileID)
+}
+
+func TestQueries_DeleteProject(t *testing.T) {
+	project := createRandomProject(t, 0)
+
+	deletedProject, err := testQueries.DeleteProject(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Equal(t, project, deletedProject)
+
+	_, err = testQueries.GetProject(context.Background(), project.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
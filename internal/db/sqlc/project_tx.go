@@ -0,0 +1,262 @@
+package db
+
+import (
+	"context"
+)
+
+type CreateProjectTxParams struct {
+	CreateProjectParams
+	SkillIDs      []int32 `json:"skill_ids"`
+	TechnologyIDs []int32 `json:"technology_ids"`
+}
+
+// CreateProjectTx creates a project together with its skill and technology links in one transaction
+func (store *SQLStore) CreateProjectTx(ctx context.Context, arg CreateProjectTxParams) (ListProjectsWithTechnologiesRow, error) {
+	var result ListProjectsWithTechnologiesRow
+
+	err := store.execTx(ctx, func(q Querier) error {
+		project, err := q.CreateProject(ctx, arg.CreateProjectParams)
+		if err != nil {
+			return err
+		}
+
+		err = linkProjectSkills(ctx, q, project.ID, arg.SkillIDs)
+		if err != nil {
+			return err
+		}
+
+		err = linkProjectTechnologies(ctx, q, project.ID, arg.TechnologyIDs)
+		if err != nil {
+			return err
+		}
+
+		result, err = projectWithTechnologies(ctx, q, project)
+		return err
+	})
+
+	return result, err
+}
+
+type UpdateProjectTxParams struct {
+	UpdateProjectParams
+	// SkillIDs replaces the skills of the project, nil leaves them unchanged
+	SkillIDs []int32 `json:"skill_ids"`
+	// TechnologyIDs replaces the technologies of the project, nil leaves them unchanged
+	TechnologyIDs []int32 `json:"technology_ids"`
+}
+
+// UpdateProjectTx updates a project and replaces its skill and technology links in one transaction
+func (store *SQLStore) UpdateProjectTx(ctx context.Context, arg UpdateProjectTxParams) (ListProjectsWithTechnologiesRow, error) {
+	var result ListProjectsWithTechnologiesRow
+
+	err := store.execTx(ctx, func(q Querier) error {
+		project, err := q.UpdateProject(ctx, arg.UpdateProjectParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.SkillIDs != nil {
+			err = replaceProjectSkills(ctx, q, project.ID, arg.SkillIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		if arg.TechnologyIDs != nil {
+			err = replaceProjectTechnologies(ctx, q, project.ID, arg.TechnologyIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err = projectWithTechnologies(ctx, q, project)
+		return err
+	})
+
+	return result, err
+}
+
+type PatchProjectTxParams struct {
+	PatchProjectParams
+	// SkillIDs replaces the skills of the project, nil leaves them unchanged
+	SkillIDs []int32 `json:"skill_ids"`
+	// TechnologyIDs replaces the technologies of the project, nil leaves them unchanged
+	TechnologyIDs []int32 `json:"technology_ids"`
+}
+
+// PatchProjectTx updates only the provided fields of a project and replaces its skill and technology links
+// in one transaction. The fields are merged by the UPDATE itself, so concurrent patches do not overwrite
+// each other's fields with stale values.
+func (store *SQLStore) PatchProjectTx(ctx context.Context, arg PatchProjectTxParams) (ListProjectsWithTechnologiesRow, error) {
+	var result ListProjectsWithTechnologiesRow
+
+	err := store.execTx(ctx, func(q Querier) error {
+		project, err := q.PatchProject(ctx, arg.PatchProjectParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.SkillIDs != nil {
+			err = replaceProjectSkills(ctx, q, project.ID, arg.SkillIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		if arg.TechnologyIDs != nil {
+			err = replaceProjectTechnologies(ctx, q, project.ID, arg.TechnologyIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err = projectWithTechnologies(ctx, q, project)
+		return err
+	})
+
+	return result, err
+}
+
+// DeleteProjectTx deletes a project together with its skill and technology links in one transaction
+func (store *SQLStore) DeleteProjectTx(ctx context.Context, projectID int32) error {
+	return store.execTx(ctx, func(q Querier) error {
+		err := q.DeleteProjectSkills(ctx, projectID)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteProjectTechnologies(ctx, projectID)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.DeleteProject(ctx, projectID)
+		return err
+	})
+}
+
+type ReplaceProjectSkillsTxParams struct {
+	ProjectID int32   `json:"project_id"`
+	SkillIDs  []int32 `json:"skill_ids"`
+}
+
+// ReplaceProjectSkillsTx replaces all skills of a project in one transaction
+func (store *SQLStore) ReplaceProjectSkillsTx(ctx context.Context, arg ReplaceProjectSkillsTxParams) ([]ProjectSkill, error) {
+	var result []ProjectSkill
+
+	err := store.execTx(ctx, func(q Querier) error {
+		// make sure the project exists, even if there are no skills to link
+		_, err := q.GetProject(ctx, arg.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		err = replaceProjectSkills(ctx, q, arg.ProjectID, arg.SkillIDs)
+		if err != nil {
+			return err
+		}
+
+		result, err = q.ListProjectSkills(ctx, arg.ProjectID)
+		return err
+	})
+
+	return result, err
+}
+
+type ReplaceProjectTechnologiesTxParams struct {
+	ProjectID     int32   `json:"project_id"`
+	TechnologyIDs []int32 `json:"technology_ids"`
+}
+
+// ReplaceProjectTechnologiesTx replaces all technologies of a project in one transaction
+func (store *SQLStore) ReplaceProjectTechnologiesTx(ctx context.Context, arg ReplaceProjectTechnologiesTxParams) ([]ListTechnologiesForProjectRow, error) {
+	var result []ListTechnologiesForProjectRow
+
+	err := store.execTx(ctx, func(q Querier) error {
+		// make sure the project exists, even if there are no technologies to link
+		_, err := q.GetProject(ctx, arg.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		err = replaceProjectTechnologies(ctx, q, arg.ProjectID, arg.TechnologyIDs)
+		if err != nil {
+			return err
+		}
+
+		result, err = q.ListTechnologiesForProject(ctx, arg.ProjectID)
+		return err
+	})
+
+	return result, err
+}
+
+// replaceProjectSkills removes all skills of the project and links the ones from skillIDs
+func replaceProjectSkills(ctx context.Context, q Querier, projectID int32, skillIDs []int32) error {
+	err := q.DeleteProjectSkills(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	return linkProjectSkills(ctx, q, projectID, skillIDs)
+}
+
+// replaceProjectTechnologies removes all technologies of the project and links the ones from technologyIDs
+func replaceProjectTechnologies(ctx context.Context, q Querier, projectID int32, technologyIDs []int32) error {
+	err := q.DeleteProjectTechnologies(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	return linkProjectTechnologies(ctx, q, projectID, technologyIDs)
+}
+
+// linkProjectSkills connects the project with every skill from skillIDs
+func linkProjectSkills(ctx context.Context, q Querier, projectID int32, skillIDs []int32) error {
+	for _, skillID := range skillIDs {
+		_, err := q.CreateProjectSkill(ctx, CreateProjectSkillParams{
+			ProjectID: projectID,
+			SkillID:   skillID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// linkProjectTechnologies connects the project with every technology from technologyIDs
+func linkProjectTechnologies(ctx context.Context, q Querier, projectID int32, technologyIDs []int32) error {
+	for _, technologyID := range technologyIDs {
+		_, err := q.CreateProjectTechnology(ctx, CreateProjectTechnologyParams{
+			ProjectID:    projectID,
+			TechnologyID: technologyID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// projectWithTechnologies returns the project in the same shape as ListProjectsWithTechnologies
+func projectWithTechnologies(ctx context.Context, q Querier, project Project) (ListProjectsWithTechnologiesRow, error) {
+	technologies, err := q.ListTechnologiesForProject(ctx, project.ID)
+	if err != nil {
+		return ListProjectsWithTechnologiesRow{}, err
+	}
+
+	return ListProjectsWithTechnologiesRow{
+		ID:               project.ID,
+		Title:            project.Title,
+		ShortDescription: project.ShortDescription,
+		Description:      project.Description,
+		Image:            project.Image,
+		HexThemeColor:    project.HexThemeColor,
+		ProjectUrl:       project.ProjectUrl,
+		Significance:     project.Significance,
+		TechnologiesUsed: technologies,
+	}, nil
+}
@@ -0,0 +1,204 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSQLStore_CreateProjectTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	cvProfile := createRandomCvProfile(t)
+	skill := createRandomSkill(t, cvProfile.ID)
+	var technologyIDs []int32
+	for i := 0; i < 3; i++ {
+		technologyIDs = append(technologyIDs, createRandomTechnology(t).ID)
+	}
+
+	params := CreateProjectTxParams{
+		CreateProjectParams: CreateProjectParams{
+			Title:            utils.RandomString(5),
+			ShortDescription: utils.RandomString(5),
+			Description:      utils.RandomString(10),
+			Image:            utils.RandomString(5),
+			HexThemeColor:    utils.RandomHexColor(),
+			ProjectUrl:       utils.RandomString(5),
+			Significance:     utils.RandomInt(0, 100),
+			CvProfileID:      cvProfile.ID,
+		},
+		SkillIDs:      []int32{skill.ID},
+		TechnologyIDs: technologyIDs,
+	}
+
+	project, err := store.CreateProjectTx(context.Background(), params)
+	require.NoError(t, err)
+	require.NotZero(t, project.ID)
+	require.Equal(t, params.Title, project.Title)
+	require.Equal(t, params.Significance, project.Significance)
+	require.Len(t, project.TechnologiesUsed, 3)
+
+	projects, err := store.ListProjectsBySkillName(context.Background(), ListProjectsBySkillNameParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+		SkillSlug:   skill.Slug,
+	})
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	require.Equal(t, project.ID, projects[0].ID)
+}
+
+func TestSQLStore_CreateProjectTxRollback(t *testing.T) {
+	store := NewStore(testDB)
+
+	cvProfile := createRandomCvProfile(t)
+	params := CreateProjectTxParams{
+		CreateProjectParams: CreateProjectParams{
+			Title:            utils.RandomString(5),
+			ShortDescription: utils.RandomString(5),
+			Description:      utils.RandomString(10),
+			Image:            utils.RandomString(5),
+			HexThemeColor:    utils.RandomHexColor(),
+			ProjectUrl:       utils.RandomString(5),
+			CvProfileID:      cvProfile.ID,
+		},
+		// technology that does not exist
+		TechnologyIDs: []int32{-1},
+	}
+
+	_, err := store.CreateProjectTx(context.Background(), params)
+	require.Error(t, err)
+
+	projects, err := store.ListProjects(context.Background(), ListProjectsParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, projects)
+}
+
+func TestSQLStore_UpdateProjectTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	projectTechnology := createRandomProjectTechnology(t)
+	project, err := store.GetProject(context.Background(), projectTechnology.ProjectID)
+	require.NoError(t, err)
+	technology := createRandomTechnology(t)
+
+	params := UpdateProjectTxParams{
+		UpdateProjectParams: UpdateProjectParams{
+			ID:               project.ID,
+			Title:            utils.RandomString(6),
+			ShortDescription: project.ShortDescription,
+			Description:      project.Description,
+			Image:            project.Image,
+			HexThemeColor:    project.HexThemeColor,
+			ProjectUrl:       project.ProjectUrl,
+			Significance:     project.Significance,
+		},
+		TechnologyIDs: []int32{technology.ID},
+	}
+
+	updatedProject, err := store.UpdateProjectTx(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, params.Title, updatedProject.Title)
+	require.Len(t, updatedProject.TechnologiesUsed, 1)
+	require.Equal(t, technology.ID, updatedProject.TechnologiesUsed[0].ID)
+
+	// nil technology IDs leave the technologies unchanged
+	params.TechnologyIDs = nil
+	updatedProject, err = store.UpdateProjectTx(context.Background(), params)
+	require.NoError(t, err)
+	require.Len(t, updatedProject.TechnologiesUsed, 1)
+}
+
+func TestSQLStore_DeleteProjectTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	projectTechnology := createRandomProjectTechnology(t)
+	project, err := store.GetProject(context.Background(), projectTechnology.ProjectID)
+	require.NoError(t, err)
+	skill := createRandomSkill(t, project.CvProfileID)
+	createTestProjectSkill(t, project.ID, skill.ID)
+
+	err = store.DeleteProjectTx(context.Background(), project.ID)
+	require.NoError(t, err)
+
+	_, err = store.GetProject(context.Background(), project.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	err = store.DeleteProjectTx(context.Background(), project.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestSQLStore_ReplaceProjectSkillsTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	project := createRandomProject(t, 0)
+	oldSkill := createRandomSkill(t, project.CvProfileID)
+	createTestProjectSkill(t, project.ID, oldSkill.ID)
+
+	var skillIDs []int32
+	for i := 0; i < 3; i++ {
+		skillIDs = append(skillIDs, createRandomSkill(t, project.CvProfileID).ID)
+	}
+
+	projectSkills, err := store.ReplaceProjectSkillsTx(context.Background(), ReplaceProjectSkillsTxParams{
+		ProjectID: project.ID,
+		SkillIDs:  skillIDs,
+	})
+	require.NoError(t, err)
+	require.Len(t, projectSkills, 3)
+	for i, projectSkill := range projectSkills {
+		require.Equal(t, project.ID, projectSkill.ProjectID)
+		require.Equal(t, skillIDs[i], projectSkill.SkillID)
+	}
+
+	// a skill that does not exist rolls back the whole replacement
+	_, err = store.ReplaceProjectSkillsTx(context.Background(), ReplaceProjectSkillsTxParams{
+		ProjectID: project.ID,
+		SkillIDs:  []int32{oldSkill.ID, -1},
+	})
+	require.Error(t, err)
+
+	projectSkills, err = store.ListProjectSkills(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Len(t, projectSkills, 3)
+
+	_, err = store.ReplaceProjectSkillsTx(context.Background(), ReplaceProjectSkillsTxParams{
+		ProjectID: -1,
+		SkillIDs:  []int32{},
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestSQLStore_ReplaceProjectTechnologiesTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	projectTechnology := createRandomProjectTechnology(t)
+	technology := createRandomTechnology(t)
+
+	technologies, err := store.ReplaceProjectTechnologiesTx(context.Background(), ReplaceProjectTechnologiesTxParams{
+		ProjectID:     projectTechnology.ProjectID,
+		TechnologyIDs: []int32{technology.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, technologies, 1)
+	require.Equal(t, technology.ID, technologies[0].ID)
+
+	// a technology that does not exist rolls back the whole replacement
+	_, err = store.ReplaceProjectTechnologiesTx(context.Background(), ReplaceProjectTechnologiesTxParams{
+		ProjectID:     projectTechnology.ProjectID,
+		TechnologyIDs: []int32{-1},
+	})
+	require.Error(t, err)
+
+	technologies, err = store.ListTechnologiesForProject(context.Background(), projectTechnology.ProjectID)
+	require.NoError(t, err)
+	require.Len(t, technologies, 1)
+	require.Equal(t, technology.ID, technologies[0].ID)
+}
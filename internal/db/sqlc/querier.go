@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.20.0
+//   sqlc v1.30.0
 
 package db
 
@@ -10,20 +10,81 @@ import (
 
 type Querier interface {
 	CreateCvEducation(ctx context.Context, arg CreateCvEducationParams) (CvEducation, error)
+	CreateCvExperience(ctx context.Context, arg CreateCvExperienceParams) (CvExperience, error)
+	CreateCvExperienceSkill(ctx context.Context, arg CreateCvExperienceSkillParams) (CvExperienceSkill, error)
+	CreateCvExperienceTechnology(ctx context.Context, arg CreateCvExperienceTechnologyParams) (CvExperienceTechnology, error)
 	CreateCvProfile(ctx context.Context, arg CreateCvProfileParams) (CvProfile, error)
 	CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error)
 	CreateProjectSkill(ctx context.Context, arg CreateProjectSkillParams) (ProjectSkill, error)
 	CreateProjectTechnology(ctx context.Context, arg CreateProjectTechnologyParams) (ProjectTechnology, error)
 	CreateSkill(ctx context.Context, arg CreateSkillParams) (Skill, error)
 	CreateTechnology(ctx context.Context, arg CreateTechnologyParams) (Technology, error)
+	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CvProfileExists(ctx context.Context, id int32) (bool, error)
+	DeleteCvEducationsByCvProfile(ctx context.Context, cvProfileID int32) error
+	DeleteCvExperienceSkills(ctx context.Context, cvExperienceID int32) error
+	DeleteCvExperienceSkillsByCvProfile(ctx context.Context, cvProfileID int32) error
+	DeleteCvExperienceTechnologies(ctx context.Context, cvExperienceID int32) error
+	DeleteCvExperienceTechnologiesByCvProfile(ctx context.Context, cvProfileID int32) error
+	DeleteCvExperiencesByCvProfile(ctx context.Context, cvProfileID int32) error
+	DeleteCvProfile(ctx context.Context, id int32) (CvProfile, error)
+	DeleteProject(ctx context.Context, id int32) (Project, error)
+	DeleteProjectSkills(ctx context.Context, projectID int32) error
+	DeleteProjectSkillsByCvProfile(ctx context.Context, cvProfileID int32) error
+	DeleteProjectTechnologies(ctx context.Context, projectID int32) error
+	DeleteProjectTechnologiesByCvProfile(ctx context.Context, cvProfileID int32) error
+	DeleteProjectsByCvProfile(ctx context.Context, cvProfileID int32) error
+	DeleteSkillsByCvProfile(ctx context.Context, cvProfileID int32) error
 	GetCvEducation(ctx context.Context, id int32) (CvEducation, error)
+	GetCvEducationByInstitution(ctx context.Context, arg GetCvEducationByInstitutionParams) (CvEducation, error)
+	GetCvExperience(ctx context.Context, id int32) (CvExperience, error)
+	GetCvExperienceByCompany(ctx context.Context, arg GetCvExperienceByCompanyParams) (CvExperience, error)
 	GetCvProfile(ctx context.Context, id int32) (CvProfile, error)
+	GetCvProfileByEmail(ctx context.Context, email string) (CvProfile, error)
+	GetProject(ctx context.Context, id int32) (Project, error)
+	GetProjectByTitle(ctx context.Context, arg GetProjectByTitleParams) (Project, error)
 	GetSkill(ctx context.Context, id int32) (Skill, error)
+	GetSkillByName(ctx context.Context, arg GetSkillByNameParams) (Skill, error)
+	// the callers slug a name with utils.Slugify, so both a slug and a name find the skill
+	GetSkillBySlug(ctx context.Context, arg GetSkillBySlugParams) (Skill, error)
+	GetTechnologyByName(ctx context.Context, name string) (Technology, error)
+	GetUser(ctx context.Context, username string) (User, error)
 	ListCvEducations(ctx context.Context, arg ListCvEducationsParams) ([]CvEducation, error)
+	ListCvExperiences(ctx context.Context, arg ListCvExperiencesParams) ([]CvExperience, error)
+	ListCvExperiencesWithJSON(ctx context.Context, arg ListCvExperiencesWithJSONParams) ([]ListCvExperiencesWithJSONRow, error)
+	ListProjectSkills(ctx context.Context, projectID int32) ([]ProjectSkill, error)
 	ListProjects(ctx context.Context, arg ListProjectsParams) ([]ListProjectsRow, error)
+	ListProjectsByCvProfile(ctx context.Context, cvProfileID int32) ([]Project, error)
 	ListProjectsBySkillName(ctx context.Context, arg ListProjectsBySkillNameParams) ([]ListProjectsBySkillNameRow, error)
+	ListProjectsWithTechnologyJSON(ctx context.Context, arg ListProjectsWithTechnologyJSONParams) ([]ListProjectsWithTechnologyJSONRow, error)
+	ListProjectsWithTechnologyJSONBySkillName(ctx context.Context, arg ListProjectsWithTechnologyJSONBySkillNameParams) ([]ListProjectsWithTechnologyJSONBySkillNameRow, error)
 	ListSkills(ctx context.Context, arg ListSkillsParams) ([]Skill, error)
+	ListSkillsByCategory(ctx context.Context, arg ListSkillsByCategoryParams) ([]Skill, error)
+	ListSkillsForCvExperience(ctx context.Context, cvExperienceID int32) ([]ListSkillsForCvExperienceRow, error)
+	// the skills of a cv profile in the display order of their categories, and by importance within a category.
+	// The categories without a row in skill_categories come last, ordered by name.
+	ListSkillsWithCategories(ctx context.Context, cvProfileID int32) ([]ListSkillsWithCategoriesRow, error)
+	ListTechnologiesForCvExperience(ctx context.Context, cvExperienceID int32) ([]ListTechnologiesForCvExperienceRow, error)
 	ListTechnologiesForProject(ctx context.Context, projectID int32) ([]ListTechnologiesForProjectRow, error)
+	PatchProject(ctx context.Context, arg PatchProjectParams) (Project, error)
+	// the skill gets its negated ID as the importance, which no other skill can hold, so that skills updated one by one
+	// can swap their importances
+	ReleaseSkillImportance(ctx context.Context, id int32) error
+	// every project gets its position in project_ids as the significance
+	ReorderProjects(ctx context.Context, arg ReorderProjectsParams) error
+	// every skill gets its position in skill_ids as the importance, the deferrable unique constraint
+	// is checked after the whole statement, so the skills can swap their importances
+	ReorderSkills(ctx context.Context, arg ReorderSkillsParams) error
+	// the text is escaped before ts_headline, so the <mark> tags are the only markup of the snippets
+	SearchCvProfile(ctx context.Context, arg SearchCvProfileParams) ([]SearchCvProfileRow, error)
+	UpdateCvEducation(ctx context.Context, arg UpdateCvEducationParams) (CvEducation, error)
+	UpdateCvExperience(ctx context.Context, arg UpdateCvExperienceParams) (CvExperience, error)
+	UpdateCvProfile(ctx context.Context, arg UpdateCvProfileParams) (CvProfile, error)
+	UpdateProject(ctx context.Context, arg UpdateProjectParams) (Project, error)
+	UpdateSkill(ctx context.Context, arg UpdateSkillParams) (Skill, error)
+	UpdateTechnology(ctx context.Context, arg UpdateTechnologyParams) (Technology, error)
+	// the category is created, or its description, theme color and display order are replaced
+	UpsertSkillCategory(ctx context.Context, arg UpsertSkillCategoryParams) (SkillCategory, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,151 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// ErrOrderMismatch is returned when a new order does not list every skill of the category,
+// or every project of the cv profile, exactly once
+var ErrOrderMismatch = errors.New("the order has to list every item exactly once")
+
+type ReorderSkillsTxParams struct {
+	CvProfileID int32  `json:"cv_profile_id"`
+	Category    string `json:"category"`
+	// SkillIDs lists all skills of the category, the first one gets importance 1
+	SkillIDs []int32 `json:"skill_ids"`
+}
+
+// ReorderSkillsTx gives the skills of a category the importances of their positions in one transaction,
+// it returns sql.ErrNoRows when the cv profile does not exist
+func (store *SQLStore) ReorderSkillsTx(ctx context.Context, arg ReorderSkillsTxParams) ([]Skill, error) {
+	var result []Skill
+
+	err := store.execTx(ctx, func(q Querier) error {
+		err := checkCvProfileExists(ctx, q, arg.CvProfileID)
+		if err != nil {
+			return err
+		}
+
+		params := ListSkillsByCategoryParams{
+			CvProfileID: arg.CvProfileID,
+			Category:    arg.Category,
+		}
+		skills, err := q.ListSkillsByCategory(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		current := make([]int32, 0, len(skills))
+		for _, skill := range skills {
+			current = append(current, skill.ID)
+		}
+		if !isPermutation(arg.SkillIDs, current) {
+			return ErrOrderMismatch
+		}
+
+		err = q.ReorderSkills(ctx, ReorderSkillsParams{
+			SkillIds:    arg.SkillIDs,
+			CvProfileID: arg.CvProfileID,
+			Category:    arg.Category,
+		})
+		if err != nil {
+			return err
+		}
+
+		result, err = q.ListSkillsByCategory(ctx, params)
+		return err
+	})
+
+	return result, err
+}
+
+type ReorderProjectsTxParams struct {
+	CvProfileID int32 `json:"cv_profile_id"`
+	// ProjectIDs lists all projects of the cv profile, the first one gets significance 1
+	ProjectIDs []int32 `json:"project_ids"`
+}
+
+// ReorderProjectsTx gives the projects of a cv profile the significances of their positions in one transaction,
+// it returns sql.ErrNoRows when the cv profile does not exist
+func (store *SQLStore) ReorderProjectsTx(ctx context.Context, arg ReorderProjectsTxParams) ([]ListProjectsWithTechnologiesRow, error) {
+	var result []ListProjectsWithTechnologiesRow
+
+	err := store.execTx(ctx, func(q Querier) error {
+		err := checkCvProfileExists(ctx, q, arg.CvProfileID)
+		if err != nil {
+			return err
+		}
+
+		projects, err := q.ListProjectsByCvProfile(ctx, arg.CvProfileID)
+		if err != nil {
+			return err
+		}
+
+		current := make([]int32, 0, len(projects))
+		for _, project := range projects {
+			current = append(current, project.ID)
+		}
+		if !isPermutation(arg.ProjectIDs, current) {
+			return ErrOrderMismatch
+		}
+
+		err = q.ReorderProjects(ctx, ReorderProjectsParams{
+			ProjectIds:  arg.ProjectIDs,
+			CvProfileID: arg.CvProfileID,
+		})
+		if err != nil {
+			return err
+		}
+
+		projects, err = q.ListProjectsByCvProfile(ctx, arg.CvProfileID)
+		if err != nil {
+			return err
+		}
+
+		result = make([]ListProjectsWithTechnologiesRow, 0, len(projects))
+		for _, project := range projects {
+			row, err := projectWithTechnologies(ctx, q, project)
+			if err != nil {
+				return err
+			}
+			result = append(result, row)
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// checkCvProfileExists returns sql.ErrNoRows when there is no cv profile with the ID
+func checkCvProfileExists(ctx context.Context, q Querier, cvProfileID int32) error {
+	exists, err := q.CvProfileExists(ctx, cvProfileID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// isPermutation reports whether ids lists every ID of current exactly once, in any order
+func isPermutation(ids, current []int32) bool {
+	if len(ids) != len(current) {
+		return false
+	}
+
+	remaining := make(map[int32]bool, len(current))
+	for _, id := range current {
+		remaining[id] = true
+	}
+	for _, id := range ids {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+
+	return true
+}
@@ -0,0 +1,17 @@
+package db
+
+import "context"
+
+// Ping verifies that the database is still reachable
+func (store *SQLStore) Ping(ctx context.Context) error {
+	return store.db.PingContext(ctx)
+}
+
+const migrationVersion = `SELECT version, dirty FROM schema_migrations LIMIT 1`
+
+// MigrationVersion returns the current version of the schema from the golang-migrate table
+// and whether the last migration failed and left the schema dirty
+func (store *SQLStore) MigrationVersion(ctx context.Context) (version int64, dirty bool, err error) {
+	err = store.db.QueryRowContext(ctx, migrationVersion).Scan(&version, &dirty)
+	return
+}
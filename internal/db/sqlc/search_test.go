@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestQueries_SearchCvProfile(t *testing.T) {
+	// the random strings are lowercase letters, so they are kept as single lexemes
+	keyword := utils.RandomString(12)
+
+	cvProfile, err := testQueries.CreateCvProfile(context.Background(), CreateCvProfileParams{
+		Name:           utils.RandomString(5),
+		Email:          utils.RandomEmail(),
+		Phone:          utils.RandomString(9),
+		Address:        utils.RandomString(5),
+		LinkedinUrl:    sql.NullString{},
+		GithubUrl:      utils.RandomString(5),
+		Bio:            "Backend developer who enjoys " + keyword + " a lot",
+		ProfilePicture: utils.RandomString(6),
+	})
+	require.NoError(t, err)
+
+	project, err := testQueries.CreateProject(context.Background(), CreateProjectParams{
+		Title:            "Project " + keyword,
+		ShortDescription: "Built around " + keyword,
+		Description:      utils.RandomString(10),
+		Image:            utils.RandomString(5),
+		HexThemeColor:    utils.RandomHexColor(),
+		ProjectUrl:       utils.RandomString(5),
+		Significance:     utils.RandomInt(0, 100),
+		CvProfileID:      cvProfile.ID,
+	})
+	require.NoError(t, err)
+
+	name := utils.RandomString(7)
+	skill, err := testQueries.CreateSkill(context.Background(), CreateSkillParams{
+		Name:          name,
+		Slug:          utils.Slugify(name),
+		Description:   "Used " + keyword + " in production",
+		Category:      utils.RandomString(7),
+		Importance:    utils.RandomInt(1, 100),
+		Image:         utils.RandomString(5),
+		HexThemeColor: utils.RandomHexColor(),
+		CvProfileID:   cvProfile.ID,
+	})
+	require.NoError(t, err)
+
+	// records of other profiles must not be returned
+	otherProject := createRandomProject(t, 0)
+	_, err = testQueries.UpdateProject(context.Background(), UpdateProjectParams{
+		ID:               otherProject.ID,
+		Title:            keyword,
+		ShortDescription: otherProject.ShortDescription,
+		Description:      otherProject.Description,
+		Image:            otherProject.Image,
+		HexThemeColor:    otherProject.HexThemeColor,
+		ProjectUrl:       otherProject.ProjectUrl,
+		Significance:     otherProject.Significance,
+	})
+	require.NoError(t, err)
+
+	results, err := testQueries.SearchCvProfile(context.Background(), SearchCvProfileParams{
+		CvProfileID: cvProfile.ID,
+		Query:       keyword,
+		Limit:       10,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	ids := make(map[string]int32)
+	for i, result := range results {
+		ids[result.Kind] = result.ID
+		require.Contains(t, result.Snippet, "<mark>")
+		require.Greater(t, result.Rank, float32(0))
+		if i > 0 {
+			require.LessOrEqual(t, result.Rank, results[i-1].Rank)
+		}
+	}
+	require.Equal(t, project.ID, ids["project"])
+	require.Equal(t, skill.ID, ids["skill"])
+	require.Equal(t, cvProfile.ID, ids["profile"])
+
+	// the project title has the highest weight
+	require.Equal(t, "project", results[0].Kind)
+	require.True(t, strings.HasPrefix(results[0].Title, "Project"))
+
+	results, err = testQueries.SearchCvProfile(context.Background(), SearchCvProfileParams{
+		CvProfileID: cvProfile.ID,
+		Query:       keyword + " -" + keyword,
+		Limit:       10,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
@@ -1,18 +1,20 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.20.0
+//   sqlc v1.30.0
 // source: skill.sql
 
 package db
 
 import (
 	"context"
+
+	"github.com/lib/pq"
 )
 
 const createSkill = `-- name: CreateSkill :one
-INSERT INTO skills (name, description, category, importance, image, hex_theme_color, cv_profile_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, name, description, category, image, hex_theme_color, cv_profile_id, importance
+INSERT INTO skills (name, slug, description, category, importance, image, hex_theme_color, cv_profile_id)
+VALUES ($1, $8, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, description, category, image, hex_theme_color, cv_profile_id, importance, slug
 `
 
 type CreateSkillParams struct {
@@ -23,6 +25,7 @@ type CreateSkillParams struct {
 	Image         string `json:"image"`
 	HexThemeColor string `json:"hex_theme_color"`
 	CvProfileID   int32  `json:"cv_profile_id"`
+	Slug          string `json:"slug"`
 }
 
 func (q *Queries) CreateSkill(ctx context.Context, arg CreateSkillParams) (Skill, error) {
@@ -34,6 +37,7 @@ func (q *Queries) CreateSkill(ctx context.Context, arg CreateSkillParams) (Skill
 		arg.Image,
 		arg.HexThemeColor,
 		arg.CvProfileID,
+		arg.Slug,
 	)
 	var i Skill
 	err := row.Scan(
@@ -45,12 +49,24 @@ func (q *Queries) CreateSkill(ctx context.Context, arg CreateSkillParams) (Skill
 		&i.HexThemeColor,
 		&i.CvProfileID,
 		&i.Importance,
+		&i.Slug,
 	)
 	return i, err
 }
 
+const deleteSkillsByCvProfile = `-- name: DeleteSkillsByCvProfile :exec
+DELETE
+FROM skills
+WHERE cv_profile_id = $1
+`
+
+func (q *Queries) DeleteSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteSkillsByCvProfile, cvProfileID)
+	return err
+}
+
 const getSkill = `-- name: GetSkill :one
-SELECT id, name, description, category, image, hex_theme_color, cv_profile_id, importance
+SELECT id, name, description, category, image, hex_theme_color, cv_profile_id, importance, slug
 FROM skills
 WHERE id = $1
 `
@@ -67,16 +83,75 @@ func (q *Queries) GetSkill(ctx context.Context, id int32) (Skill, error) {
 		&i.HexThemeColor,
 		&i.CvProfileID,
 		&i.Importance,
+		&i.Slug,
+	)
+	return i, err
+}
+
+const getSkillByName = `-- name: GetSkillByName :one
+SELECT id, name, description, category, image, hex_theme_color, cv_profile_id, importance, slug
+FROM skills
+WHERE cv_profile_id = $1
+  AND name = $2
+`
+
+type GetSkillByNameParams struct {
+	CvProfileID int32  `json:"cv_profile_id"`
+	Name        string `json:"name"`
+}
+
+func (q *Queries) GetSkillByName(ctx context.Context, arg GetSkillByNameParams) (Skill, error) {
+	row := q.db.QueryRowContext(ctx, getSkillByName, arg.CvProfileID, arg.Name)
+	var i Skill
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Category,
+		&i.Image,
+		&i.HexThemeColor,
+		&i.CvProfileID,
+		&i.Importance,
+		&i.Slug,
+	)
+	return i, err
+}
+
+const getSkillBySlug = `-- name: GetSkillBySlug :one
+SELECT id, name, description, category, image, hex_theme_color, cv_profile_id, importance, slug
+FROM skills
+WHERE cv_profile_id = $1
+  AND slug = $2
+`
+
+type GetSkillBySlugParams struct {
+	CvProfileID int32  `json:"cv_profile_id"`
+	Slug        string `json:"slug"`
+}
+
+// the callers slug a name with utils.Slugify, so both a slug and a name find the skill
+func (q *Queries) GetSkillBySlug(ctx context.Context, arg GetSkillBySlugParams) (Skill, error) {
+	row := q.db.QueryRowContext(ctx, getSkillBySlug, arg.CvProfileID, arg.Slug)
+	var i Skill
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Category,
+		&i.Image,
+		&i.HexThemeColor,
+		&i.CvProfileID,
+		&i.Importance,
+		&i.Slug,
 	)
 	return i, err
 }
 
 const listSkills = `-- name: ListSkills :many
-SELECT id, name, description, category, image, hex_theme_color, cv_profile_id, importance
+SELECT id, name, description, category, image, hex_theme_color, cv_profile_id, importance, slug
 FROM skills
 WHERE cv_profile_id = $1
-GROUP BY category, id
-ORDER BY importance
+ORDER BY importance, category, id
 LIMIT $2 OFFSET $3
 `
 
@@ -104,6 +179,7 @@ func (q *Queries) ListSkills(ctx context.Context, arg ListSkillsParams) ([]Skill
 			&i.HexThemeColor,
 			&i.CvProfileID,
 			&i.Importance,
+			&i.Slug,
 		); err != nil {
 			return nil, err
 		}
@@ -117,3 +193,200 @@ func (q *Queries) ListSkills(ctx context.Context, arg ListSkillsParams) ([]Skill
 	}
 	return items, nil
 }
+
+const listSkillsByCategory = `-- name: ListSkillsByCategory :many
+SELECT id, name, description, category, image, hex_theme_color, cv_profile_id, importance, slug
+FROM skills
+WHERE cv_profile_id = $1
+  AND category = $2
+ORDER BY importance, id
+`
+
+type ListSkillsByCategoryParams struct {
+	CvProfileID int32  `json:"cv_profile_id"`
+	Category    string `json:"category"`
+}
+
+func (q *Queries) ListSkillsByCategory(ctx context.Context, arg ListSkillsByCategoryParams) ([]Skill, error) {
+	rows, err := q.db.QueryContext(ctx, listSkillsByCategory, arg.CvProfileID, arg.Category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Skill{}
+	for rows.Next() {
+		var i Skill
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Category,
+			&i.Image,
+			&i.HexThemeColor,
+			&i.CvProfileID,
+			&i.Importance,
+			&i.Slug,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSkillsWithCategories = `-- name: ListSkillsWithCategories :many
+SELECT skills.id, skills.name, skills.description, skills.category, skills.image, skills.hex_theme_color, skills.cv_profile_id, skills.importance, skills.slug,
+       COALESCE(skill_categories.description, '')::text        AS category_description,
+       COALESCE(skill_categories.hex_theme_color, '')::varchar AS category_hex_theme_color,
+       COALESCE(skill_categories.display_order, 0)::int        AS category_display_order
+FROM skills
+         LEFT JOIN skill_categories
+                   ON skill_categories.cv_profile_id = skills.cv_profile_id
+                       AND skill_categories.name = skills.category
+WHERE skills.cv_profile_id = $1
+ORDER BY skill_categories.id IS NULL, skill_categories.display_order, skills.category, skills.importance, skills.id
+`
+
+type ListSkillsWithCategoriesRow struct {
+	ID                    int32  `json:"id"`
+	Name                  string `json:"name"`
+	Description           string `json:"description"`
+	Category              string `json:"category"`
+	Image                 string `json:"image"`
+	HexThemeColor         string `json:"hex_theme_color"`
+	CvProfileID           int32  `json:"cv_profile_id"`
+	Importance            int32  `json:"importance"`
+	Slug                  string `json:"slug"`
+	CategoryDescription   string `json:"category_description"`
+	CategoryHexThemeColor string `json:"category_hex_theme_color"`
+	CategoryDisplayOrder  int32  `json:"category_display_order"`
+}
+
+// the skills of a cv profile in the display order of their categories, and by importance within a category.
+// The categories without a row in skill_categories come last, ordered by name.
+func (q *Queries) ListSkillsWithCategories(ctx context.Context, cvProfileID int32) ([]ListSkillsWithCategoriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSkillsWithCategories, cvProfileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSkillsWithCategoriesRow{}
+	for rows.Next() {
+		var i ListSkillsWithCategoriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Category,
+			&i.Image,
+			&i.HexThemeColor,
+			&i.CvProfileID,
+			&i.Importance,
+			&i.Slug,
+			&i.CategoryDescription,
+			&i.CategoryHexThemeColor,
+			&i.CategoryDisplayOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseSkillImportance = `-- name: ReleaseSkillImportance :exec
+UPDATE skills
+SET importance = -id
+WHERE id = $1
+`
+
+// the skill gets its negated ID as the importance, which no other skill can hold, so that skills updated one by one
+// can swap their importances
+func (q *Queries) ReleaseSkillImportance(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, releaseSkillImportance, id)
+	return err
+}
+
+const reorderSkills = `-- name: ReorderSkills :exec
+UPDATE skills
+SET importance = o.position
+FROM unnest($3::int[]) WITH ORDINALITY AS o(id, position)
+WHERE skills.id = o.id
+  AND skills.cv_profile_id = $1
+  AND skills.category = $2
+`
+
+type ReorderSkillsParams struct {
+	CvProfileID int32   `json:"cv_profile_id"`
+	Category    string  `json:"category"`
+	SkillIds    []int32 `json:"skill_ids"`
+}
+
+// every skill gets its position in skill_ids as the importance, the deferrable unique constraint
+// is checked after the whole statement, so the skills can swap their importances
+func (q *Queries) ReorderSkills(ctx context.Context, arg ReorderSkillsParams) error {
+	_, err := q.db.ExecContext(ctx, reorderSkills, arg.CvProfileID, arg.Category, pq.Array(arg.SkillIds))
+	return err
+}
+
+const updateSkill = `-- name: UpdateSkill :one
+UPDATE skills
+SET name            = $2,
+    slug            = $8,
+    description     = $3,
+    category        = $4,
+    importance      = $5,
+    image           = $6,
+    hex_theme_color = $7
+WHERE id = $1
+RETURNING id, name, description, category, image, hex_theme_color, cv_profile_id, importance, slug
+`
+
+type UpdateSkillParams struct {
+	ID            int32  `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Category      string `json:"category"`
+	Importance    int32  `json:"importance"`
+	Image         string `json:"image"`
+	HexThemeColor string `json:"hex_theme_color"`
+	Slug          string `json:"slug"`
+}
+
+func (q *Queries) UpdateSkill(ctx context.Context, arg UpdateSkillParams) (Skill, error) {
+	row := q.db.QueryRowContext(ctx, updateSkill,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.Category,
+		arg.Importance,
+		arg.Image,
+		arg.HexThemeColor,
+		arg.Slug,
+	)
+	var i Skill
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Category,
+		&i.Image,
+		&i.HexThemeColor,
+		&i.CvProfileID,
+		&i.Importance,
+		&i.Slug,
+	)
+	return i, err
+}
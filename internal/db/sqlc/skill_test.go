@@ -13,13 +13,15 @@ func createRandomSkill(t *testing.T, cvProfileID int32) Skill {
 		cvProfileID = createRandomCvProfile(t).ID
 	}
 
+	name := utils.RandomString(7)
 	params := CreateSkillParams{
-		Name:          utils.RandomString(7),
+		Name:          name,
+		Slug:          utils.Slugify(name),
 		Description:   utils.RandomString(10),
 		Category:      utils.RandomString(7),
 		Importance:    utils.RandomInt(1, 100),
 		Image:         utils.RandomString(5),
-		HexThemeColor: utils.RandomString(6),
+		HexThemeColor: utils.RandomHexColor(),
 		CvProfileID:   cvProfileID,
 	}
 	skill, err := testQueries.CreateSkill(context.Background(), params)
@@ -32,6 +34,7 @@ func createRandomSkill(t *testing.T, cvProfileID int32) Skill {
 	require.Equal(t, params.Image, skill.Image)
 	require.Equal(t, params.HexThemeColor, skill.HexThemeColor)
 	require.Equal(t, params.CvProfileID, skill.CvProfileID)
+	require.Equal(t, utils.Slugify(params.Name), skill.Slug)
 
 	return skill
 }
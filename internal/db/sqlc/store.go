@@ -3,28 +3,91 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"time"
 )
 
 type Store interface {
 	Querier
 	ListProjectsWithTechnologies(ctx context.Context, arg ListProjectsWithTechnologiesParams) ([]ListProjectsWithTechnologiesRow, error)
 	ListProjectsWithTechnologiesBySkillName(ctx context.Context, arg ListProjectsWithTechnologiesBySkillNameParams) ([]ListProjectsWithTechnologiesBySkillNameRow, error)
+	ListSkillCategoriesWithSkills(ctx context.Context, cvProfileID int32) ([]ListSkillCategoriesWithSkillsRow, error)
+	CreateProjectTx(ctx context.Context, arg CreateProjectTxParams) (ListProjectsWithTechnologiesRow, error)
+	UpdateProjectTx(ctx context.Context, arg UpdateProjectTxParams) (ListProjectsWithTechnologiesRow, error)
+	PatchProjectTx(ctx context.Context, arg PatchProjectTxParams) (ListProjectsWithTechnologiesRow, error)
+	DeleteProjectTx(ctx context.Context, projectID int32) error
+	ReplaceProjectSkillsTx(ctx context.Context, arg ReplaceProjectSkillsTxParams) ([]ProjectSkill, error)
+	ReplaceProjectTechnologiesTx(ctx context.Context, arg ReplaceProjectTechnologiesTxParams) ([]ListTechnologiesForProjectRow, error)
+	ReorderProjectsTx(ctx context.Context, arg ReorderProjectsTxParams) ([]ListProjectsWithTechnologiesRow, error)
+	ReorderSkillsTx(ctx context.Context, arg ReorderSkillsTxParams) ([]Skill, error)
+	DeleteCvProfileTx(ctx context.Context, cvProfileID int32) error
+	ListCvExperiencesWithDetails(ctx context.Context, arg ListCvExperiencesWithDetailsParams) ([]ListCvExperiencesWithDetailsRow, error)
+	CreateCvExperienceTx(ctx context.Context, arg CreateCvExperienceTxParams) (ListCvExperiencesWithDetailsRow, error)
+	ImportCvProfileTx(ctx context.Context, arg ImportCvProfileTxParams) (CvProfile, error)
+	SeedCvProfileTx(ctx context.Context, arg SeedCvProfileTxParams) (CvProfile, error)
+	Ping(ctx context.Context) error
+	MigrationVersion(ctx context.Context) (version int64, dirty bool, err error)
 }
 
 // SQLStore provides all functions to execute db queries and transactions
 type SQLStore struct {
-	*Queries
-	db *sql.DB
+	Querier
+	db         *sql.DB
+	observe    QueryObserver
+	newQuerier NewQuerier
+}
+
+// NewQuerier creates the queries of a SQL dialect that run on a connection or a transaction
+type NewQuerier func(db DBTX) Querier
+
+// newQueries is the NewQuerier of the Postgres queries generated by sqlc
+func newQueries(db DBTX) Querier {
+	return New(db)
 }
 
 // NewStore creates a new Store
 func NewStore(db *sql.DB) Store {
+	return NewDialectStore(db, nil, newQueries)
+}
+
+// NewInstrumentedStore creates a new Store that reports the duration of every query,
+// including the ones executed in transactions, to observe
+func NewInstrumentedStore(db *sql.DB, observe QueryObserver) Store {
+	return NewDialectStore(db, observe, newQueries)
+}
+
+// NewDialectStore creates a Store that runs the queries created by newQuerier, so other databases
+// only implement Querier and share the transactions with Postgres. observe can be nil.
+func NewDialectStore(db *sql.DB, observe QueryObserver, newQuerier NewQuerier) Store {
 	return &SQLStore{
-		db:      db,
-		Queries: New(db),
+		db:         db,
+		observe:    observe,
+		newQuerier: newQuerier,
+		Querier:    newQuerier(instrument(db, observe)),
 	}
 }
 
+// execTx executes a function within a database transaction
+func (store *SQLStore) execTx(ctx context.Context, fn func(Querier) error) error {
+	tx, err := store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	q := store.newQuerier(instrument(tx, store.observe))
+	err = fn(q)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %w, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
 type ListProjectsWithTechnologiesParams struct {
 	CvProfileID int32
 	Limit       int32
@@ -45,20 +108,20 @@ type ListProjectsWithTechnologiesRow struct {
 
 // ListProjectsWithTechnologies returns a list of projects with technologies
 func (store *SQLStore) ListProjectsWithTechnologies(ctx context.Context, arg ListProjectsWithTechnologiesParams) ([]ListProjectsWithTechnologiesRow, error) {
-	params := ListProjectsParams{
+	params := ListProjectsWithTechnologyJSONParams{
 		CvProfileID: arg.CvProfileID,
 		Limit:       arg.Limit,
 		Offset:      arg.Offset,
 	}
-	projects, err := store.ListProjects(ctx, params)
+	projects, err := store.ListProjectsWithTechnologyJSON(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
-	var rows []ListProjectsWithTechnologiesRow
+	rows := []ListProjectsWithTechnologiesRow{}
 	for _, project := range projects {
-		technologies, err := store.ListTechnologiesForProject(ctx, project.ID)
-		if err != nil {
+		var technologies []ListTechnologiesForProjectRow
+		if err := json.Unmarshal(project.TechnologiesUsed, &technologies); err != nil {
 			return nil, err
 		}
 
@@ -97,23 +160,24 @@ type ListProjectsWithTechnologiesBySkillNameRow struct {
 	TechnologiesUsed []ListTechnologiesForProjectRow `json:"technologies_used"`
 }
 
-// ListProjectsWithTechnologiesBySkillName returns a list of projects with technologies that used given skill
+// ListProjectsWithTechnologiesBySkillName returns a list of projects with technologies that used given skill,
+// the skill is found by the slug of the name, so both a name and a slug find it
 func (store *SQLStore) ListProjectsWithTechnologiesBySkillName(ctx context.Context, arg ListProjectsWithTechnologiesBySkillNameParams) ([]ListProjectsWithTechnologiesBySkillNameRow, error) {
-	params := ListProjectsBySkillNameParams{
-		SkillName:   arg.SkillName,
+	params := ListProjectsWithTechnologyJSONBySkillNameParams{
+		SkillSlug:   utils.Slugify(arg.SkillName),
 		CvProfileID: arg.CvProfileID,
 		Limit:       arg.Limit,
 		Offset:      arg.Offset,
 	}
-	projects, err := store.ListProjectsBySkillName(ctx, params)
+	projects, err := store.ListProjectsWithTechnologyJSONBySkillName(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
-	var rows []ListProjectsWithTechnologiesBySkillNameRow
+	rows := []ListProjectsWithTechnologiesBySkillNameRow{}
 	for _, project := range projects {
-		technologies, err := store.ListTechnologiesForProject(ctx, project.ID)
-		if err != nil {
+		var technologies []ListTechnologiesForProjectRow
+		if err := json.Unmarshal(project.TechnologiesUsed, &technologies); err != nil {
 			return nil, err
 		}
 
@@ -132,3 +196,119 @@ func (store *SQLStore) ListProjectsWithTechnologiesBySkillName(ctx context.Conte
 
 	return rows, nil
 }
+
+type ListSkillCategoriesWithSkillsRow struct {
+	Name          string  `json:"name"`
+	Description   string  `json:"description"`
+	HexThemeColor string  `json:"hex_theme_color"`
+	DisplayOrder  int32   `json:"display_order"`
+	Skills        []Skill `json:"skills"`
+}
+
+// ListSkillCategoriesWithSkills returns the categories of the skills of a cv profile in their display order,
+// each with its skills ordered by importance. The categories without skills are left out, the categories
+// without a row in skill_categories come last with an empty description and theme color.
+func (store *SQLStore) ListSkillCategoriesWithSkills(ctx context.Context, cvProfileID int32) ([]ListSkillCategoriesWithSkillsRow, error) {
+	skills, err := store.ListSkillsWithCategories(ctx, cvProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the skills of a category are next to each other
+	rows := []ListSkillCategoriesWithSkillsRow{}
+	for _, skill := range skills {
+		if len(rows) == 0 || rows[len(rows)-1].Name != skill.Category {
+			rows = append(rows, ListSkillCategoriesWithSkillsRow{
+				Name:          skill.Category,
+				Description:   skill.CategoryDescription,
+				HexThemeColor: skill.CategoryHexThemeColor,
+				DisplayOrder:  skill.CategoryDisplayOrder,
+				Skills:        []Skill{},
+			})
+		}
+
+		category := &rows[len(rows)-1]
+		category.Skills = append(category.Skills, Skill{
+			ID:            skill.ID,
+			Name:          skill.Name,
+			Description:   skill.Description,
+			Category:      skill.Category,
+			Image:         skill.Image,
+			HexThemeColor: skill.HexThemeColor,
+			CvProfileID:   skill.CvProfileID,
+			Importance:    skill.Importance,
+			Slug:          skill.Slug,
+		})
+	}
+
+	return rows, nil
+}
+
+type ListCvExperiencesWithDetailsParams struct {
+	CvProfileID int32
+	Limit       int32
+	Offset      int32
+}
+
+type ListCvExperiencesWithDetailsRow struct {
+	ID             int32     `json:"id"`
+	Company        string    `json:"company"`
+	Position       string    `json:"position"`
+	Location       string    `json:"location"`
+	EmploymentType string    `json:"employment_type"`
+	StartDate      time.Time `json:"start_date"`
+	// EndDate is nil for the current job
+	EndDate          *time.Time                           `json:"end_date"`
+	Achievements     []string                             `json:"achievements"`
+	Skills           []ListSkillsForCvExperienceRow       `json:"skills"`
+	TechnologiesUsed []ListTechnologiesForCvExperienceRow `json:"technologies_used"`
+}
+
+// ListCvExperiencesWithDetails returns a list of work experiences with their skills and technologies
+func (store *SQLStore) ListCvExperiencesWithDetails(ctx context.Context, arg ListCvExperiencesWithDetailsParams) ([]ListCvExperiencesWithDetailsRow, error) {
+	params := ListCvExperiencesWithJSONParams{
+		CvProfileID: arg.CvProfileID,
+		Limit:       arg.Limit,
+		Offset:      arg.Offset,
+	}
+	experiences, err := store.ListCvExperiencesWithJSON(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []ListCvExperiencesWithDetailsRow{}
+	for _, experience := range experiences {
+		var skills []ListSkillsForCvExperienceRow
+		if err := json.Unmarshal(experience.Skills, &skills); err != nil {
+			return nil, err
+		}
+
+		var technologies []ListTechnologiesForCvExperienceRow
+		if err := json.Unmarshal(experience.TechnologiesUsed, &technologies); err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, ListCvExperiencesWithDetailsRow{
+			ID:               experience.ID,
+			Company:          experience.Company,
+			Position:         experience.Position,
+			Location:         experience.Location,
+			EmploymentType:   experience.EmploymentType,
+			StartDate:        experience.StartDate,
+			EndDate:          nullTimePtr(experience.EndDate),
+			Achievements:     experience.Achievements,
+			Skills:           skills,
+			TechnologiesUsed: technologies,
+		})
+	}
+
+	return rows, nil
+}
+
+// nullTimePtr converts sql.NullTime to a pointer that is nil when the time is not set
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -6,81 +6,55 @@ import (
 	"testing"
 )
 
-func TestSQLStore_ListProjectsWithTechnologies(t *testing.T) {
+// BenchmarkSQLStore_ListProjectsWithTechnologies compares listing a page of projects
+// with one technologies query per project against the single aggregated query
+func BenchmarkSQLStore_ListProjectsWithTechnologies(b *testing.B) {
 	store := NewStore(testDB)
-
-	// create test data in the database
-	cvProfile := createRandomCvProfile(t)
-	project := createRandomProject(t, cvProfile.ID)
-
-	for i := 0; i < 5; i++ {
-		technology := createRandomTechnology(t)
-		p := CreateProjectTechnologyParams{
-			ProjectID:    project.ID,
-			TechnologyID: technology.ID,
+	ctx := context.Background()
+
+	// create a full page of projects, each with a few technologies
+	cvProfile := createRandomCvProfile(b)
+	for i := 0; i < 15; i++ {
+		project := createRandomProject(b, cvProfile.ID)
+		for j := 0; j < 3; j++ {
+			technology := createRandomTechnology(b)
+			p := CreateProjectTechnologyParams{
+				ProjectID:    project.ID,
+				TechnologyID: technology.ID,
+			}
+			_, err := store.CreateProjectTechnology(ctx, p)
+			require.NoError(b, err)
 		}
-		_, err := store.CreateProjectTechnology(context.Background(), p)
-		require.NoError(t, err)
 	}
 
-	params := ListProjectsWithTechnologiesParams{
-		CvProfileID: cvProfile.ID,
-		Limit:       5,
-		Offset:      0,
-	}
-
-	projects, err := store.ListProjectsWithTechnologies(context.Background(), params)
-	require.NoError(t, err)
-
-	require.NotEmpty(t, projects[0])
-	require.Len(t, projects[0].TechnologiesUsed, 5)
-	require.Equal(t, projects[0].ID, project.ID)
-}
-
-func TestSQLStore_ListProjectsWithTechnologiesBySkillName(t *testing.T) {
-	store := NewStore(testDB)
-
-	// create test data in the database
-	cvProfile := createRandomCvProfile(t)
-	skill := createRandomSkill(t, cvProfile.ID)
-
-	for i := 0; i < 5; i++ {
-		project := createRandomProject(t, cvProfile.ID)
-
-		params := CreateProjectSkillParams{
-			ProjectID: project.ID,
-			SkillID:   skill.ID,
+	b.Run("QueryPerProject", func(b *testing.B) {
+		params := ListProjectsParams{
+			CvProfileID: cvProfile.ID,
+			Limit:       15,
+			Offset:      0,
 		}
-		_, err := store.CreateProjectSkill(context.Background(), params)
-		require.NoError(t, err)
-	}
 
-	params := ListProjectsWithTechnologiesBySkillNameParams{
-		CvProfileID: cvProfile.ID,
-		SkillName:   skill.Name,
-		Limit:       5,
-		Offset:      0,
-	}
+		for i := 0; i < b.N; i++ {
+			projects, err := store.ListProjects(ctx, params)
+			require.NoError(b, err)
 
-	projects, err := store.ListProjectsWithTechnologiesBySkillName(context.Background(), params)
-	require.NoError(t, err)
-
-	require.Len(t, projects, 5)
-	for _, project := range projects {
-		require.NotEmpty(t, project)
-	}
+			for _, project := range projects {
+				_, err := store.ListTechnologiesForProject(ctx, project.ID)
+				require.NoError(b, err)
+			}
+		}
+	})
 
-	p := ListProjectsBySkillNameParams{
-		CvProfileID: cvProfile.ID,
-		Limit:       5,
-		Offset:      0,
-		SkillName:   skill.Name,
-	}
+	b.Run("SingleQuery", func(b *testing.B) {
+		params := ListProjectsWithTechnologiesParams{
+			CvProfileID: cvProfile.ID,
+			Limit:       15,
+			Offset:      0,
+		}
 
-	x, err := store.ListProjectsBySkillName(context.Background(), p)
-	require.NoError(t, err)
-	require.Len(t, x, 5)
-	for i := 0; i < 5; i++ {
-		require.Equal(t, x[i].ID, projects[i].ID)
-	}
+		for i := 0; i < b.N; i++ {
+			_, err := store.ListProjectsWithTechnologies(ctx, params)
+			require.NoError(b, err)
+		}
+	})
 }
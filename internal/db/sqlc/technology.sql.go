@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.20.0
+//   sqlc v1.30.0
 // source: technology.sql
 
 package db
@@ -51,6 +51,48 @@ func (q *Queries) CreateTechnology(ctx context.Context, arg CreateTechnologyPara
 	return i, err
 }
 
+const deleteProjectTechnologies = `-- name: DeleteProjectTechnologies :exec
+DELETE
+FROM project_technologies
+WHERE project_id = $1
+`
+
+func (q *Queries) DeleteProjectTechnologies(ctx context.Context, projectID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteProjectTechnologies, projectID)
+	return err
+}
+
+const deleteProjectTechnologiesByCvProfile = `-- name: DeleteProjectTechnologiesByCvProfile :exec
+DELETE
+FROM project_technologies
+WHERE project_id IN (SELECT id FROM projects WHERE projects.cv_profile_id = $1)
+`
+
+func (q *Queries) DeleteProjectTechnologiesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteProjectTechnologiesByCvProfile, cvProfileID)
+	return err
+}
+
+const getTechnologyByName = `-- name: GetTechnologyByName :one
+SELECT id, name, url, order_field
+FROM technologies
+WHERE name = $1
+ORDER BY id
+LIMIT 1
+`
+
+func (q *Queries) GetTechnologyByName(ctx context.Context, name string) (Technology, error) {
+	row := q.db.QueryRowContext(ctx, getTechnologyByName, name)
+	var i Technology
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Url,
+		&i.OrderField,
+	)
+	return i, err
+}
+
 const listTechnologiesForProject = `-- name: ListTechnologiesForProject :many
 SELECT t.id,
        t.name,
@@ -89,3 +131,29 @@ func (q *Queries) ListTechnologiesForProject(ctx context.Context, projectID int3
 	}
 	return items, nil
 }
+
+const updateTechnology = `-- name: UpdateTechnology :one
+UPDATE technologies
+SET url         = $2,
+    order_field = $3
+WHERE id = $1
+RETURNING id, name, url, order_field
+`
+
+type UpdateTechnologyParams struct {
+	ID         int32  `json:"id"`
+	Url        string `json:"url"`
+	OrderField int32  `json:"order_field"`
+}
+
+func (q *Queries) UpdateTechnology(ctx context.Context, arg UpdateTechnologyParams) (Technology, error) {
+	row := q.db.QueryRowContext(ctx, updateTechnology, arg.ID, arg.Url, arg.OrderField)
+	var i Technology
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Url,
+		&i.OrderField,
+	)
+	return i, err
+}
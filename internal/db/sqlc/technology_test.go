@@ -8,7 +8,7 @@ import (
 )
 
 // createRandomTechnology create and return a random technology
-func createRandomTechnology(t *testing.T) Technology {
+func createRandomTechnology(t testing.TB) Technology {
 	params := CreateTechnologyParams{
 		Name:       utils.RandomString(5),
 		Url:        utils.RandomString(5),
@@ -76,3 +76,14 @@ func TestQueries_ListTechnologiesForProject(t *testing.T) {
 		require.NotEmpty(t, technology)
 	}
 }
+
+func TestQueries_DeleteProjectTechnologies(t *testing.T) {
+	projectTechnology := createRandomProjectTechnology(t)
+
+	err := testQueries.DeleteProjectTechnologies(context.Background(), projectTechnology.ProjectID)
+	require.NoError(t, err)
+
+	technologies, err := testQueries.ListTechnologiesForProject(context.Background(), projectTechnology.ProjectID)
+	require.NoError(t, err)
+	require.Empty(t, technologies)
+}
@@ -0,0 +1,40 @@
+package db
+
+import (
+	"context"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// createRandomUser creates and return a random user
+func createRandomUser(t *testing.T) User {
+	hashedPassword, err := utils.HashPassword(utils.RandomString(8))
+	require.NoError(t, err)
+
+	params := CreateUserParams{
+		Username:       utils.RandomString(8),
+		HashedPassword: hashedPassword,
+	}
+
+	user, err := testQueries.CreateUser(context.Background(), params)
+	require.NoError(t, err)
+	require.NotEmpty(t, user)
+	require.Equal(t, params.Username, user.Username)
+	require.Equal(t, params.HashedPassword, user.HashedPassword)
+	require.NotZero(t, user.ID)
+	require.NotZero(t, user.CreatedAt)
+
+	return user
+}
+
+func TestQueries_CreateUser(t *testing.T) {
+	createRandomUser(t)
+}
+
+func TestQueries_GetUser(t *testing.T) {
+	user := createRandomUser(t)
+	user2, err := testQueries.GetUser(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, user, user2)
+}
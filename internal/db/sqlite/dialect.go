@@ -0,0 +1,614 @@
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"time"
+)
+
+// querier implements db.Querier with the SQLite queries. The rows of both databases have the same fields,
+// except for the arrays and JSON columns, which SQLite stores as JSON text, and the errors are translated
+// to the errors of Postgres.
+type querier struct {
+	queries *Queries
+}
+
+var _ db.Querier = querier{}
+
+func newQuerier(dbtx db.DBTX) db.Querier {
+	return querier{queries: New(dbtx)}
+}
+
+func (q querier) CreateCvEducation(ctx context.Context, arg db.CreateCvEducationParams) (db.CvEducation, error) {
+	arg.StartDate, arg.EndDate = date(arg.StartDate), datePointer(arg.EndDate)
+	row, err := q.queries.CreateCvEducation(ctx, CreateCvEducationParams(arg))
+	return db.CvEducation(row), sqliteError(err)
+}
+
+func (q querier) CreateCvExperience(ctx context.Context, arg db.CreateCvExperienceParams) (db.CvExperience, error) {
+	params, err := createCvExperienceParams(arg)
+	if err != nil {
+		return db.CvExperience{}, err
+	}
+	row, err := q.queries.CreateCvExperience(ctx, params)
+	if err != nil {
+		return db.CvExperience{}, sqliteError(err)
+	}
+	return cvExperience(row)
+}
+
+func (q querier) CreateCvExperienceSkill(ctx context.Context, arg db.CreateCvExperienceSkillParams) (db.CvExperienceSkill, error) {
+	row, err := q.queries.CreateCvExperienceSkill(ctx, CreateCvExperienceSkillParams(arg))
+	return db.CvExperienceSkill(row), sqliteError(err)
+}
+
+func (q querier) CreateCvExperienceTechnology(ctx context.Context, arg db.CreateCvExperienceTechnologyParams) (db.CvExperienceTechnology, error) {
+	row, err := q.queries.CreateCvExperienceTechnology(ctx, CreateCvExperienceTechnologyParams(arg))
+	return db.CvExperienceTechnology(row), sqliteError(err)
+}
+
+func (q querier) CreateCvProfile(ctx context.Context, arg db.CreateCvProfileParams) (db.CvProfile, error) {
+	row, err := q.queries.CreateCvProfile(ctx, CreateCvProfileParams(arg))
+	return db.CvProfile(row), sqliteError(err)
+}
+
+func (q querier) CreateProject(ctx context.Context, arg db.CreateProjectParams) (db.Project, error) {
+	row, err := q.queries.CreateProject(ctx, CreateProjectParams(arg))
+	return db.Project(row), sqliteError(err)
+}
+
+func (q querier) CreateProjectSkill(ctx context.Context, arg db.CreateProjectSkillParams) (db.ProjectSkill, error) {
+	row, err := q.queries.CreateProjectSkill(ctx, CreateProjectSkillParams(arg))
+	return db.ProjectSkill(row), sqliteError(err)
+}
+
+func (q querier) CreateProjectTechnology(ctx context.Context, arg db.CreateProjectTechnologyParams) (db.ProjectTechnology, error) {
+	row, err := q.queries.CreateProjectTechnology(ctx, CreateProjectTechnologyParams(arg))
+	return db.ProjectTechnology(row), sqliteError(err)
+}
+
+func (q querier) CreateSkill(ctx context.Context, arg db.CreateSkillParams) (db.Skill, error) {
+	row, err := q.queries.CreateSkill(ctx, CreateSkillParams(arg))
+	return db.Skill(row), sqliteError(err)
+}
+
+func (q querier) CreateTechnology(ctx context.Context, arg db.CreateTechnologyParams) (db.Technology, error) {
+	row, err := q.queries.CreateTechnology(ctx, CreateTechnologyParams(arg))
+	return db.Technology(row), sqliteError(err)
+}
+
+func (q querier) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	row, err := q.queries.CreateUser(ctx, CreateUserParams(arg))
+	return db.User(row), sqliteError(err)
+}
+
+func (q querier) CvProfileExists(ctx context.Context, id int32) (bool, error) {
+	row, err := q.queries.CvProfileExists(ctx, id)
+	return row, sqliteError(err)
+}
+
+func (q querier) DeleteCvEducationsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	return sqliteError(q.queries.DeleteCvEducationsByCvProfile(ctx, cvProfileID))
+}
+
+func (q querier) DeleteCvExperienceSkills(ctx context.Context, cvExperienceID int32) error {
+	return sqliteError(q.queries.DeleteCvExperienceSkills(ctx, cvExperienceID))
+}
+
+func (q querier) DeleteCvExperienceSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	return sqliteError(q.queries.DeleteCvExperienceSkillsByCvProfile(ctx, cvProfileID))
+}
+
+func (q querier) DeleteCvExperienceTechnologies(ctx context.Context, cvExperienceID int32) error {
+	return sqliteError(q.queries.DeleteCvExperienceTechnologies(ctx, cvExperienceID))
+}
+
+func (q querier) DeleteCvExperienceTechnologiesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	return sqliteError(q.queries.DeleteCvExperienceTechnologiesByCvProfile(ctx, cvProfileID))
+}
+
+func (q querier) DeleteCvExperiencesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	return sqliteError(q.queries.DeleteCvExperiencesByCvProfile(ctx, cvProfileID))
+}
+
+func (q querier) DeleteCvProfile(ctx context.Context, id int32) (db.CvProfile, error) {
+	row, err := q.queries.DeleteCvProfile(ctx, id)
+	return db.CvProfile(row), sqliteError(err)
+}
+
+func (q querier) DeleteProject(ctx context.Context, id int32) (db.Project, error) {
+	row, err := q.queries.DeleteProject(ctx, id)
+	return db.Project(row), sqliteError(err)
+}
+
+func (q querier) DeleteProjectSkills(ctx context.Context, projectID int32) error {
+	return sqliteError(q.queries.DeleteProjectSkills(ctx, projectID))
+}
+
+func (q querier) DeleteProjectSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	return sqliteError(q.queries.DeleteProjectSkillsByCvProfile(ctx, cvProfileID))
+}
+
+func (q querier) DeleteProjectTechnologies(ctx context.Context, projectID int32) error {
+	return sqliteError(q.queries.DeleteProjectTechnologies(ctx, projectID))
+}
+
+func (q querier) DeleteProjectTechnologiesByCvProfile(ctx context.Context, cvProfileID int32) error {
+	return sqliteError(q.queries.DeleteProjectTechnologiesByCvProfile(ctx, cvProfileID))
+}
+
+func (q querier) DeleteProjectsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	return sqliteError(q.queries.DeleteProjectsByCvProfile(ctx, cvProfileID))
+}
+
+func (q querier) DeleteSkillsByCvProfile(ctx context.Context, cvProfileID int32) error {
+	return sqliteError(q.queries.DeleteSkillsByCvProfile(ctx, cvProfileID))
+}
+
+func (q querier) GetCvEducation(ctx context.Context, id int32) (db.CvEducation, error) {
+	row, err := q.queries.GetCvEducation(ctx, id)
+	return db.CvEducation(row), sqliteError(err)
+}
+
+func (q querier) GetCvEducationByInstitution(ctx context.Context, arg db.GetCvEducationByInstitutionParams) (db.CvEducation, error) {
+	row, err := q.queries.GetCvEducationByInstitution(ctx, GetCvEducationByInstitutionParams(arg))
+	return db.CvEducation(row), sqliteError(err)
+}
+
+func (q querier) GetCvExperience(ctx context.Context, id int32) (db.CvExperience, error) {
+	row, err := q.queries.GetCvExperience(ctx, id)
+	if err != nil {
+		return db.CvExperience{}, sqliteError(err)
+	}
+	return cvExperience(row)
+}
+
+func (q querier) GetCvExperienceByCompany(ctx context.Context, arg db.GetCvExperienceByCompanyParams) (db.CvExperience, error) {
+	arg.StartDate = date(arg.StartDate)
+	row, err := q.queries.GetCvExperienceByCompany(ctx, GetCvExperienceByCompanyParams(arg))
+	if err != nil {
+		return db.CvExperience{}, sqliteError(err)
+	}
+	return cvExperience(row)
+}
+
+func (q querier) GetCvProfile(ctx context.Context, id int32) (db.CvProfile, error) {
+	row, err := q.queries.GetCvProfile(ctx, id)
+	return db.CvProfile(row), sqliteError(err)
+}
+
+func (q querier) GetCvProfileByEmail(ctx context.Context, email string) (db.CvProfile, error) {
+	row, err := q.queries.GetCvProfileByEmail(ctx, email)
+	return db.CvProfile(row), sqliteError(err)
+}
+
+func (q querier) GetProject(ctx context.Context, id int32) (db.Project, error) {
+	row, err := q.queries.GetProject(ctx, id)
+	return db.Project(row), sqliteError(err)
+}
+
+func (q querier) GetProjectByTitle(ctx context.Context, arg db.GetProjectByTitleParams) (db.Project, error) {
+	row, err := q.queries.GetProjectByTitle(ctx, GetProjectByTitleParams(arg))
+	return db.Project(row), sqliteError(err)
+}
+
+func (q querier) GetSkill(ctx context.Context, id int32) (db.Skill, error) {
+	row, err := q.queries.GetSkill(ctx, id)
+	return db.Skill(row), sqliteError(err)
+}
+
+func (q querier) GetSkillByName(ctx context.Context, arg db.GetSkillByNameParams) (db.Skill, error) {
+	row, err := q.queries.GetSkillByName(ctx, GetSkillByNameParams(arg))
+	return db.Skill(row), sqliteError(err)
+}
+
+func (q querier) GetSkillBySlug(ctx context.Context, arg db.GetSkillBySlugParams) (db.Skill, error) {
+	row, err := q.queries.GetSkillBySlug(ctx, GetSkillBySlugParams(arg))
+	return db.Skill(row), sqliteError(err)
+}
+
+func (q querier) GetTechnologyByName(ctx context.Context, name string) (db.Technology, error) {
+	row, err := q.queries.GetTechnologyByName(ctx, name)
+	return db.Technology(row), sqliteError(err)
+}
+
+func (q querier) GetUser(ctx context.Context, username string) (db.User, error) {
+	row, err := q.queries.GetUser(ctx, username)
+	return db.User(row), sqliteError(err)
+}
+
+func (q querier) ListCvEducations(ctx context.Context, arg db.ListCvEducationsParams) ([]db.CvEducation, error) {
+	rows, err := q.queries.ListCvEducations(ctx, ListCvEducationsParams(arg))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, func(row CvEducation) (db.CvEducation, error) { return db.CvEducation(row), nil })
+}
+
+func (q querier) ListCvExperiences(ctx context.Context, arg db.ListCvExperiencesParams) ([]db.CvExperience, error) {
+	rows, err := q.queries.ListCvExperiences(ctx, ListCvExperiencesParams(arg))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, cvExperience)
+}
+
+func (q querier) ListCvExperiencesWithJSON(ctx context.Context, arg db.ListCvExperiencesWithJSONParams) ([]db.ListCvExperiencesWithJSONRow, error) {
+	rows, err := q.queries.ListCvExperiencesWithJSON(ctx, ListCvExperiencesWithJSONParams(arg))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, cvExperienceWithJSON)
+}
+
+func (q querier) ListProjectSkills(ctx context.Context, projectID int32) ([]db.ProjectSkill, error) {
+	rows, err := q.queries.ListProjectSkills(ctx, projectID)
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, func(row ProjectSkill) (db.ProjectSkill, error) { return db.ProjectSkill(row), nil })
+}
+
+func (q querier) ListProjects(ctx context.Context, arg db.ListProjectsParams) ([]db.ListProjectsRow, error) {
+	rows, err := q.queries.ListProjects(ctx, ListProjectsParams(arg))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, func(row ListProjectsRow) (db.ListProjectsRow, error) { return db.ListProjectsRow(row), nil })
+}
+
+func (q querier) ListProjectsByCvProfile(ctx context.Context, cvProfileID int32) ([]db.Project, error) {
+	rows, err := q.queries.ListProjectsByCvProfile(ctx, cvProfileID)
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, func(row Project) (db.Project, error) { return db.Project(row), nil })
+}
+
+func (q querier) ListProjectsBySkillName(ctx context.Context, arg db.ListProjectsBySkillNameParams) ([]db.ListProjectsBySkillNameRow, error) {
+	rows, err := q.queries.ListProjectsBySkillName(ctx, ListProjectsBySkillNameParams(arg))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, func(row ListProjectsBySkillNameRow) (db.ListProjectsBySkillNameRow, error) {
+		return db.ListProjectsBySkillNameRow(row), nil
+	})
+}
+
+func (q querier) ListProjectsWithTechnologyJSON(ctx context.Context, arg db.ListProjectsWithTechnologyJSONParams) ([]db.ListProjectsWithTechnologyJSONRow, error) {
+	rows, err := q.queries.ListProjectsWithTechnologyJSON(ctx, ListProjectsWithTechnologyJSONParams(arg))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, projectWithTechnologyJSON)
+}
+
+func (q querier) ListProjectsWithTechnologyJSONBySkillName(ctx context.Context, arg db.ListProjectsWithTechnologyJSONBySkillNameParams) ([]db.ListProjectsWithTechnologyJSONBySkillNameRow, error) {
+	rows, err := q.queries.ListProjectsWithTechnologyJSONBySkillName(ctx, ListProjectsWithTechnologyJSONBySkillNameParams(arg))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, projectWithTechnologyJSONBySkillName)
+}
+
+func (q querier) ListSkills(ctx context.Context, arg db.ListSkillsParams) ([]db.Skill, error) {
+	rows, err := q.queries.ListSkills(ctx, ListSkillsParams(arg))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, func(row Skill) (db.Skill, error) { return db.Skill(row), nil })
+}
+
+func (q querier) ListSkillsByCategory(ctx context.Context, arg db.ListSkillsByCategoryParams) ([]db.Skill, error) {
+	rows, err := q.queries.ListSkillsByCategory(ctx, ListSkillsByCategoryParams(arg))
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, func(row Skill) (db.Skill, error) { return db.Skill(row), nil })
+}
+
+func (q querier) ListSkillsForCvExperience(ctx context.Context, cvExperienceID int32) ([]db.ListSkillsForCvExperienceRow, error) {
+	rows, err := q.queries.ListSkillsForCvExperience(ctx, cvExperienceID)
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, func(row ListSkillsForCvExperienceRow) (db.ListSkillsForCvExperienceRow, error) {
+		return db.ListSkillsForCvExperienceRow(row), nil
+	})
+}
+
+func (q querier) ListSkillsWithCategories(ctx context.Context, cvProfileID int32) ([]db.ListSkillsWithCategoriesRow, error) {
+	rows, err := q.queries.ListSkillsWithCategories(ctx, cvProfileID)
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, func(row ListSkillsWithCategoriesRow) (db.ListSkillsWithCategoriesRow, error) {
+		return db.ListSkillsWithCategoriesRow(row), nil
+	})
+}
+
+func (q querier) ListTechnologiesForCvExperience(ctx context.Context, cvExperienceID int32) ([]db.ListTechnologiesForCvExperienceRow, error) {
+	rows, err := q.queries.ListTechnologiesForCvExperience(ctx, cvExperienceID)
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, func(row ListTechnologiesForCvExperienceRow) (db.ListTechnologiesForCvExperienceRow, error) {
+		return db.ListTechnologiesForCvExperienceRow(row), nil
+	})
+}
+
+func (q querier) ListTechnologiesForProject(ctx context.Context, projectID int32) ([]db.ListTechnologiesForProjectRow, error) {
+	rows, err := q.queries.ListTechnologiesForProject(ctx, projectID)
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, func(row ListTechnologiesForProjectRow) (db.ListTechnologiesForProjectRow, error) {
+		return db.ListTechnologiesForProjectRow(row), nil
+	})
+}
+
+func (q querier) PatchProject(ctx context.Context, arg db.PatchProjectParams) (db.Project, error) {
+	row, err := q.queries.PatchProject(ctx, PatchProjectParams(arg))
+	return db.Project(row), sqliteError(err)
+}
+
+func (q querier) ReleaseSkillImportance(ctx context.Context, id int32) error {
+	return sqliteError(q.queries.ReleaseSkillImportance(ctx, id))
+}
+
+// ReorderProjects updates the projects one by one, run it in a transaction
+func (q querier) ReorderProjects(ctx context.Context, arg db.ReorderProjectsParams) error {
+	for i, id := range arg.ProjectIds {
+		err := q.queries.UpdateProjectSignificance(ctx, UpdateProjectSignificanceParams{
+			ID:           id,
+			CvProfileID:  arg.CvProfileID,
+			Significance: int32(i + 1),
+		})
+		if err != nil {
+			return sqliteError(err)
+		}
+	}
+	return nil
+}
+
+// ReorderSkills cannot rely on a deferred unique constraint like Postgres, it first negates the importances
+// of the category, so the new ones never collide with the old ones. Run it in a transaction.
+func (q querier) ReorderSkills(ctx context.Context, arg db.ReorderSkillsParams) error {
+	err := q.queries.ReleaseSkillImportances(ctx, ReleaseSkillImportancesParams{
+		CvProfileID: arg.CvProfileID,
+		Category:    arg.Category,
+	})
+	if err != nil {
+		return sqliteError(err)
+	}
+	for i, id := range arg.SkillIds {
+		err = q.queries.UpdateSkillImportance(ctx, UpdateSkillImportanceParams{
+			ID:          id,
+			CvProfileID: arg.CvProfileID,
+			Category:    arg.Category,
+			Importance:  int32(i + 1),
+		})
+		if err != nil {
+			return sqliteError(err)
+		}
+	}
+	return nil
+}
+
+func (q querier) SearchCvProfile(ctx context.Context, arg db.SearchCvProfileParams) ([]db.SearchCvProfileRow, error) {
+	query, ok := ftsQuery(arg.Query)
+	if !ok {
+		return []db.SearchCvProfileRow{}, nil
+	}
+
+	rows, err := q.queries.SearchCvProfile(ctx, SearchCvProfileParams{
+		CvProfileID: arg.CvProfileID,
+		Limit:       arg.Limit,
+		Offset:      arg.Offset,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, sqliteError(err)
+	}
+	return convertAll(rows, searchResult)
+}
+
+func (q querier) UpdateCvEducation(ctx context.Context, arg db.UpdateCvEducationParams) (db.CvEducation, error) {
+	arg.StartDate, arg.EndDate = date(arg.StartDate), datePointer(arg.EndDate)
+	row, err := q.queries.UpdateCvEducation(ctx, UpdateCvEducationParams(arg))
+	return db.CvEducation(row), sqliteError(err)
+}
+
+func (q querier) UpdateCvExperience(ctx context.Context, arg db.UpdateCvExperienceParams) (db.CvExperience, error) {
+	params, err := updateCvExperienceParams(arg)
+	if err != nil {
+		return db.CvExperience{}, err
+	}
+	row, err := q.queries.UpdateCvExperience(ctx, params)
+	if err != nil {
+		return db.CvExperience{}, sqliteError(err)
+	}
+	return cvExperience(row)
+}
+
+func (q querier) UpdateCvProfile(ctx context.Context, arg db.UpdateCvProfileParams) (db.CvProfile, error) {
+	row, err := q.queries.UpdateCvProfile(ctx, UpdateCvProfileParams(arg))
+	return db.CvProfile(row), sqliteError(err)
+}
+
+func (q querier) UpdateProject(ctx context.Context, arg db.UpdateProjectParams) (db.Project, error) {
+	row, err := q.queries.UpdateProject(ctx, UpdateProjectParams(arg))
+	return db.Project(row), sqliteError(err)
+}
+
+func (q querier) UpdateSkill(ctx context.Context, arg db.UpdateSkillParams) (db.Skill, error) {
+	row, err := q.queries.UpdateSkill(ctx, UpdateSkillParams(arg))
+	return db.Skill(row), sqliteError(err)
+}
+
+func (q querier) UpdateTechnology(ctx context.Context, arg db.UpdateTechnologyParams) (db.Technology, error) {
+	row, err := q.queries.UpdateTechnology(ctx, UpdateTechnologyParams(arg))
+	return db.Technology(row), sqliteError(err)
+}
+
+func (q querier) UpsertSkillCategory(ctx context.Context, arg db.UpsertSkillCategoryParams) (db.SkillCategory, error) {
+	row, err := q.queries.UpsertSkillCategory(ctx, UpsertSkillCategoryParams(arg))
+	return db.SkillCategory(row), sqliteError(err)
+}
+
+// convertAll converts the rows of a SQLite query to the rows of db.Querier, the result is never nil
+func convertAll[T, U any](rows []T, convert func(T) (U, error)) ([]U, error) {
+	items := make([]U, 0, len(rows))
+	for _, row := range rows {
+		item, err := convert(row)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// encodeAchievements stores the achievements as a JSON array
+func encodeAchievements(achievements []string) (string, error) {
+	if achievements == nil {
+		achievements = []string{}
+	}
+	b, err := json.Marshal(achievements)
+	if err != nil {
+		return "", fmt.Errorf("cannot encode achievements: %w", err)
+	}
+	return string(b), nil
+}
+
+// date is the date of t at midnight UTC. SQLite stores a time with its time of day,
+// so the dates are truncated like the date columns of Postgres truncate them.
+func date(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func datePointer(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	d := date(*t)
+	return &d
+}
+
+func nullDate(t sql.NullTime) sql.NullTime {
+	if !t.Valid {
+		return t
+	}
+	return sql.NullTime{Time: date(t.Time), Valid: true}
+}
+
+// decodeAchievements reads the JSON array of achievements
+func decodeAchievements(achievements string) ([]string, error) {
+	values := []string{}
+	if err := json.Unmarshal([]byte(achievements), &values); err != nil {
+		return nil, fmt.Errorf("cannot decode achievements: %w", err)
+	}
+	return values, nil
+}
+
+func createCvExperienceParams(arg db.CreateCvExperienceParams) (CreateCvExperienceParams, error) {
+	achievements, err := encodeAchievements(arg.Achievements)
+	return CreateCvExperienceParams{
+		Company:        arg.Company,
+		Position:       arg.Position,
+		Location:       arg.Location,
+		EmploymentType: arg.EmploymentType,
+		StartDate:      date(arg.StartDate),
+		EndDate:        nullDate(arg.EndDate),
+		Achievements:   achievements,
+		CvProfileID:    arg.CvProfileID,
+	}, err
+}
+
+func updateCvExperienceParams(arg db.UpdateCvExperienceParams) (UpdateCvExperienceParams, error) {
+	achievements, err := encodeAchievements(arg.Achievements)
+	return UpdateCvExperienceParams{
+		ID:             arg.ID,
+		Company:        arg.Company,
+		Position:       arg.Position,
+		Location:       arg.Location,
+		EmploymentType: arg.EmploymentType,
+		StartDate:      date(arg.StartDate),
+		EndDate:        nullDate(arg.EndDate),
+		Achievements:   achievements,
+	}, err
+}
+
+func cvExperience(row CvExperience) (db.CvExperience, error) {
+	achievements, err := decodeAchievements(row.Achievements)
+	return db.CvExperience{
+		ID:             row.ID,
+		Company:        row.Company,
+		Position:       row.Position,
+		Location:       row.Location,
+		EmploymentType: row.EmploymentType,
+		StartDate:      row.StartDate,
+		EndDate:        row.EndDate,
+		Achievements:   achievements,
+		CvProfileID:    row.CvProfileID,
+	}, err
+}
+
+func cvExperienceWithJSON(row ListCvExperiencesWithJSONRow) (db.ListCvExperiencesWithJSONRow, error) {
+	achievements, err := decodeAchievements(row.Achievements)
+	return db.ListCvExperiencesWithJSONRow{
+		ID:               row.ID,
+		Company:          row.Company,
+		Position:         row.Position,
+		Location:         row.Location,
+		EmploymentType:   row.EmploymentType,
+		StartDate:        row.StartDate,
+		EndDate:          row.EndDate,
+		Achievements:     achievements,
+		CvProfileID:      row.CvProfileID,
+		Skills:           json.RawMessage(row.Skills),
+		TechnologiesUsed: json.RawMessage(row.TechnologiesUsed),
+	}, err
+}
+
+func projectWithTechnologyJSON(row ListProjectsWithTechnologyJSONRow) (db.ListProjectsWithTechnologyJSONRow, error) {
+	return db.ListProjectsWithTechnologyJSONRow{
+		ID:               row.ID,
+		Title:            row.Title,
+		ShortDescription: row.ShortDescription,
+		Description:      row.Description,
+		Image:            row.Image,
+		HexThemeColor:    row.HexThemeColor,
+		ProjectUrl:       row.ProjectUrl,
+		Significance:     row.Significance,
+		TechnologiesUsed: json.RawMessage(row.TechnologiesUsed),
+	}, nil
+}
+
+func projectWithTechnologyJSONBySkillName(row ListProjectsWithTechnologyJSONBySkillNameRow) (db.ListProjectsWithTechnologyJSONBySkillNameRow, error) {
+	return db.ListProjectsWithTechnologyJSONBySkillNameRow{
+		ID:               row.ID,
+		Title:            row.Title,
+		ShortDescription: row.ShortDescription,
+		Description:      row.Description,
+		Image:            row.Image,
+		HexThemeColor:    row.HexThemeColor,
+		ProjectUrl:       row.ProjectUrl,
+		Significance:     row.Significance,
+		TechnologiesUsed: json.RawMessage(row.TechnologiesUsed),
+	}, nil
+}
+
+func searchResult(row SearchCvProfileRow) (db.SearchCvProfileRow, error) {
+	return db.SearchCvProfileRow{
+		Kind:    row.Kind,
+		ID:      row.ID,
+		Title:   row.Title,
+		Snippet: row.Snippet,
+		Rank:    float32(row.Rank),
+	}, nil
+}
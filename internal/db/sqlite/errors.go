@@ -0,0 +1,78 @@
+package sqlitedb
+
+import (
+	"errors"
+	"fmt"
+	"github.com/lib/pq"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+	"strings"
+)
+
+// foreignKeyPrefix starts the messages of the triggers that check the foreign keys,
+// the rest of the message is the name of the Postgres constraint
+const foreignKeyPrefix = "foreign_key_violation: "
+
+// checkPrefix precedes the name of the constraint in the message of a failed check
+const checkPrefix = "CHECK constraint failed: "
+
+// uniqueConstraints maps the columns that SQLite reports in unique violations
+// to the names of the Postgres constraints
+var uniqueConstraints = map[string]string{
+	"skills.cv_profile_id, skills.name":                        "unique_profile_skill_name",
+	"skills.cv_profile_id, skills.slug":                        "unique_profile_skill_slug",
+	"skills.cv_profile_id, skills.category, skills.importance": "unique_profile_skill_category_importance",
+	"skill_categories.cv_profile_id, skill_categories.name":    "unique_profile_skill_category_name",
+	"users.username": "users_username_key",
+	"project_skills.project_id, project_skills.skill_id":                                    "project_skills_pkey",
+	"project_technologies.project_id, project_technologies.technology_id":                   "project_technologies_pkey",
+	"cv_experience_skills.cv_experience_id, cv_experience_skills.skill_id":                  "cv_experience_skills_pkey",
+	"cv_experience_technologies.cv_experience_id, cv_experience_technologies.technology_id": "cv_experience_technologies_pkey",
+}
+
+// sqliteError translates constraint violations to the *pq.Error that Postgres returns,
+// so the API maps the errors of both databases to the same responses
+func sqliteError(err error) error {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+
+	msg := sqliteErr.Error()
+	switch sqliteErr.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		for columns, constraint := range uniqueConstraints {
+			if strings.Contains(msg, "constraint failed: "+columns+" (") {
+				return &pq.Error{
+					Code:       "23505",
+					Message:    fmt.Sprintf("duplicate key value violates unique constraint %q", constraint),
+					Constraint: constraint,
+				}
+			}
+		}
+		return &pq.Error{Code: "23505", Message: msg}
+	case sqlite3.SQLITE_CONSTRAINT_TRIGGER:
+		if _, constraint, ok := strings.Cut(msg, foreignKeyPrefix); ok {
+			constraint, _, _ = strings.Cut(constraint, " (")
+			return &pq.Error{
+				Code:       "23503",
+				Message:    fmt.Sprintf("foreign key constraint %q is violated", constraint),
+				Constraint: constraint,
+			}
+		}
+	case sqlite3.SQLITE_CONSTRAINT_CHECK:
+		// the checks have the names of the Postgres constraints
+		if _, constraint, ok := strings.Cut(msg, checkPrefix); ok {
+			constraint, _, _ = strings.Cut(constraint, " (")
+			return &pq.Error{
+				Code:       "23514",
+				Message:    fmt.Sprintf("check constraint %q is violated", constraint),
+				Constraint: constraint,
+			}
+		}
+		return &pq.Error{Code: "23514", Message: msg}
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		return &pq.Error{Code: "23503", Message: msg}
+	}
+	return err
+}
@@ -0,0 +1,90 @@
+-- name: CreateSkill :one
+INSERT INTO skills (name, slug, description, category, importance, image, hex_theme_color, cv_profile_id)
+VALUES (?1, ?8, ?2, ?3, ?4, ?5, ?6, ?7)
+RETURNING *;
+
+-- name: GetSkill :one
+SELECT *
+FROM skills
+WHERE id = ?1;
+
+-- name: ListSkills :many
+SELECT *
+FROM skills
+WHERE cv_profile_id = ?1
+ORDER BY importance, category, id
+LIMIT ?2 OFFSET ?3;
+
+-- name: DeleteSkillsByCvProfile :exec
+DELETE
+FROM skills
+WHERE cv_profile_id = ?1;
+
+-- name: GetSkillByName :one
+SELECT *
+FROM skills
+WHERE cv_profile_id = ?1
+  AND name = ?2;
+
+-- name: GetSkillBySlug :one
+-- the callers slug a name with utils.Slugify, so both a slug and a name find the skill
+SELECT *
+FROM skills
+WHERE cv_profile_id = ?1
+  AND slug = ?2;
+
+-- name: UpdateSkill :one
+UPDATE skills
+SET name            = ?2,
+    slug            = ?8,
+    description     = ?3,
+    category        = ?4,
+    importance      = ?5,
+    image           = ?6,
+    hex_theme_color = ?7
+WHERE id = ?1
+RETURNING *;
+
+-- name: ListSkillsByCategory :many
+SELECT *
+FROM skills
+WHERE cv_profile_id = ?1
+  AND category = ?2
+ORDER BY importance, id;
+
+-- name: ReleaseSkillImportance :exec
+-- the skill gets its negated ID as the importance, which no other skill can hold, so that skills updated one by one
+-- can swap their importances
+UPDATE skills
+SET importance = -id
+WHERE id = ?1;
+
+-- name: ReleaseSkillImportances :exec
+-- SQLite checks unique constraints after every row, the negated importances of the category
+-- cannot collide with the positions that ReorderSkills writes next
+UPDATE skills
+SET importance = -importance
+WHERE cv_profile_id = ?1
+  AND category = ?2;
+
+-- name: UpdateSkillImportance :exec
+-- ReorderSkills sets the importances one by one, sqlc cannot parse the UPDATE ... FROM json_each of a single statement
+UPDATE skills
+SET importance = ?4
+WHERE id = ?1
+  AND cv_profile_id = ?2
+  AND category = ?3;
+
+-- name: ListSkillsWithCategories :many
+-- the skills of a cv profile in the display order of their categories, and by importance within a category.
+-- The categories without a row in skill_categories come last, ordered by name.
+SELECT skills.*,
+       CAST(COALESCE(skill_categories.description, '') AS TEXT)       AS category_description,
+       CAST(COALESCE(skill_categories.hex_theme_color, '') AS TEXT)   AS category_hex_theme_color,
+       CAST(COALESCE(skill_categories.display_order, 0) AS INTEGER)   AS category_display_order
+FROM skills
+         LEFT JOIN skill_categories
+                   ON skill_categories.cv_profile_id = skills.cv_profile_id
+                       AND skill_categories.name = skills.category
+WHERE skills.cv_profile_id = ?1
+ORDER BY skill_categories.id IS NULL, skill_categories.display_order, skills.category, skills.importance, skills.id;
@@ -0,0 +1,42 @@
+-- name: CreateTechnology :one
+INSERT INTO technologies (name, url, order_field)
+VALUES (?1, ?2, ?3)
+RETURNING *;
+
+-- name: CreateProjectTechnology :one
+INSERT INTO project_technologies (project_id, technology_id)
+VALUES (?1, ?2)
+RETURNING *;
+
+-- name: ListTechnologiesForProject :many
+SELECT t.id,
+       t.name,
+       t.url
+FROM project_technologies pt
+         JOIN technologies t ON pt.technology_id = t.id
+WHERE pt.project_id = ?1
+ORDER BY t.order_field, t.id;
+
+-- name: DeleteProjectTechnologies :exec
+DELETE
+FROM project_technologies
+WHERE project_id = ?1;
+
+-- name: DeleteProjectTechnologiesByCvProfile :exec
+DELETE
+FROM project_technologies
+WHERE project_id IN (SELECT id FROM projects WHERE projects.cv_profile_id = ?1);
+
+-- name: GetTechnologyByName :one
+SELECT *
+FROM technologies
+WHERE name = ?1
+ORDER BY id
+LIMIT 1;
+
+-- name: UpdateTechnology :one
+UPDATE technologies
+SET url         = ?2,
+    order_field = ?3
+WHERE id = ?1
+RETURNING *;
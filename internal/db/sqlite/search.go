@@ -0,0 +1,156 @@
+package sqlitedb
+
+import (
+	"context"
+	"strings"
+	"unicode"
+)
+
+// searchCvProfile is not generated, the SQLite engine of sqlc cannot resolve the hidden column of an FTS5 table
+// that "<table> MATCH" refers to, and a column would restrict the match to that column
+const searchCvProfile = `SELECT 'project' AS kind,
+       p.id,
+       p.title,
+       replace(replace(replace(replace(replace(snippet(projects_search, -1, char(2), char(3), '...', 30), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), char(2), '<mark>'), char(3), '</mark>') AS snippet,
+       CAST(-bm25(projects_search, 1.0, 0.4, 0.2) AS REAL)         AS rank
+FROM projects_search
+         JOIN projects p ON p.id = projects_search.rowid
+WHERE projects_search MATCH ?4
+  AND p.cv_profile_id = ?1
+UNION ALL
+SELECT 'skill' AS kind,
+       s.id,
+       s.name,
+       replace(replace(replace(replace(replace(snippet(skills_search, 1, char(2), char(3), '...', 30), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), char(2), '<mark>'), char(3), '</mark>'),
+       CAST(-bm25(skills_search, 1.0, 0.4) AS REAL)
+FROM skills_search
+         JOIN skills s ON s.id = skills_search.rowid
+WHERE skills_search MATCH ?4
+  AND s.cv_profile_id = ?1
+UNION ALL
+SELECT 'profile' AS kind,
+       c.id,
+       c.name,
+       replace(replace(replace(replace(replace(snippet(cv_profiles_search, 0, char(2), char(3), '...', 30), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), char(2), '<mark>'), char(3), '</mark>'),
+       CAST(-bm25(cv_profiles_search, 0.4) AS REAL)
+FROM cv_profiles_search
+         JOIN cv_profiles c ON c.id = cv_profiles_search.rowid
+WHERE cv_profiles_search MATCH ?4
+  AND c.id = ?1
+ORDER BY rank DESC, kind, id
+LIMIT ?2 OFFSET ?3
+`
+
+type SearchCvProfileParams struct {
+	CvProfileID int32  `json:"cv_profile_id"`
+	Limit       int32  `json:"limit"`
+	Offset      int32  `json:"offset"`
+	Query       string `json:"query"`
+}
+
+type SearchCvProfileRow struct {
+	Kind    string  `json:"kind"`
+	ID      int32   `json:"id"`
+	Title   string  `json:"title"`
+	Snippet string  `json:"snippet"`
+	Rank    float64 `json:"rank"`
+}
+
+// SearchCvProfile is the FTS5 version of the Postgres query. query is an FTS5 query, the weights of the columns
+// match the Postgres ones (A=1.0, B=0.4, C=0.2). The snippets are escaped and the matches are marked by control
+// characters until then, so <mark> is their only markup.
+func (q *Queries) SearchCvProfile(ctx context.Context, arg SearchCvProfileParams) ([]SearchCvProfileRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchCvProfile,
+		arg.CvProfileID,
+		arg.Limit,
+		arg.Offset,
+		arg.Query,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchCvProfileRow{}
+	for rows.Next() {
+		var i SearchCvProfileRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.ID,
+			&i.Title,
+			&i.Snippet,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// searchTerm is a word or a "quoted phrase" of a search query
+type searchTerm struct {
+	words   []string
+	negated bool
+	or      bool
+}
+
+// ftsQuery converts the syntax of websearch_to_tsquery - words, "quoted phrases", or and -word -
+// to an FTS5 query. Every term is quoted, so the FTS5 operators are never read from the input.
+// ok is false when the query has nothing to search for.
+func ftsQuery(query string) (fts string, ok bool) {
+	var parts []string
+	for _, term := range searchTerms(query) {
+		phrase := `"` + strings.Join(term.words, " ") + `"`
+		switch {
+		case term.negated:
+			// FTS5 has no unary NOT, the term can only exclude the results of the previous ones
+			if len(parts) > 0 {
+				parts = append(parts, "NOT "+phrase)
+			}
+		case term.or && len(parts) > 0:
+			parts = append(parts, "OR "+phrase)
+		default:
+			parts = append(parts, phrase)
+			ok = true
+		}
+	}
+	return strings.Join(parts, " "), ok
+}
+
+// searchTerms splits the query into terms, words that are split by punctuation are searched as a phrase
+func searchTerms(query string) []searchTerm {
+	var terms []searchTerm
+	or := false
+	for query = strings.TrimSpace(query); query != ""; query = strings.TrimSpace(query) {
+		var text string
+		quoted := strings.HasPrefix(query, `"`)
+		if quoted {
+			text, query, _ = strings.Cut(query[1:], `"`)
+		} else {
+			end := strings.IndexFunc(query, func(r rune) bool { return unicode.IsSpace(r) || r == '"' })
+			if end < 0 {
+				end = len(query)
+			}
+			text, query = query[:end], query[end:]
+		}
+
+		if !quoted && strings.EqualFold(text, "or") {
+			or = len(terms) > 0
+			continue
+		}
+
+		negated := !quoted && strings.HasPrefix(text, "-")
+		words := strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+		if len(words) > 0 {
+			terms = append(terms, searchTerm{words: words, negated: negated, or: or && !negated})
+		}
+		or = false
+	}
+	return terms
+}
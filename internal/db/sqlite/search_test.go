@@ -0,0 +1,34 @@
+package sqlitedb
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFtsQuery(t *testing.T) {
+	testCases := []struct {
+		name  string
+		query string
+		fts   string
+		ok    bool
+	}{
+		{name: "Words", query: "golang  api", fts: `"golang" "api"`, ok: true},
+		{name: "Phrase", query: `"rest api" golang`, fts: `"rest api" "golang"`, ok: true},
+		{name: "UnclosedPhrase", query: `"rest api`, fts: `"rest api"`, ok: true},
+		{name: "Or", query: "golang or rust", fts: `"golang" OR "rust"`, ok: true},
+		{name: "LeadingOr", query: "or golang", fts: `"golang"`, ok: true},
+		{name: "Negated", query: "golang -rust", fts: `"golang" NOT "rust"`, ok: true},
+		{name: "OnlyNegated", query: "-rust", fts: "", ok: false},
+		{name: "Punctuation", query: "ci/cd c++", fts: `"ci cd" "c"`, ok: true},
+		{name: "Operators", query: "NEAR(a b) AND", fts: `"NEAR a" "b" "AND"`, ok: true},
+		{name: "Empty", query: "  ", fts: "", ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fts, ok := ftsQuery(tc.query)
+			require.Equal(t, tc.fts, fts)
+			require.Equal(t, tc.ok, ok)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Package sqlitedb runs the store on SQLite, for deployments that do not have a Postgres server.
+// The queries are generated by sqlc from the queries directory and the schema of the sqlite migrations.
+package sqlitedb
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"modernc.org/sqlite"
+	"strings"
+)
+
+// DriverName is the name of the SQLite database/sql driver
+const DriverName = "sqlite"
+
+// connectionPragmas enable the foreign keys, wait for locks instead of failing and store the times
+// in the format that the date functions of SQLite read
+const connectionPragmas = "_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_time_format=sqlite"
+
+func init() {
+	// the migrations slug the existing skill names with the function that the stores slug the names with
+	if err := sqlite.RegisterDeterministicScalarFunction("skill_slug", 1, skillSlug); err != nil {
+		panic(err)
+	}
+}
+
+// skillSlug is the skill_slug SQL function, on Postgres the migrator sets the slugs once the migrations are applied
+func skillSlug(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	switch name := args[0].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return utils.Slugify(name), nil
+	default:
+		return nil, fmt.Errorf("skill_slug: expected text, got %T", name)
+	}
+}
+
+// Open opens the SQLite database at source, e.g. "file:cv.db", with the pragmas that the store needs.
+// SQLite allows a single writer, so the pool has a single connection.
+func Open(source string) (*sql.DB, error) {
+	separator := "?"
+	if strings.Contains(source, "?") {
+		separator = "&"
+	}
+
+	conn, err := sql.Open(DriverName, source+separator+connectionPragmas)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetMaxOpenConns(1)
+	return conn, nil
+}
+
+// NewStore creates a new Store on a database opened with Open
+func NewStore(conn *sql.DB) db.Store {
+	return db.NewDialectStore(conn, nil, newQuerier)
+}
+
+// NewInstrumentedStore creates a new Store that reports the duration of every query to observe
+func NewInstrumentedStore(conn *sql.DB, observe db.QueryObserver) db.Store {
+	return db.NewDialectStore(conn, observe, newQuerier)
+}
@@ -0,0 +1,177 @@
+package sqlitedb
+
+import (
+	"context"
+	"github.com/aalug/cv-backend-go/internal/db/migrations"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/internal/db/storetest"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestStore creates a store on a new, migrated database file that is removed after the test
+func newTestStore(t *testing.T) db.Store {
+	conn, err := Open("file:" + filepath.Join(t.TempDir(), "cv.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	migrator, err := migrations.NewMigrator(context.Background(), DriverName, conn)
+	require.NoError(t, err)
+	require.NoError(t, migrator.Up())
+	require.NoError(t, migrator.Close())
+
+	return NewStore(conn)
+}
+
+func TestStore_Contract(t *testing.T) {
+	storetest.Run(t, newTestStore)
+}
+
+func TestStore_CvExperienceAchievements(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	cvProfile, err := store.CreateCvProfile(ctx, db.CreateCvProfileParams{Name: "name", Email: "email@example.com"})
+	require.NoError(t, err)
+
+	experience, err := store.CreateCvExperience(ctx, db.CreateCvExperienceParams{
+		Company:      "company",
+		Achievements: []string{"first", `"quoted"`},
+		CvProfileID:  cvProfile.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", `"quoted"`}, experience.Achievements)
+
+	experience, err = store.UpdateCvExperience(ctx, db.UpdateCvExperienceParams{
+		ID:      experience.ID,
+		Company: "company",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, experience.Achievements)
+	require.Empty(t, experience.Achievements)
+}
+
+func TestMigrations_RebuildKeepsRows(t *testing.T) {
+	conn, err := Open("file:" + filepath.Join(t.TempDir(), "cv.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	migrator, err := migrations.NewMigrator(context.Background(), DriverName, conn)
+	require.NoError(t, err)
+	defer migrator.Close()
+	require.NoError(t, migrator.Up())
+
+	store := NewStore(conn)
+	ctx := context.Background()
+
+	cvProfile, err := store.CreateCvProfile(ctx, db.CreateCvProfileParams{Name: "name", Email: "email@example.com"})
+	require.NoError(t, err)
+	skill, err := store.CreateSkill(ctx, db.CreateSkillParams{Name: "C++", Slug: "c-plus-plus", Category: "languages", Importance: 1, HexThemeColor: "#00599C", CvProfileID: cvProfile.ID})
+	require.NoError(t, err)
+	project, err := store.CreateProject(ctx, db.CreateProjectParams{Title: "title", CvProfileID: cvProfile.ID})
+	require.NoError(t, err)
+	_, err = store.CreateProjectSkill(ctx, db.CreateProjectSkillParams{ProjectID: project.ID, SkillID: skill.ID})
+	require.NoError(t, err)
+	education, err := store.CreateCvEducation(ctx, db.CreateCvEducationParams{Institution: "institution", StartDate: time.Now(), CvProfileID: cvProfile.ID})
+	require.NoError(t, err)
+
+	// 000011 and 000014 rebuild tables in both directions, the rows and the links between them are kept
+	require.NoError(t, migrator.Down(4))
+	require.NoError(t, migrator.Up())
+
+	// an ongoing education gets an end date when the column is not nullable
+	gotEducation, err := store.GetCvEducation(ctx, education.ID)
+	require.NoError(t, err)
+	require.NotNil(t, gotEducation.EndDate)
+	require.False(t, gotEducation.EndDate.Before(gotEducation.StartDate))
+
+	got, err := store.GetSkill(ctx, skill.ID)
+	require.NoError(t, err)
+	require.Equal(t, skill, got)
+
+	links, err := store.ListProjectSkills(ctx, project.ID)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+
+	// the categories of the existing skills are created
+	categories, err := store.ListSkillCategoriesWithSkills(ctx, cvProfile.ID)
+	require.NoError(t, err)
+	require.Len(t, categories, 1)
+	require.Equal(t, "languages", categories[0].Name)
+	require.Equal(t, int32(1), categories[0].DisplayOrder)
+
+	// the triggers are recreated
+	_, err = store.CreateSkill(ctx, db.CreateSkillParams{Name: "Go", Slug: "go", Category: "languages", Importance: 2, CvProfileID: cvProfile.ID + 1})
+	require.Error(t, err)
+
+	var foreignKeys bool
+	require.NoError(t, conn.QueryRow("PRAGMA foreign_keys").Scan(&foreignKeys))
+	require.True(t, foreignKeys)
+}
+
+func TestMigrations_SkillSlugs(t *testing.T) {
+	conn, err := Open("file:" + filepath.Join(t.TempDir(), "cv.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	migrator, err := migrations.NewMigrator(context.Background(), DriverName, conn)
+	require.NoError(t, err)
+	defer migrator.Close()
+	require.NoError(t, migrator.Up())
+
+	// the skills exist before 000009 adds the slugs
+	require.NoError(t, migrator.Down(int(migrations.LatestVersion())-8))
+	_, err = conn.Exec(`INSERT INTO cv_profiles (id, name, email, phone, address, github_url, bio, profile_picture)
+VALUES (1, 'name', 'email@example.com', '', '', '', '', '')`)
+	require.NoError(t, err)
+	for i, name := range []string{"Go", "Łódź", "go", "!?", "GO!"} {
+		_, err = conn.Exec(`INSERT INTO skills (id, name, description, category, image, hex_theme_color, cv_profile_id, importance)
+VALUES (?, ?, '', 'languages', '', '', 1, ?)`, i+1, name, i+1)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, migrator.Up())
+
+	// the slugs are made by utils.Slugify, names with the same slug get their id appended
+	store := NewStore(conn)
+	for id, slug := range map[int32]string{1: "go", 2: "lodz", 3: "go-3", 4: utils.Slugify("!?"), 5: "go-5"} {
+		skill, err := store.GetSkill(context.Background(), id)
+		require.NoError(t, err)
+		require.Equal(t, slug, skill.Slug, skill.Name)
+	}
+}
+
+func TestStore_CascadeUpdatesSearchIndexes(t *testing.T) {
+	conn, err := Open("file:" + filepath.Join(t.TempDir(), "cv.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	migrator, err := migrations.NewMigrator(context.Background(), DriverName, conn)
+	require.NoError(t, err)
+	require.NoError(t, migrator.Up())
+	require.NoError(t, migrator.Close())
+
+	store := NewStore(conn)
+	ctx := context.Background()
+
+	cvProfile, err := store.CreateCvProfile(ctx, db.CreateCvProfileParams{Name: "name", Email: "email@example.com"})
+	require.NoError(t, err)
+	_, err = store.CreateSkill(ctx, db.CreateSkillParams{Name: "golang", Slug: "golang", Category: "languages", Importance: 1, CvProfileID: cvProfile.ID})
+	require.NoError(t, err)
+	_, err = store.CreateProject(ctx, db.CreateProjectParams{Title: "golang", CvProfileID: cvProfile.ID})
+	require.NoError(t, err)
+
+	// the delete triggers of the search indexes run for the rows deleted by the cascades
+	_, err = store.DeleteCvProfile(ctx, cvProfile.ID)
+	require.NoError(t, err)
+
+	for _, index := range []string{"skills_search", "projects_search"} {
+		var count int
+		err = conn.QueryRow("SELECT count(*) FROM " + index + " WHERE " + index + " MATCH 'golang'").Scan(&count)
+		require.NoError(t, err)
+		require.Zero(t, count, index)
+	}
+}
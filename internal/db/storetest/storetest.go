@@ -0,0 +1,1286 @@
+// Package storetest is the contract test suite of db.Store. Every implementation runs it, so that they all
+// behave the same - including the uniqueness and foreign key rules and the errors returned when they are broken.
+package storetest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/aalug/cv-backend-go/internal/db/migrations"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+// missingID is an ID that no row ever has
+const missingID int32 = -1
+
+// Run runs the contract tests against the stores returned by newStore. The tests create random data
+// and never depend on the store being empty, so the same store can be shared by all of them.
+func Run(t *testing.T, newStore func(t *testing.T) db.Store) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, store db.Store)
+	}{
+		{"ListProjectsWithTechnologies", testListProjectsWithTechnologies},
+		{"ListProjectsWithTechnologiesNoTechnologies", testListProjectsWithTechnologiesNoTechnologies},
+		{"ListProjectsWithTechnologiesBySkillName", testListProjectsWithTechnologiesBySkillName},
+		{"EmptyListsAreNotNil", testEmptyListsAreNotNil},
+		{"NotFound", testNotFound},
+		{"UniqueSkillName", testUniqueSkillName},
+		{"SkillSlugs", testSkillSlugs},
+		{"SkillSlugsOfNonASCIINames", testSkillSlugsOfNonASCIINames},
+		{"UniqueSkillCategoryImportance", testUniqueSkillCategoryImportance},
+		{"ReorderSkillsTx", testReorderSkillsTx},
+		{"ReorderProjectsTx", testReorderProjectsTx},
+		{"SkillCategories", testSkillCategories},
+		{"UniqueLinks", testUniqueLinks},
+		{"UniqueUsername", testUniqueUsername},
+		{"ForeignKeys", testForeignKeys},
+		{"SameProfileLinks", testSameProfileLinks},
+		{"CheckConstraints", testCheckConstraints},
+		{"PatchProjectTx", testPatchProjectTx},
+		{"DeleteProjectCascade", testDeleteProjectCascade},
+		{"DeleteCvProfileCascade", testDeleteCvProfileCascade},
+		{"DeleteCvProfileTx", testDeleteCvProfileTx},
+		{"SearchSnippetEscapesHTML", testSearchSnippetEscapesHTML},
+		{"ImportCvProfileTxRollback", testImportCvProfileTxRollback},
+		{"ImportCvProfileTxDuplicateNames", testImportCvProfileTxDuplicateNames},
+		{"SeedCvProfileTx", testSeedCvProfileTx},
+		{"SeedCvProfileTxSwapsImportances", testSeedCvProfileTxSwapsImportances},
+		{"Ping", testPing},
+		{"MigrationVersion", testMigrationVersion},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			tc.test(t, newStore(t))
+		})
+	}
+}
+
+func testListProjectsWithTechnologies(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	project := createRandomProject(t, store, cvProfile.ID)
+
+	for i := 0; i < 5; i++ {
+		technology := createRandomTechnology(t, store)
+		_, err := store.CreateProjectTechnology(context.Background(), db.CreateProjectTechnologyParams{
+			ProjectID:    project.ID,
+			TechnologyID: technology.ID,
+		})
+		require.NoError(t, err)
+	}
+
+	projects, err := store.ListProjectsWithTechnologies(context.Background(), db.ListProjectsWithTechnologiesParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	require.Equal(t, project.ID, projects[0].ID)
+	require.Len(t, projects[0].TechnologiesUsed, 5)
+
+	// the aggregated technologies match the ones listed for the project alone
+	technologies, err := store.ListTechnologiesForProject(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Equal(t, technologies, projects[0].TechnologiesUsed)
+}
+
+func testListProjectsWithTechnologiesNoTechnologies(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	createRandomProject(t, store, cvProfile.ID)
+
+	projects, err := store.ListProjectsWithTechnologies(context.Background(), db.ListProjectsWithTechnologiesParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	require.NotNil(t, projects[0].TechnologiesUsed)
+	require.Empty(t, projects[0].TechnologiesUsed)
+}
+
+func testListProjectsWithTechnologiesBySkillName(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	skill := createRandomSkill(t, store, cvProfile.ID)
+
+	for i := 0; i < 5; i++ {
+		project := createRandomProject(t, store, cvProfile.ID)
+		_, err := store.CreateProjectSkill(context.Background(), db.CreateProjectSkillParams{
+			ProjectID: project.ID,
+			SkillID:   skill.ID,
+		})
+		require.NoError(t, err)
+	}
+	// a project without the skill is not listed
+	createRandomProject(t, store, cvProfile.ID)
+
+	projects, err := store.ListProjectsWithTechnologiesBySkillName(context.Background(), db.ListProjectsWithTechnologiesBySkillNameParams{
+		CvProfileID: cvProfile.ID,
+		SkillName:   skill.Name,
+		Limit:       10,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.Len(t, projects, 5)
+
+	rows, err := store.ListProjectsBySkillName(context.Background(), db.ListProjectsBySkillNameParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       10,
+		Offset:      0,
+		SkillSlug:   skill.Slug,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+	for i := range rows {
+		require.Equal(t, rows[i].ID, projects[i].ID)
+	}
+}
+
+func testEmptyListsAreNotNil(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+
+	// empty lists have to be serialized as [] and not null
+	projects, err := store.ListProjectsWithTechnologies(context.Background(), db.ListProjectsWithTechnologiesParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, projects)
+	require.Empty(t, projects)
+
+	projectsBySkill, err := store.ListProjectsWithTechnologiesBySkillName(context.Background(), db.ListProjectsWithTechnologiesBySkillNameParams{
+		CvProfileID: cvProfile.ID,
+		SkillName:   "unknown",
+		Limit:       5,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, projectsBySkill)
+	require.Empty(t, projectsBySkill)
+
+	experiences, err := store.ListCvExperiencesWithDetails(context.Background(), db.ListCvExperiencesWithDetailsParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, experiences)
+	require.Empty(t, experiences)
+
+	skills, err := store.ListSkills(context.Background(), db.ListSkillsParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       5,
+		Offset:      0,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, skills)
+	require.Empty(t, skills)
+}
+
+func testNotFound(t *testing.T, store db.Store) {
+	_, err := store.GetCvProfile(context.Background(), missingID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	exists, err := store.CvProfileExists(context.Background(), missingID)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = store.GetProject(context.Background(), missingID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = store.UpdateProject(context.Background(), db.UpdateProjectParams{ID: missingID})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = store.DeleteCvProfile(context.Background(), missingID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	err = store.DeleteCvProfileTx(context.Background(), missingID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = store.ReplaceProjectSkillsTx(context.Background(), db.ReplaceProjectSkillsTxParams{ProjectID: missingID})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = store.GetUser(context.Background(), utils.RandomString(12))
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func testUniqueSkillName(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	skill := createRandomSkill(t, store, cvProfile.ID)
+
+	params := db.CreateSkillParams{
+		Name:          skill.Name,
+		Slug:          utils.Slugify(skill.Name),
+		Category:      utils.RandomString(8),
+		Importance:    1,
+		HexThemeColor: "#000000",
+		CvProfileID:   cvProfile.ID,
+	}
+	_, err := store.CreateSkill(context.Background(), params)
+	requireSkillNameTaken(t, err)
+
+	// names are unique per profile
+	params.CvProfileID = createRandomCvProfile(t, store).ID
+	_, err = store.CreateSkill(context.Background(), params)
+	require.NoError(t, err)
+
+	// the name of another skill cannot be taken by an update either
+	other := createRandomSkill(t, store, cvProfile.ID)
+	_, err = store.UpdateSkill(context.Background(), db.UpdateSkillParams{
+		ID:         other.ID,
+		Name:       skill.Name,
+		Slug:       utils.Slugify(skill.Name),
+		Category:   other.Category,
+		Importance: other.Importance,
+	})
+	requireSkillNameTaken(t, err)
+
+	// updating the skill with its own values is fine
+	_, err = store.UpdateSkill(context.Background(), db.UpdateSkillParams{
+		ID:         skill.ID,
+		Name:       skill.Name,
+		Slug:       utils.Slugify(skill.Name),
+		Category:   skill.Category,
+		Importance: skill.Importance,
+	})
+	require.NoError(t, err)
+}
+
+func testSkillSlugs(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	name := "Café C++ " + utils.RandomString(6)
+
+	skill, err := store.CreateSkill(context.Background(), db.CreateSkillParams{
+		Name:          name,
+		Slug:          utils.Slugify(name),
+		Category:      utils.RandomString(8),
+		Importance:    1,
+		HexThemeColor: "#000000",
+		CvProfileID:   cvProfile.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, utils.Slugify(name), skill.Slug)
+
+	got, err := store.GetSkillBySlug(context.Background(), db.GetSkillBySlugParams{CvProfileID: cvProfile.ID, Slug: skill.Slug})
+	require.NoError(t, err)
+	require.Equal(t, skill, got)
+
+	project := createRandomProject(t, store, cvProfile.ID)
+	_, err = store.CreateProjectSkill(context.Background(), db.CreateProjectSkillParams{ProjectID: project.ID, SkillID: skill.ID})
+	require.NoError(t, err)
+
+	// the slug, the name and the name in other cases and without accents find the projects of the skill
+	for _, nameOrSlug := range []string{skill.Slug, name, strings.ToUpper(name), strings.Replace(name, "é", "e", 1)} {
+		projects, err := store.ListProjectsWithTechnologiesBySkillName(context.Background(), db.ListProjectsWithTechnologiesBySkillNameParams{
+			CvProfileID: cvProfile.ID,
+			SkillName:   nameOrSlug,
+			Limit:       5,
+		})
+		require.NoError(t, err, nameOrSlug)
+		require.Len(t, projects, 1, nameOrSlug)
+		require.Equal(t, project.ID, projects[0].ID)
+	}
+
+	rows, err := store.ListProjectsBySkillName(context.Background(), db.ListProjectsBySkillNameParams{
+		CvProfileID: cvProfile.ID,
+		SkillSlug:   skill.Slug,
+		Limit:       5,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+
+	// skills of other profiles are not found
+	otherProfile := createRandomCvProfile(t, store)
+	_, err = store.GetSkillBySlug(context.Background(), db.GetSkillBySlugParams{CvProfileID: otherProfile.ID, Slug: skill.Slug})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// a name with the same slug is taken within the profile, but not in other profiles
+	sameSlug := db.CreateSkillParams{
+		Name:          strings.ToUpper(name),
+		Slug:          utils.Slugify(strings.ToUpper(name)),
+		Category:      utils.RandomString(8),
+		Importance:    1,
+		HexThemeColor: "#000000",
+		CvProfileID:   cvProfile.ID,
+	}
+	_, err = store.CreateSkill(context.Background(), sameSlug)
+	requireConstraintError(t, err, "unique_violation", "unique_profile_skill_slug")
+
+	sameSlug.CvProfileID = otherProfile.ID
+	_, err = store.CreateSkill(context.Background(), sameSlug)
+	require.NoError(t, err)
+
+	// renaming a skill changes its slug
+	newName := "Node.js " + utils.RandomString(6)
+	updated, err := store.UpdateSkill(context.Background(), db.UpdateSkillParams{
+		ID:         skill.ID,
+		Name:       newName,
+		Slug:       utils.Slugify(newName),
+		Category:   skill.Category,
+		Importance: skill.Importance,
+	})
+	require.NoError(t, err)
+	require.Equal(t, utils.Slugify(updated.Name), updated.Slug)
+
+	_, err = store.GetSkillBySlug(context.Background(), db.GetSkillBySlugParams{CvProfileID: cvProfile.ID, Slug: skill.Slug})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+// testSkillSlugsOfNonASCIINames checks that the imported skills get the slugs of utils.Slugify,
+// which no SQL function reproduces for every script
+func testSkillSlugsOfNonASCIINames(t *testing.T, store db.Store) {
+	names := []string{"Zürich Straße", "Łódź", "Ελληνικά", "日本語", "Ångström C#", "Tiếng Việt", "हिन्दी", "עִבְרִית"}
+
+	params := db.ImportCvProfileTxParams{
+		CreateCvProfileParams: db.CreateCvProfileParams{Name: utils.RandomString(6), Email: utils.RandomEmail() + utils.RandomString(6)},
+	}
+	for i, name := range names {
+		params.Skills = append(params.Skills, db.CreateSkillParams{Name: name, Category: "languages", Importance: int32(i + 1), HexThemeColor: "#000000"})
+	}
+	cvProfile, err := store.ImportCvProfileTx(context.Background(), params)
+	require.NoError(t, err)
+
+	for _, name := range names {
+		skill, err := store.GetSkillByName(context.Background(), db.GetSkillByNameParams{CvProfileID: cvProfile.ID, Name: name})
+		require.NoError(t, err, name)
+		require.Equal(t, utils.Slugify(name), skill.Slug, name)
+
+		got, err := store.GetSkillBySlug(context.Background(), db.GetSkillBySlugParams{CvProfileID: cvProfile.ID, Slug: utils.Slugify(name)})
+		require.NoError(t, err, name)
+		require.Equal(t, skill.ID, got.ID)
+	}
+}
+
+func testUniqueSkillCategoryImportance(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	skill := createRandomSkill(t, store, cvProfile.ID)
+
+	name := utils.RandomString(12)
+	params := db.CreateSkillParams{
+		Name:          name,
+		Slug:          utils.Slugify(name),
+		Category:      skill.Category,
+		Importance:    skill.Importance,
+		HexThemeColor: "#000000",
+		CvProfileID:   cvProfile.ID,
+	}
+	_, err := store.CreateSkill(context.Background(), params)
+	requireConstraintError(t, err, "unique_violation", "unique_profile_skill_category_importance")
+
+	// importances are unique per category of a profile
+	params.CvProfileID = createRandomCvProfile(t, store).ID
+	_, err = store.CreateSkill(context.Background(), params)
+	require.NoError(t, err)
+}
+
+func testReorderSkillsTx(t *testing.T, store db.Store) {
+	ctx := context.Background()
+	cvProfile := createRandomCvProfile(t, store)
+	category := utils.RandomString(12)
+
+	var skillIDs []int32
+	for i := int32(1); i <= 3; i++ {
+		name := utils.RandomString(12)
+		skill, err := store.CreateSkill(ctx, db.CreateSkillParams{
+			Name:          name,
+			Slug:          utils.Slugify(name),
+			Category:      category,
+			Importance:    i,
+			HexThemeColor: "#000000",
+			CvProfileID:   cvProfile.ID,
+		})
+		require.NoError(t, err)
+		skillIDs = append(skillIDs, skill.ID)
+	}
+	other := createRandomSkill(t, store, cvProfile.ID)
+
+	// the skills swap their importances, which the unique constraint does not allow one skill at a time
+	order := []int32{skillIDs[2], skillIDs[0], skillIDs[1]}
+	params := db.ReorderSkillsTxParams{
+		CvProfileID: cvProfile.ID,
+		Category:    category,
+		SkillIDs:    order,
+	}
+	skills, err := store.ReorderSkillsTx(ctx, params)
+	require.NoError(t, err)
+	require.Len(t, skills, len(order))
+	for i, skill := range skills {
+		require.Equal(t, order[i], skill.ID)
+		require.Equal(t, int32(i+1), skill.Importance)
+	}
+
+	// the skills of other categories keep their importances
+	got, err := store.GetSkill(ctx, other.ID)
+	require.NoError(t, err)
+	require.Equal(t, other.Importance, got.Importance)
+
+	// the order has to list every skill of the category exactly once
+	for _, skillIDs := range [][]int32{
+		order[:2],
+		{order[0], order[1], order[2], other.ID},
+		{order[0], order[1], other.ID},
+		{order[0], order[0], order[1]},
+	} {
+		params.SkillIDs = skillIDs
+		_, err = store.ReorderSkillsTx(ctx, params)
+		require.ErrorIs(t, err, db.ErrOrderMismatch)
+	}
+
+	skills, err = store.ListSkillsByCategory(ctx, db.ListSkillsByCategoryParams{
+		CvProfileID: cvProfile.ID,
+		Category:    category,
+	})
+	require.NoError(t, err)
+	require.Len(t, skills, len(order))
+	for i, skill := range skills {
+		require.Equal(t, order[i], skill.ID)
+		require.Equal(t, int32(i+1), skill.Importance)
+	}
+
+	params.CvProfileID = missingID
+	params.SkillIDs = order
+	_, err = store.ReorderSkillsTx(ctx, params)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func testReorderProjectsTx(t *testing.T, store db.Store) {
+	ctx := context.Background()
+	cvProfile := createRandomCvProfile(t, store)
+
+	var order []int32
+	for i := 0; i < 3; i++ {
+		project := createRandomProject(t, store, cvProfile.ID)
+		order = append([]int32{project.ID}, order...)
+	}
+	other := createRandomProject(t, store, createRandomCvProfile(t, store).ID)
+
+	params := db.ReorderProjectsTxParams{
+		CvProfileID: cvProfile.ID,
+		ProjectIDs:  order,
+	}
+	projects, err := store.ReorderProjectsTx(ctx, params)
+	require.NoError(t, err)
+	require.Len(t, projects, len(order))
+	for i, project := range projects {
+		require.Equal(t, order[i], project.ID)
+		require.Equal(t, int32(i+1), project.Significance)
+	}
+
+	listed, err := store.ListProjectsWithTechnologies(ctx, db.ListProjectsWithTechnologiesParams{
+		CvProfileID: cvProfile.ID,
+		Limit:       10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, projects, listed)
+
+	// the order has to list every project of the cv profile exactly once
+	for _, projectIDs := range [][]int32{
+		order[:2],
+		{order[0], order[1], order[2], other.ID},
+		{order[0], order[1], other.ID},
+	} {
+		params.ProjectIDs = projectIDs
+		_, err = store.ReorderProjectsTx(ctx, params)
+		require.ErrorIs(t, err, db.ErrOrderMismatch)
+	}
+
+	got, err := store.GetProject(ctx, other.ID)
+	require.NoError(t, err)
+	require.Equal(t, other.Significance, got.Significance)
+
+	params.CvProfileID = missingID
+	params.ProjectIDs = order
+	_, err = store.ReorderProjectsTx(ctx, params)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func testSkillCategories(t *testing.T, store db.Store) {
+	ctx := context.Background()
+	cvProfile := createRandomCvProfile(t, store)
+
+	createSkill := func(category string, importance int32) db.Skill {
+		name := utils.RandomString(12)
+		skill, err := store.CreateSkill(ctx, db.CreateSkillParams{
+			Name:          name,
+			Slug:          utils.Slugify(name),
+			Category:      category,
+			Importance:    importance,
+			HexThemeColor: "#000000",
+			CvProfileID:   cvProfile.ID,
+		})
+		require.NoError(t, err)
+		return skill
+	}
+	// the categories are listed in their display order, not by name,
+	// the ones without a row come last
+	backend1 := createSkill("backend", 2)
+	backend2 := createSkill("backend", 1)
+	frontend := createSkill("frontend", 1)
+	tools := createSkill("tools", 1)
+	createRandomSkill(t, store, createRandomCvProfile(t, store).ID)
+
+	_, err := store.UpsertSkillCategory(ctx, db.UpsertSkillCategoryParams{
+		CvProfileID:  cvProfile.ID,
+		Name:         "backend",
+		DisplayOrder: 1,
+	})
+	require.NoError(t, err)
+	params := db.UpsertSkillCategoryParams{
+		CvProfileID:   cvProfile.ID,
+		Name:          "frontend",
+		Description:   utils.RandomString(20),
+		HexThemeColor: "#abc",
+		DisplayOrder:  3,
+	}
+	created, err := store.UpsertSkillCategory(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, params.Name, created.Name)
+	require.Equal(t, params.Description, created.Description)
+
+	// upserting the category again replaces it
+	params.DisplayOrder = 0
+	params.HexThemeColor = "#00ADD8"
+	updated, err := store.UpsertSkillCategory(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, updated.ID)
+	require.Equal(t, params.HexThemeColor, updated.HexThemeColor)
+	require.Zero(t, updated.DisplayOrder)
+
+	categories, err := store.ListSkillCategoriesWithSkills(ctx, cvProfile.ID)
+	require.NoError(t, err)
+	require.Equal(t, []db.ListSkillCategoriesWithSkillsRow{
+		{
+			Name:          "frontend",
+			Description:   params.Description,
+			HexThemeColor: params.HexThemeColor,
+			Skills:        []db.Skill{frontend},
+		},
+		{
+			Name:         "backend",
+			DisplayOrder: 1,
+			Skills:       []db.Skill{backend2, backend1},
+		},
+		{
+			Name:   "tools",
+			Skills: []db.Skill{tools},
+		},
+	}, categories)
+
+	categories, err = store.ListSkillCategoriesWithSkills(ctx, createRandomCvProfile(t, store).ID)
+	require.NoError(t, err)
+	require.NotNil(t, categories)
+	require.Empty(t, categories)
+
+	params.HexThemeColor = "red"
+	_, err = store.UpsertSkillCategory(ctx, params)
+	requireConstraintError(t, err, "check_violation", "skill_categories_hex_theme_color_check")
+
+	params.HexThemeColor = ""
+	params.CvProfileID = missingID
+	_, err = store.UpsertSkillCategory(ctx, params)
+	requireConstraintError(t, err, "foreign_key_violation", "skill_categories_cv_profile_id_fkey")
+}
+
+func testUniqueLinks(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	project := createRandomProject(t, store, cvProfile.ID)
+	skill := createRandomSkill(t, store, cvProfile.ID)
+	technology := createRandomTechnology(t, store)
+
+	_, err := store.CreateProjectSkill(context.Background(), db.CreateProjectSkillParams{ProjectID: project.ID, SkillID: skill.ID})
+	require.NoError(t, err)
+	_, err = store.CreateProjectSkill(context.Background(), db.CreateProjectSkillParams{ProjectID: project.ID, SkillID: skill.ID})
+	requireConstraintError(t, err, "unique_violation", "project_skills_pkey")
+
+	_, err = store.CreateProjectTechnology(context.Background(), db.CreateProjectTechnologyParams{ProjectID: project.ID, TechnologyID: technology.ID})
+	require.NoError(t, err)
+	_, err = store.CreateProjectTechnology(context.Background(), db.CreateProjectTechnologyParams{ProjectID: project.ID, TechnologyID: technology.ID})
+	requireConstraintError(t, err, "unique_violation", "project_technologies_pkey")
+
+	// a failed transaction leaves the links as they were
+	_, err = store.ReplaceProjectSkillsTx(context.Background(), db.ReplaceProjectSkillsTxParams{
+		ProjectID: project.ID,
+		SkillIDs:  []int32{skill.ID, skill.ID},
+	})
+	requireConstraintError(t, err, "unique_violation", "project_skills_pkey")
+
+	links, err := store.ListProjectSkills(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Equal(t, []db.ProjectSkill{{ProjectID: project.ID, SkillID: skill.ID}}, links)
+}
+
+func testUniqueUsername(t *testing.T, store db.Store) {
+	arg := db.CreateUserParams{
+		Username:       utils.RandomString(12),
+		HashedPassword: utils.RandomString(20),
+	}
+
+	user, err := store.CreateUser(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Username, user.Username)
+	require.NotZero(t, user.CreatedAt)
+
+	_, err = store.CreateUser(context.Background(), arg)
+	requireConstraintError(t, err, "unique_violation", "users_username_key")
+
+	got, err := store.GetUser(context.Background(), arg.Username)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, got.ID)
+}
+
+func testForeignKeys(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	project := createRandomProject(t, store, cvProfile.ID)
+	skill := createRandomSkill(t, store, cvProfile.ID)
+
+	name := utils.RandomString(12)
+	_, err := store.CreateSkill(context.Background(), db.CreateSkillParams{
+		Name:          name,
+		Slug:          utils.Slugify(name),
+		Category:      utils.RandomString(8),
+		Importance:    1,
+		HexThemeColor: "#000000",
+		CvProfileID:   missingID,
+	})
+	requireConstraintError(t, err, "foreign_key_violation", "skills_cv_profile_id_fkey")
+
+	_, err = store.CreateProject(context.Background(), db.CreateProjectParams{Title: utils.RandomString(6), CvProfileID: missingID})
+	requireConstraintError(t, err, "foreign_key_violation", "projects_cv_profile_id_fkey")
+
+	_, err = store.CreateCvEducation(context.Background(), db.CreateCvEducationParams{
+		Institution: utils.RandomString(6),
+		Degree:      utils.RandomString(6),
+		StartDate:   time.Now(),
+		CvProfileID: missingID,
+	})
+	requireConstraintError(t, err, "foreign_key_violation", "cv_educations_cv_profile_id_fkey")
+
+	_, err = store.CreateProjectSkill(context.Background(), db.CreateProjectSkillParams{ProjectID: project.ID, SkillID: missingID})
+	requireConstraintError(t, err, "foreign_key_violation", "project_skills_skill_id_fkey")
+
+	_, err = store.CreateProjectSkill(context.Background(), db.CreateProjectSkillParams{ProjectID: missingID, SkillID: skill.ID})
+	requireConstraintError(t, err, "foreign_key_violation", "project_skills_project_id_fkey")
+
+	_, err = store.CreateProjectTechnology(context.Background(), db.CreateProjectTechnologyParams{ProjectID: project.ID, TechnologyID: missingID})
+	requireConstraintError(t, err, "foreign_key_violation", "project_technologies_technology_id_fkey")
+
+	// nothing is created when a link of a new project is broken
+	title := utils.RandomString(12)
+	_, err = store.CreateProjectTx(context.Background(), db.CreateProjectTxParams{
+		CreateProjectParams: db.CreateProjectParams{Title: title, CvProfileID: cvProfile.ID},
+		TechnologyIDs:       []int32{missingID},
+	})
+	requireConstraintError(t, err, "foreign_key_violation", "project_technologies_technology_id_fkey")
+
+	_, err = store.GetProjectByTitle(context.Background(), db.GetProjectByTitleParams{CvProfileID: cvProfile.ID, Title: title})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func testSameProfileLinks(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	project := createRandomProject(t, store, cvProfile.ID)
+	experience := createRandomCvExperience(t, store, cvProfile.ID)
+	otherSkill := createRandomSkill(t, store, createRandomCvProfile(t, store).ID)
+
+	_, err := store.CreateProjectSkill(context.Background(), db.CreateProjectSkillParams{ProjectID: project.ID, SkillID: otherSkill.ID})
+	requireConstraintError(t, err, "foreign_key_violation", "project_skills_cv_profile_fkey")
+
+	_, err = store.CreateCvExperienceSkill(context.Background(), db.CreateCvExperienceSkillParams{CvExperienceID: experience.ID, SkillID: otherSkill.ID})
+	requireConstraintError(t, err, "foreign_key_violation", "cv_experience_skills_cv_profile_fkey")
+
+	// nothing is created when a new project links a skill of another profile
+	title := utils.RandomString(12)
+	_, err = store.CreateProjectTx(context.Background(), db.CreateProjectTxParams{
+		CreateProjectParams: db.CreateProjectParams{Title: title, CvProfileID: cvProfile.ID},
+		SkillIDs:            []int32{otherSkill.ID},
+	})
+	requireConstraintError(t, err, "foreign_key_violation", "project_skills_cv_profile_fkey")
+
+	_, err = store.GetProjectByTitle(context.Background(), db.GetProjectByTitleParams{CvProfileID: cvProfile.ID, Title: title})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// skills of the same profile can be linked
+	skill := createRandomSkill(t, store, cvProfile.ID)
+	_, err = store.ReplaceProjectSkillsTx(context.Background(), db.ReplaceProjectSkillsTxParams{
+		ProjectID: project.ID,
+		SkillIDs:  []int32{skill.ID, otherSkill.ID},
+	})
+	requireConstraintError(t, err, "foreign_key_violation", "project_skills_cv_profile_fkey")
+
+	_, err = store.ReplaceProjectSkillsTx(context.Background(), db.ReplaceProjectSkillsTxParams{
+		ProjectID: project.ID,
+		SkillIDs:  []int32{skill.ID},
+	})
+	require.NoError(t, err)
+}
+
+func testCheckConstraints(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	skill := createRandomSkill(t, store, cvProfile.ID)
+	project := createRandomProject(t, store, cvProfile.ID)
+
+	// theme colors are "#rgb", "#rrggbb" or empty
+	for _, color := range []string{"#abc", "#00ADD8", ""} {
+		_, err := store.UpdateSkill(context.Background(), db.UpdateSkillParams{
+			ID:            skill.ID,
+			Name:          skill.Name,
+			Slug:          utils.Slugify(skill.Name),
+			Category:      skill.Category,
+			Importance:    skill.Importance,
+			HexThemeColor: color,
+		})
+		require.NoError(t, err, color)
+	}
+
+	for _, color := range []string{"00ADD8", "#00ADD", "#00ADDG", "red", "#00ADD8 "} {
+		name := utils.RandomString(12)
+		_, err := store.CreateSkill(context.Background(), db.CreateSkillParams{
+			Name:          name,
+			Slug:          utils.Slugify(name),
+			Category:      utils.RandomString(8),
+			Importance:    1,
+			HexThemeColor: color,
+			CvProfileID:   cvProfile.ID,
+		})
+		requireConstraintError(t, err, "check_violation", "skills_hex_theme_color_check")
+
+		_, err = store.UpdateSkill(context.Background(), db.UpdateSkillParams{
+			ID:            skill.ID,
+			Name:          skill.Name,
+			Slug:          utils.Slugify(skill.Name),
+			Category:      skill.Category,
+			Importance:    skill.Importance,
+			HexThemeColor: color,
+		})
+		requireConstraintError(t, err, "check_violation", "skills_hex_theme_color_check")
+
+		_, err = store.CreateProject(context.Background(), db.CreateProjectParams{
+			Title:         utils.RandomString(6),
+			HexThemeColor: color,
+			CvProfileID:   cvProfile.ID,
+		})
+		requireConstraintError(t, err, "check_violation", "projects_hex_theme_color_check")
+
+		_, err = store.UpdateProject(context.Background(), db.UpdateProjectParams{
+			ID:            project.ID,
+			Title:         project.Title,
+			HexThemeColor: color,
+		})
+		requireConstraintError(t, err, "check_violation", "projects_hex_theme_color_check")
+	}
+
+	// periods cannot end before they start, they can start and end on the same day
+	start := time.Date(2020, time.March, 10, 12, 0, 0, 0, time.UTC)
+	dayBefore := start.AddDate(0, 0, -1)
+	_, err := store.CreateCvEducation(context.Background(), db.CreateCvEducationParams{
+		Institution: utils.RandomString(6),
+		StartDate:   start,
+		EndDate:     &dayBefore,
+		CvProfileID: cvProfile.ID,
+	})
+	requireConstraintError(t, err, "check_violation", "cv_educations_dates_check")
+
+	_, err = store.CreateCvEducation(context.Background(), db.CreateCvEducationParams{
+		Institution: utils.RandomString(6),
+		StartDate:   start,
+		EndDate:     &start,
+		CvProfileID: cvProfile.ID,
+	})
+	require.NoError(t, err)
+
+	// an education without an end date is ongoing
+	ongoing, err := store.CreateCvEducation(context.Background(), db.CreateCvEducationParams{
+		Institution: utils.RandomString(6),
+		StartDate:   start,
+		CvProfileID: cvProfile.ID,
+	})
+	require.NoError(t, err)
+	require.Nil(t, ongoing.EndDate)
+
+	_, err = store.CreateCvExperience(context.Background(), db.CreateCvExperienceParams{
+		Company:     utils.RandomString(6),
+		StartDate:   start,
+		EndDate:     sql.NullTime{Time: start.AddDate(-1, 0, 0), Valid: true},
+		CvProfileID: cvProfile.ID,
+	})
+	requireConstraintError(t, err, "check_violation", "cv_experiences_dates_check")
+
+	// the current job has no end date
+	experience, err := store.CreateCvExperience(context.Background(), db.CreateCvExperienceParams{
+		Company:     utils.RandomString(6),
+		StartDate:   start,
+		CvProfileID: cvProfile.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = store.UpdateCvExperience(context.Background(), db.UpdateCvExperienceParams{
+		ID:        experience.ID,
+		Company:   experience.Company,
+		StartDate: start,
+		EndDate:   sql.NullTime{Time: start.AddDate(0, -1, 0), Valid: true},
+	})
+	requireConstraintError(t, err, "check_violation", "cv_experiences_dates_check")
+}
+
+func testPatchProjectTx(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	project := createRandomProject(t, store, cvProfile.ID)
+	skill := createRandomSkill(t, store, cvProfile.ID)
+	technology := createRandomTechnology(t, store)
+
+	_, err := store.CreateProjectSkill(context.Background(), db.CreateProjectSkillParams{ProjectID: project.ID, SkillID: skill.ID})
+	require.NoError(t, err)
+
+	// two patches of different fields both end up in the project
+	title := utils.RandomString(8)
+	_, err = store.PatchProjectTx(context.Background(), db.PatchProjectTxParams{
+		PatchProjectParams: db.PatchProjectParams{
+			ID:    project.ID,
+			Title: sql.NullString{String: title, Valid: true},
+		},
+	})
+	require.NoError(t, err)
+
+	patched, err := store.PatchProjectTx(context.Background(), db.PatchProjectTxParams{
+		PatchProjectParams: db.PatchProjectParams{
+			ID:           project.ID,
+			Significance: sql.NullInt32{Int32: project.Significance + 1, Valid: true},
+		},
+		TechnologyIDs: []int32{technology.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, title, patched.Title)
+	require.Equal(t, project.Significance+1, patched.Significance)
+	require.Equal(t, project.Description, patched.Description)
+	require.Len(t, patched.TechnologiesUsed, 1)
+	require.Equal(t, technology.ID, patched.TechnologiesUsed[0].ID)
+
+	// nil skill ids leave the skills unchanged
+	links, err := store.ListProjectSkills(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+
+	_, err = store.PatchProjectTx(context.Background(), db.PatchProjectTxParams{
+		PatchProjectParams: db.PatchProjectParams{
+			ID:    missingID,
+			Title: sql.NullString{String: title, Valid: true},
+		},
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func testDeleteProjectCascade(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	project := createRandomProject(t, store, cvProfile.ID)
+	skill := createRandomSkill(t, store, cvProfile.ID)
+	technology := createRandomTechnology(t, store)
+
+	_, err := store.CreateProjectSkill(context.Background(), db.CreateProjectSkillParams{ProjectID: project.ID, SkillID: skill.ID})
+	require.NoError(t, err)
+	_, err = store.CreateProjectTechnology(context.Background(), db.CreateProjectTechnologyParams{ProjectID: project.ID, TechnologyID: technology.ID})
+	require.NoError(t, err)
+
+	// the links are deleted with the project, the skill and the technology are kept
+	_, err = store.DeleteProject(context.Background(), project.ID)
+	require.NoError(t, err)
+
+	_, err = store.GetProject(context.Background(), project.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	links, err := store.ListProjectSkills(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Empty(t, links)
+
+	technologies, err := store.ListTechnologiesForProject(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Empty(t, technologies)
+
+	_, err = store.GetSkill(context.Background(), skill.ID)
+	require.NoError(t, err)
+	_, err = store.GetTechnologyByName(context.Background(), technology.Name)
+	require.NoError(t, err)
+}
+
+func testDeleteCvProfileCascade(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	project := createRandomProject(t, store, cvProfile.ID)
+	skill := createRandomSkill(t, store, cvProfile.ID)
+	experience := createRandomCvExperience(t, store, cvProfile.ID)
+	technology := createRandomTechnology(t, store)
+
+	education, err := store.CreateCvEducation(context.Background(), db.CreateCvEducationParams{
+		Institution: utils.RandomString(6),
+		StartDate:   time.Now(),
+		CvProfileID: cvProfile.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = store.CreateProjectSkill(context.Background(), db.CreateProjectSkillParams{ProjectID: project.ID, SkillID: skill.ID})
+	require.NoError(t, err)
+	_, err = store.CreateProjectTechnology(context.Background(), db.CreateProjectTechnologyParams{ProjectID: project.ID, TechnologyID: technology.ID})
+	require.NoError(t, err)
+	_, err = store.CreateCvExperienceSkill(context.Background(), db.CreateCvExperienceSkillParams{CvExperienceID: experience.ID, SkillID: skill.ID})
+	require.NoError(t, err)
+	_, err = store.CreateCvExperienceTechnology(context.Background(), db.CreateCvExperienceTechnologyParams{CvExperienceID: experience.ID, TechnologyID: technology.ID})
+	require.NoError(t, err)
+
+	// everything of the profile is deleted with it
+	_, err = store.DeleteCvProfile(context.Background(), cvProfile.ID)
+	require.NoError(t, err)
+
+	_, err = store.GetCvEducation(context.Background(), education.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = store.GetCvExperience(context.Background(), experience.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = store.GetSkill(context.Background(), skill.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = store.GetProject(context.Background(), project.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	links, err := store.ListProjectSkills(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Empty(t, links)
+
+	experienceSkills, err := store.ListSkillsForCvExperience(context.Background(), experience.ID)
+	require.NoError(t, err)
+	require.Empty(t, experienceSkills)
+
+	experienceTechnologies, err := store.ListTechnologiesForCvExperience(context.Background(), experience.ID)
+	require.NoError(t, err)
+	require.Empty(t, experienceTechnologies)
+
+	// technologies are shared, so they are kept
+	_, err = store.GetTechnologyByName(context.Background(), technology.Name)
+	require.NoError(t, err)
+
+	// the links of another profile are not touched
+	otherProfile := createRandomCvProfile(t, store)
+	otherProject := createRandomProject(t, store, otherProfile.ID)
+	_, err = store.CreateProjectTechnology(context.Background(), db.CreateProjectTechnologyParams{ProjectID: otherProject.ID, TechnologyID: technology.ID})
+	require.NoError(t, err)
+
+	_, err = store.DeleteCvProfile(context.Background(), createRandomCvProfile(t, store).ID)
+	require.NoError(t, err)
+
+	technologies, err := store.ListTechnologiesForProject(context.Background(), otherProject.ID)
+	require.NoError(t, err)
+	require.Len(t, technologies, 1)
+}
+
+func testDeleteCvProfileTx(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	project := createRandomProject(t, store, cvProfile.ID)
+	skill := createRandomSkill(t, store, cvProfile.ID)
+	technology := createRandomTechnology(t, store)
+
+	_, err := store.CreateProjectSkill(context.Background(), db.CreateProjectSkillParams{ProjectID: project.ID, SkillID: skill.ID})
+	require.NoError(t, err)
+
+	_, err = store.CreateCvExperienceTx(context.Background(), db.CreateCvExperienceTxParams{
+		CreateCvExperienceParams: db.CreateCvExperienceParams{
+			Company:      utils.RandomString(6),
+			Position:     utils.RandomString(6),
+			StartDate:    time.Now(),
+			Achievements: []string{utils.RandomString(10)},
+			CvProfileID:  cvProfile.ID,
+		},
+		SkillIDs:      []int32{skill.ID},
+		TechnologyIDs: []int32{technology.ID},
+	})
+	require.NoError(t, err)
+
+	err = store.DeleteCvProfileTx(context.Background(), cvProfile.ID)
+	require.NoError(t, err)
+
+	exists, err := store.CvProfileExists(context.Background(), cvProfile.ID)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = store.GetSkill(context.Background(), skill.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// technologies are shared, so they are kept
+	got, err := store.GetTechnologyByName(context.Background(), technology.Name)
+	require.NoError(t, err)
+	require.Equal(t, technology.ID, got.ID)
+}
+
+func testSearchSnippetEscapesHTML(t *testing.T, store db.Store) {
+	cvProfile := createRandomCvProfile(t, store)
+	keyword := utils.RandomString(12)
+
+	name := utils.RandomString(12)
+	_, err := store.CreateSkill(context.Background(), db.CreateSkillParams{
+		Name:          name,
+		Slug:          utils.Slugify(name),
+		Description:   "Used " + keyword + " in <script>alert(1)</script> & production",
+		Category:      utils.RandomString(12),
+		Importance:    1,
+		HexThemeColor: "#000000",
+		CvProfileID:   cvProfile.ID,
+	})
+	require.NoError(t, err)
+
+	// the snippets are rendered as HTML, so the text of the rows must not add markup
+	results, err := store.SearchCvProfile(context.Background(), db.SearchCvProfileParams{CvProfileID: cvProfile.ID, Query: keyword, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Contains(t, results[0].Snippet, "<mark>"+keyword+"</mark>")
+	require.Contains(t, results[0].Snippet, "&lt;script&gt;")
+	require.Contains(t, results[0].Snippet, "&amp;")
+	require.NotContains(t, results[0].Snippet, "<script>")
+}
+
+func testImportCvProfileTxRollback(t *testing.T, store db.Store) {
+	skillName := utils.RandomString(12)
+	email := utils.RandomEmail() + utils.RandomString(6)
+
+	// the second skill takes the name of the first one, so nothing should be created
+	_, err := store.ImportCvProfileTx(context.Background(), db.ImportCvProfileTxParams{
+		CreateCvProfileParams: db.CreateCvProfileParams{Name: utils.RandomString(6), Email: email},
+		Skills: []db.CreateSkillParams{
+			{Name: skillName, Category: utils.RandomString(8), Importance: 1, HexThemeColor: "#000000"},
+			{Name: skillName, Category: utils.RandomString(8), Importance: 1, HexThemeColor: "#000000"},
+		},
+	})
+	requireSkillNameTaken(t, err)
+
+	_, err = store.GetCvProfileByEmail(context.Background(), email)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func testImportCvProfileTxDuplicateNames(t *testing.T, store db.Store) {
+	technologyName := utils.RandomString(12)
+
+	// a technology repeated in the keywords of a project is linked once
+	cvProfile, err := store.ImportCvProfileTx(context.Background(), db.ImportCvProfileTxParams{
+		CreateCvProfileParams: db.CreateCvProfileParams{Name: utils.RandomString(6), Email: utils.RandomEmail() + utils.RandomString(6)},
+		Projects: []db.ImportProjectParams{
+			{
+				CreateProjectParams: db.CreateProjectParams{Title: utils.RandomString(6), Significance: 1},
+				TechnologyNames:     []string{technologyName, technologyName},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	projects, err := store.ListProjectsWithTechnologies(context.Background(), db.ListProjectsWithTechnologiesParams{CvProfileID: cvProfile.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	require.Len(t, projects[0].TechnologiesUsed, 1)
+	require.Equal(t, technologyName, projects[0].TechnologiesUsed[0].Name)
+}
+
+func testSeedCvProfileTx(t *testing.T, store db.Store) {
+	technologyName := utils.RandomString(12)
+	skillName := utils.RandomString(12)
+
+	params := db.SeedCvProfileTxParams{
+		CreateCvProfileParams: db.CreateCvProfileParams{
+			Name:  utils.RandomString(6),
+			Email: utils.RandomEmail() + utils.RandomString(6),
+		},
+		Technologies: []db.CreateTechnologyParams{{Name: technologyName, OrderField: 1}},
+		Skills: []db.CreateSkillParams{
+			{Name: skillName, Category: utils.RandomString(12), Importance: 1, HexThemeColor: "#000000"},
+		},
+		Educations: []db.CreateCvEducationParams{
+			{Institution: utils.RandomString(6), Degree: utils.RandomString(6), StartDate: time.Now()},
+		},
+		Experiences: []db.SeedCvExperienceParams{
+			{
+				CreateCvExperienceParams: db.CreateCvExperienceParams{Company: utils.RandomString(6), Position: utils.RandomString(6), StartDate: time.Now(), Achievements: []string{}},
+				// a repeated name is linked once
+				SkillNames:      []string{skillName, skillName},
+				TechnologyNames: []string{technologyName, technologyName},
+			},
+		},
+		Projects: []db.SeedProjectParams{
+			{
+				CreateProjectParams: db.CreateProjectParams{Title: utils.RandomString(6), Significance: 1},
+				SkillNames:          []string{skillName, skillName},
+				TechnologyNames:     []string{technologyName, technologyName},
+			},
+		},
+	}
+
+	first, err := store.SeedCvProfileTx(context.Background(), params)
+	require.NoError(t, err)
+
+	params.Bio = utils.RandomString(20)
+	second, err := store.SeedCvProfileTx(context.Background(), params)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, second.ID)
+	require.Equal(t, params.Bio, second.Bio)
+
+	projects, err := store.ListProjectsWithTechnologies(context.Background(), db.ListProjectsWithTechnologiesParams{CvProfileID: first.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	require.Len(t, projects[0].TechnologiesUsed, 1)
+
+	experiences, err := store.ListCvExperiencesWithDetails(context.Background(), db.ListCvExperiencesWithDetailsParams{CvProfileID: first.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, experiences, 1)
+	require.Len(t, experiences[0].Skills, 1)
+	require.Len(t, experiences[0].TechnologiesUsed, 1)
+
+	educations, err := store.ListCvEducations(context.Background(), db.ListCvEducationsParams{CvProfileID: first.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, educations, 1)
+
+	// an unknown skill rolls back the whole seed
+	params.Bio = utils.RandomString(20)
+	params.Projects[0].SkillNames = []string{utils.RandomString(12)}
+	_, err = store.SeedCvProfileTx(context.Background(), params)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	cvProfile, err := store.GetCvProfile(context.Background(), first.ID)
+	require.NoError(t, err)
+	require.Equal(t, second.Bio, cvProfile.Bio)
+}
+
+func testSeedCvProfileTxSwapsImportances(t *testing.T, store db.Store) {
+	category := utils.RandomString(12)
+	params := db.SeedCvProfileTxParams{
+		CreateCvProfileParams: db.CreateCvProfileParams{
+			Name:  utils.RandomString(6),
+			Email: utils.RandomEmail() + utils.RandomString(6),
+		},
+		Skills: []db.CreateSkillParams{
+			{Name: utils.RandomString(12), Category: category, Importance: 1, HexThemeColor: "#000000"},
+			{Name: utils.RandomString(12), Category: category, Importance: 2, HexThemeColor: "#000000"},
+		},
+	}
+	cvProfile, err := store.SeedCvProfileTx(context.Background(), params)
+	require.NoError(t, err)
+
+	// the skills are updated one by one, the second one takes the importance that the first one has given up
+	params.Skills[0].Importance, params.Skills[1].Importance = 2, 1
+	_, err = store.SeedCvProfileTx(context.Background(), params)
+	require.NoError(t, err)
+
+	skills, err := store.ListSkillsByCategory(context.Background(), db.ListSkillsByCategoryParams{CvProfileID: cvProfile.ID, Category: category})
+	require.NoError(t, err)
+	require.Len(t, skills, 2)
+	require.Equal(t, params.Skills[1].Name, skills[0].Name)
+	require.Equal(t, int32(1), skills[0].Importance)
+	require.Equal(t, params.Skills[0].Name, skills[1].Name)
+	require.Equal(t, int32(2), skills[1].Importance)
+
+	// equal importances are still rejected, and the released ones are rolled back
+	params.Skills[0].Importance = 1
+	_, err = store.SeedCvProfileTx(context.Background(), params)
+	requireConstraintError(t, err, "unique_violation", "unique_profile_skill_category_importance")
+
+	got, err := store.ListSkillsByCategory(context.Background(), db.ListSkillsByCategoryParams{CvProfileID: cvProfile.ID, Category: category})
+	require.NoError(t, err)
+	require.Equal(t, skills, got)
+}
+
+func testPing(t *testing.T, store db.Store) {
+	err := store.Ping(context.Background())
+	require.NoError(t, err)
+}
+
+func testMigrationVersion(t *testing.T, store db.Store) {
+	version, dirty, err := store.MigrationVersion(context.Background())
+	require.NoError(t, err)
+	require.False(t, dirty)
+	require.Equal(t, int64(migrations.LatestVersion()), version)
+}
+
+// requireConstraintError checks that err is the Postgres error of the broken constraint
+func requireConstraintError(t *testing.T, err error, code, constraint string) {
+	t.Helper()
+
+	var pqErr *pq.Error
+	require.True(t, errors.As(err, &pqErr), "expected a *pq.Error, got %v", err)
+	require.Equal(t, code, pqErr.Code.Name())
+	require.Equal(t, constraint, pqErr.Constraint)
+}
+
+// requireSkillNameTaken checks that err is the violation of a unique skill name. Equal names have equal slugs,
+// so the databases may report either of the constraints, the API returns the same error for both.
+func requireSkillNameTaken(t *testing.T, err error) {
+	t.Helper()
+
+	var pqErr *pq.Error
+	require.True(t, errors.As(err, &pqErr), "expected a *pq.Error, got %v", err)
+	require.Equal(t, "unique_violation", pqErr.Code.Name())
+	require.Contains(t, []string{"unique_profile_skill_name", "unique_profile_skill_slug"}, pqErr.Constraint)
+}
+
+func createRandomCvProfile(t *testing.T, store db.Store) db.CvProfile {
+	cvProfile, err := store.CreateCvProfile(context.Background(), db.CreateCvProfileParams{
+		Name:      utils.RandomString(6),
+		Email:     utils.RandomEmail(),
+		Phone:     utils.RandomString(9),
+		Address:   utils.RandomString(10),
+		GithubUrl: utils.RandomString(10),
+		Bio:       utils.RandomString(50),
+	})
+	require.NoError(t, err)
+	require.NotZero(t, cvProfile.ID)
+	return cvProfile
+}
+
+func createRandomSkill(t *testing.T, store db.Store, cvProfileID int32) db.Skill {
+	name := utils.RandomString(12)
+	skill, err := store.CreateSkill(context.Background(), db.CreateSkillParams{
+		Name:          name,
+		Slug:          utils.Slugify(name),
+		Description:   utils.RandomString(20),
+		Category:      utils.RandomString(12),
+		Importance:    utils.RandomInt(1, 10),
+		HexThemeColor: "#000000",
+		CvProfileID:   cvProfileID,
+	})
+	require.NoError(t, err)
+	return skill
+}
+
+func createRandomProject(t *testing.T, store db.Store, cvProfileID int32) db.Project {
+	project, err := store.CreateProject(context.Background(), db.CreateProjectParams{
+		Title:            utils.RandomString(6),
+		ShortDescription: utils.RandomString(20),
+		Description:      utils.RandomString(50),
+		HexThemeColor:    "#000000",
+		Significance:     utils.RandomInt(1, 10),
+		CvProfileID:      cvProfileID,
+	})
+	require.NoError(t, err)
+	return project
+}
+
+func createRandomTechnology(t *testing.T, store db.Store) db.Technology {
+	technology, err := store.CreateTechnology(context.Background(), db.CreateTechnologyParams{
+		Name:       utils.RandomString(12),
+		Url:        utils.RandomString(10),
+		OrderField: utils.RandomInt(1, 100),
+	})
+	require.NoError(t, err)
+	return technology
+}
+
+func createRandomCvExperience(t *testing.T, store db.Store, cvProfileID int32) db.CvExperience {
+	experience, err := store.CreateCvExperience(context.Background(), db.CreateCvExperienceParams{
+		Company:      utils.RandomString(6),
+		Position:     utils.RandomString(6),
+		StartDate:    time.Now(),
+		Achievements: []string{utils.RandomString(10)},
+		CvProfileID:  cvProfileID,
+	})
+	require.NoError(t, err)
+	return experience
+}
@@ -0,0 +1,40 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// New creates a logger that writes JSON lines to w, messages below the level are dropped.
+// The level is one of "debug", "info", "warn" or "error", an empty level means "info".
+func New(w io.Writer, level string) (*slog.Logger, error) {
+	lvl, err := ParseLevel(level)
+	if err != nil {
+		return nil, err
+	}
+
+	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{Level: lvl})), nil
+}
+
+// ParseLevel converts the name of a level to slog.Level
+func ParseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+
+	return slog.LevelInfo, fmt.Errorf("unsupported log level: %q", level)
+}
+
+// Discard returns a logger that drops all messages, useful in tests
+func Discard() *slog.Logger {
+	return slog.New(slog.NewJSONHandler(io.Discard, nil))
+}
@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"testing"
+)
+
+func TestParseLevel(t *testing.T) {
+	testCases := []struct {
+		level    string
+		expected slog.Level
+	}{
+		{"debug", slog.LevelDebug},
+		{"", slog.LevelInfo},
+		{"info", slog.LevelInfo},
+		{"INFO", slog.LevelInfo},
+		{"warn", slog.LevelWarn},
+		{"warning", slog.LevelWarn},
+		{"error", slog.LevelError},
+	}
+
+	for _, tc := range testCases {
+		level, err := ParseLevel(tc.level)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, level)
+	}
+
+	_, err := ParseLevel("verbose")
+	require.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	var buf bytes.Buffer
+	log, err := New(&buf, "warn")
+	require.NoError(t, err)
+
+	log.Info("dropped")
+	log.Warn("kept", slog.String("request_id", "abc"))
+
+	var line map[string]any
+	err = json.Unmarshal(buf.Bytes(), &line)
+	require.NoError(t, err)
+	require.Equal(t, "WARN", line["level"])
+	require.Equal(t, "kept", line["msg"])
+	require.Equal(t, "abc", line["request_id"])
+
+	_, err = New(&buf, "verbose")
+	require.Error(t, err)
+}
@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMetrics_ObserveRequest(t *testing.T) {
+	m := New()
+	m.ObserveRequest(http.MethodGet, "/api/v1/projects/:id", http.StatusOK, 10*time.Millisecond, 512)
+	m.ObserveRequest(http.MethodGet, "/api/v1/projects/:id", http.StatusOK, 20*time.Millisecond, 256)
+	m.ObserveRequest(http.MethodGet, "/api/v1/projects/:id", http.StatusNotFound, time.Millisecond, 32)
+
+	require.Equal(t, float64(2), testutil.ToFloat64(m.requests.WithLabelValues(http.MethodGet, "/api/v1/projects/:id", "200")))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.requests.WithLabelValues(http.MethodGet, "/api/v1/projects/:id", "404")))
+	require.Equal(t, 2, testutil.CollectAndCount(m.requestDuration))
+	require.Equal(t, 2, testutil.CollectAndCount(m.responseSize))
+}
+
+func TestMetrics_ObserveQuery(t *testing.T) {
+	m := New()
+	m.ObserveQuery("GetProject", time.Millisecond, nil)
+	m.ObserveQuery("GetProject", time.Millisecond, errors.New("connection reset"))
+	m.ObserveQuery("ListSkills", time.Millisecond, nil)
+
+	require.Equal(t, 3, testutil.CollectAndCount(m.queryDuration))
+}
+
+func TestMetrics_Handler(t *testing.T) {
+	m := New()
+	m.ObserveQuery("GetProject", time.Millisecond, nil)
+
+	// sql.Open does not connect, the pool stats are available anyway
+	conn, err := sql.Open("postgres", "postgresql://localhost/cv_db")
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, m.RegisterDB(conn, "cv_db"))
+
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+	m.Handler().ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	body := recorder.Body.String()
+	require.Contains(t, body, `cv_backend_db_query_duration_seconds_count{query="GetProject",result="ok"} 1`)
+	require.Contains(t, body, `go_sql_max_open_connections{db_name="cv_db"}`)
+	require.Contains(t, body, "go_goroutines")
+}
@@ -0,0 +1,350 @@
+package resume
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"strings"
+	"time"
+)
+
+// JSONResumeSchema is the version of the jsonresume.org schema used for the export
+const JSONResumeSchema = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// defaultSkillColor is used for imported skills, as JSON Resume has no colors
+const defaultSkillColor = "#000000"
+
+// JSONResume is a résumé in the jsonresume.org schema, only the sections supported by cv profiles are included
+type JSONResume struct {
+	Schema    string                `json:"$schema,omitempty"`
+	Basics    JSONResumeBasics      `json:"basics"`
+	Work      []JSONResumeWork      `json:"work,omitempty"`
+	Education []JSONResumeEducation `json:"education"`
+	Skills    []JSONResumeSkill     `json:"skills"`
+	Projects  []JSONResumeProject   `json:"projects"`
+}
+
+type JSONResumeBasics struct {
+	Name     string              `json:"name"`
+	Image    string              `json:"image,omitempty"`
+	Email    string              `json:"email"`
+	Phone    string              `json:"phone,omitempty"`
+	Summary  string              `json:"summary,omitempty"`
+	Location JSONResumeLocation  `json:"location"`
+	Profiles []JSONResumeProfile `json:"profiles"`
+}
+
+type JSONResumeLocation struct {
+	Address     string `json:"address,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+	Region      string `json:"region,omitempty"`
+}
+
+type JSONResumeProfile struct {
+	Network string `json:"network"`
+	Url     string `json:"url"`
+}
+
+type JSONResumeWork struct {
+	Name       string   `json:"name"`
+	Position   string   `json:"position"`
+	Location   string   `json:"location,omitempty"`
+	StartDate  string   `json:"startDate"`
+	EndDate    string   `json:"endDate,omitempty"`
+	Highlights []string `json:"highlights"`
+}
+
+type JSONResumeEducation struct {
+	Institution string `json:"institution"`
+	Area        string `json:"area,omitempty"`
+	StudyType   string `json:"studyType"`
+	StartDate   string `json:"startDate"`
+	EndDate     string `json:"endDate,omitempty"`
+}
+
+type JSONResumeSkill struct {
+	Name     string   `json:"name"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords"`
+}
+
+type JSONResumeProject struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Highlights  []string `json:"highlights"`
+	Keywords    []string `json:"keywords"`
+	Url         string   `json:"url,omitempty"`
+}
+
+// jsonResumeDate is the date format used in the export, imports also accept "2006-01" and "2006"
+const jsonResumeDate = "2006-01-02"
+
+// ToJSONResume converts the cv profile data to the JSON Resume schema
+func ToJSONResume(data Data) JSONResume {
+	profile := data.Profile
+	result := JSONResume{
+		Schema: JSONResumeSchema,
+		Basics: JSONResumeBasics{
+			Name:     profile.Name,
+			Image:    profile.ProfilePicture,
+			Email:    profile.Email,
+			Phone:    profile.Phone,
+			Summary:  profile.Bio,
+			Location: JSONResumeLocation{Address: profile.Address},
+			Profiles: []JSONResumeProfile{},
+		},
+		Education: []JSONResumeEducation{},
+		Skills:    []JSONResumeSkill{},
+		Projects:  []JSONResumeProject{},
+	}
+
+	if profile.GithubUrl != "" {
+		result.Basics.Profiles = append(result.Basics.Profiles, JSONResumeProfile{Network: "GitHub", Url: profile.GithubUrl})
+	}
+	if profile.LinkedinUrl.Valid && profile.LinkedinUrl.String != "" {
+		result.Basics.Profiles = append(result.Basics.Profiles, JSONResumeProfile{Network: "LinkedIn", Url: profile.LinkedinUrl.String})
+	}
+
+	for _, experience := range data.Experience {
+		work := JSONResumeWork{
+			Name:       experience.Company,
+			Position:   experience.Position,
+			Location:   experience.Location,
+			StartDate:  experience.StartDate.Format(jsonResumeDate),
+			Highlights: experience.Achievements,
+		}
+		if experience.EndDate != nil {
+			work.EndDate = experience.EndDate.Format(jsonResumeDate)
+		}
+		if work.Highlights == nil {
+			work.Highlights = []string{}
+		}
+		result.Work = append(result.Work, work)
+	}
+
+	for _, education := range data.Education {
+		studyType, area := splitDegree(education.Degree)
+		entry := JSONResumeEducation{
+			Institution: education.Institution,
+			Area:        area,
+			StudyType:   studyType,
+			StartDate:   education.StartDate.Format(jsonResumeDate),
+		}
+		if education.EndDate != nil {
+			entry.EndDate = education.EndDate.Format(jsonResumeDate)
+		}
+		result.Education = append(result.Education, entry)
+	}
+
+	// JSON Resume skills are groups of keywords, which matches skill categories
+	for _, group := range GroupSkillsByCategory(data.Skills) {
+		skill := JSONResumeSkill{Name: group.Category, Level: groupLevel(group.Skills), Keywords: []string{}}
+		for _, s := range group.Skills {
+			skill.Keywords = append(skill.Keywords, s.Name)
+		}
+		result.Skills = append(result.Skills, skill)
+	}
+
+	for _, p := range data.Projects {
+		project := JSONResumeProject{
+			Name:        p.Title,
+			Description: p.ShortDescription,
+			Highlights:  []string{},
+			Keywords:    []string{},
+			Url:         p.ProjectUrl,
+		}
+		if p.Description != "" && p.Description != p.ShortDescription {
+			project.Highlights = append(project.Highlights, p.Description)
+		}
+		for _, technology := range p.TechnologiesUsed {
+			project.Keywords = append(project.Keywords, technology.Name)
+		}
+		result.Projects = append(result.Projects, project)
+	}
+
+	return result
+}
+
+// ImportParams validates the résumé and converts it to the params of db.Store.ImportCvProfileTx
+func (r JSONResume) ImportParams() (db.ImportCvProfileTxParams, error) {
+	basics := r.Basics
+	if strings.TrimSpace(basics.Name) == "" {
+		return db.ImportCvProfileTxParams{}, errors.New("basics.name is required")
+	}
+	if strings.TrimSpace(basics.Email) == "" {
+		return db.ImportCvProfileTxParams{}, errors.New("basics.email is required")
+	}
+
+	params := db.ImportCvProfileTxParams{
+		CreateCvProfileParams: db.CreateCvProfileParams{
+			Name:           basics.Name,
+			Email:          basics.Email,
+			Phone:          basics.Phone,
+			Address:        strings.Join(nonEmpty(basics.Location.Address, basics.Location.City, basics.Location.Region, basics.Location.CountryCode), ", "),
+			Bio:            basics.Summary,
+			ProfilePicture: basics.Image,
+		},
+	}
+
+	for _, profile := range basics.Profiles {
+		switch strings.ToLower(profile.Network) {
+		case "github":
+			params.GithubUrl = profile.Url
+		case "linkedin":
+			params.LinkedinUrl = sql.NullString{String: profile.Url, Valid: profile.Url != ""}
+		}
+	}
+
+	for i, work := range r.Work {
+		if work.Name == "" || work.Position == "" {
+			return db.ImportCvProfileTxParams{}, fmt.Errorf("work[%d]: name and position are required", i)
+		}
+		startDate, err := parseJSONResumeDate(work.StartDate)
+		if err != nil {
+			return db.ImportCvProfileTxParams{}, fmt.Errorf("work[%d].startDate: %w", i, err)
+		}
+
+		var endDate sql.NullTime
+		if work.EndDate != "" {
+			endDate.Time, err = parseJSONResumeDate(work.EndDate)
+			if err != nil {
+				return db.ImportCvProfileTxParams{}, fmt.Errorf("work[%d].endDate: %w", i, err)
+			}
+			endDate.Valid = true
+		}
+
+		achievements := work.Highlights
+		if achievements == nil {
+			achievements = []string{}
+		}
+
+		params.Experiences = append(params.Experiences, db.CreateCvExperienceParams{
+			Company:      work.Name,
+			Position:     work.Position,
+			Location:     work.Location,
+			StartDate:    startDate,
+			EndDate:      endDate,
+			Achievements: achievements,
+		})
+	}
+
+	for i, education := range r.Education {
+		if education.Institution == "" {
+			return db.ImportCvProfileTxParams{}, fmt.Errorf("education[%d]: institution is required", i)
+		}
+		startDate, err := parseJSONResumeDate(education.StartDate)
+		if err != nil {
+			return db.ImportCvProfileTxParams{}, fmt.Errorf("education[%d].startDate: %w", i, err)
+		}
+
+		// an education without an end date is ongoing
+		var endDate *time.Time
+		if education.EndDate != "" {
+			end, err := parseJSONResumeDate(education.EndDate)
+			if err != nil {
+				return db.ImportCvProfileTxParams{}, fmt.Errorf("education[%d].endDate: %w", i, err)
+			}
+			endDate = &end
+		}
+
+		params.Educations = append(params.Educations, db.CreateCvEducationParams{
+			Institution: education.Institution,
+			Degree:      strings.Join(nonEmpty(education.StudyType, education.Area), degreeSeparator),
+			StartDate:   startDate,
+			EndDate:     endDate,
+		})
+	}
+
+	seen := make(map[string]bool)
+	// importances are counted per category, as several skill groups can have the same name
+	importances := make(map[string]int32)
+	for i, skill := range r.Skills {
+		if skill.Name == "" {
+			return db.ImportCvProfileTxParams{}, fmt.Errorf("skills[%d]: name is required", i)
+		}
+
+		// a skill without keywords is a single skill, otherwise it is a category of skills
+		names := db.UniqueNames(skill.Keywords)
+		if len(names) == 0 {
+			names = []string{skill.Name}
+		}
+		for _, name := range names {
+			if seen[name] {
+				// skill names are unique per profile, the first category listing a skill keeps it
+				continue
+			}
+			seen[name] = true
+			importances[skill.Name]++
+			params.Skills = append(params.Skills, db.CreateSkillParams{
+				Name:          name,
+				Description:   skill.Level,
+				Category:      skill.Name,
+				Importance:    importances[skill.Name],
+				HexThemeColor: defaultSkillColor,
+			})
+		}
+	}
+
+	for i, project := range r.Projects {
+		if project.Name == "" {
+			return db.ImportCvProfileTxParams{}, fmt.Errorf("projects[%d]: name is required", i)
+		}
+
+		description := strings.Join(project.Highlights, "\n")
+		if description == "" {
+			description = project.Description
+		}
+
+		params.Projects = append(params.Projects, db.ImportProjectParams{
+			CreateProjectParams: db.CreateProjectParams{
+				Title:            project.Name,
+				ShortDescription: project.Description,
+				Description:      description,
+				ProjectUrl:       project.Url,
+				// projects are ordered by significance, so the order of the file is kept
+				Significance: int32(i + 1),
+			},
+			TechnologyNames: db.UniqueNames(project.Keywords),
+		})
+	}
+
+	return params, nil
+}
+
+// groupLevel returns the level of a skill group, the reverse of the import which gives every skill
+// of the group the level as its description. Groups whose skills have different descriptions have no
+// level, as the descriptions cannot be kept on the group.
+func groupLevel(skills []db.Skill) string {
+	for _, skill := range skills[1:] {
+		if skill.Description != skills[0].Description {
+			return ""
+		}
+	}
+	return skills[0].Description
+}
+
+// degreeSeparator joins the study type and the area of an imported education into the degree
+const degreeSeparator = ", "
+
+// splitDegree splits a degree into the study type and the area, the reverse of the import
+func splitDegree(degree string) (studyType, area string) {
+	studyType, area, _ = strings.Cut(degree, degreeSeparator)
+	return studyType, area
+}
+
+// parseJSONResumeDate parses the ISO 8601 dates of JSON Resume - "2006-01-02", "2006-01" or "2006"
+func parseJSONResumeDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, errors.New("date is required")
+	}
+
+	for _, layout := range []string{jsonResumeDate, "2006-01", "2006"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
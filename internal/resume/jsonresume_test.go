@@ -0,0 +1,263 @@
+package resume
+
+import (
+	"encoding/json"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestToJSONResume(t *testing.T) {
+	data := generateRandomData()
+
+	result := ToJSONResume(data)
+	require.Equal(t, JSONResumeSchema, result.Schema)
+	require.Equal(t, data.Profile.Name, result.Basics.Name)
+	require.Equal(t, data.Profile.Email, result.Basics.Email)
+	require.Equal(t, data.Profile.Bio, result.Basics.Summary)
+	require.Len(t, result.Basics.Profiles, 2)
+
+	require.Len(t, result.Work, len(data.Experience))
+	require.Empty(t, result.Work[0].EndDate)
+	require.Equal(t, data.Experience[1].EndDate.Format("2006-01-02"), result.Work[1].EndDate)
+
+	require.Len(t, result.Education, len(data.Education))
+	require.Equal(t, data.Education[0].Degree, result.Education[0].StudyType)
+
+	// skills are exported as one entry per category
+	require.Len(t, result.Skills, 3)
+	require.Len(t, result.Skills[0].Keywords, 10)
+
+	require.Len(t, result.Projects, len(data.Projects))
+	require.Len(t, result.Projects[0].Keywords, 2)
+}
+
+func TestToJSONResumeEmptyProfile(t *testing.T) {
+	result := ToJSONResume(Data{})
+
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+
+	// empty sections are still arrays, work is left out when there is no experience
+	var got map[string]interface{}
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{}, got["education"])
+	require.Equal(t, []interface{}{}, got["skills"])
+	require.Equal(t, []interface{}{}, got["projects"])
+	require.NotContains(t, got, "work")
+}
+
+func TestJSONResumeImportParams(t *testing.T) {
+	data := generateRandomData()
+
+	params, err := ToJSONResume(data).ImportParams()
+	require.NoError(t, err)
+
+	require.Equal(t, data.Profile.Name, params.Name)
+	require.Equal(t, data.Profile.Email, params.Email)
+	require.Equal(t, data.Profile.GithubUrl, params.GithubUrl)
+	require.Equal(t, data.Profile.LinkedinUrl, params.LinkedinUrl)
+	require.Equal(t, data.Profile.Address, params.Address)
+
+	require.Len(t, params.Experiences, len(data.Experience))
+	require.False(t, params.Experiences[0].EndDate.Valid)
+	require.True(t, params.Experiences[1].EndDate.Valid)
+	require.Equal(t, data.Experience[0].Achievements, params.Experiences[0].Achievements)
+
+	require.Len(t, params.Educations, len(data.Education))
+	require.Len(t, params.Skills, len(data.Skills))
+	for _, skill := range params.Skills {
+		require.NotZero(t, skill.Importance)
+		require.NotEmpty(t, skill.Category)
+	}
+
+	require.Len(t, params.Projects, len(data.Projects))
+	for i, project := range params.Projects {
+		require.Equal(t, data.Projects[i].Title, project.Title)
+		require.Equal(t, int32(i+1), project.Significance)
+		require.Len(t, project.TechnologyNames, 2)
+	}
+}
+
+func TestJSONResumeEducation(t *testing.T) {
+	r := JSONResume{
+		Basics: JSONResumeBasics{Name: "name", Email: "email@example.com"},
+		Education: []JSONResumeEducation{
+			{Institution: "University", StudyType: "Bachelor", Area: "Computer Science", StartDate: "2015", EndDate: "2019"},
+			{Institution: "University", StudyType: "Master", StartDate: "2019"},
+		},
+	}
+
+	params, err := r.ImportParams()
+	require.NoError(t, err)
+	require.Len(t, params.Educations, 2)
+	require.Equal(t, "Bachelor, Computer Science", params.Educations[0].Degree)
+	require.NotNil(t, params.Educations[0].EndDate)
+	// an education without an end date is ongoing
+	require.Nil(t, params.Educations[1].EndDate)
+
+	// the area and the study type are exported again
+	var data Data
+	for _, education := range params.Educations {
+		data.Education = append(data.Education, db.CvEducation{
+			Institution: education.Institution,
+			Degree:      education.Degree,
+			StartDate:   education.StartDate,
+			EndDate:     education.EndDate,
+		})
+	}
+	result := ToJSONResume(data)
+	require.Equal(t, r.Education[0].StudyType, result.Education[0].StudyType)
+	require.Equal(t, r.Education[0].Area, result.Education[0].Area)
+	require.Equal(t, "2019-01-01", result.Education[0].EndDate)
+	require.Equal(t, r.Education[1].StudyType, result.Education[1].StudyType)
+	require.Empty(t, result.Education[1].Area)
+	require.Empty(t, result.Education[1].EndDate)
+}
+
+func TestJSONResumeImportParamsDuplicateNames(t *testing.T) {
+	r := JSONResume{
+		Basics: JSONResumeBasics{Name: "name", Email: "email@example.com"},
+		Skills: []JSONResumeSkill{
+			{Name: "Backend", Keywords: []string{"Go", "SQL", "Go"}},
+			{Name: "Databases", Keywords: []string{"SQL", "Redis"}},
+		},
+		Projects: []JSONResumeProject{{Name: "project", Keywords: []string{"Go", "Docker", "Go"}}},
+	}
+
+	params, err := r.ImportParams()
+	require.NoError(t, err)
+
+	// a skill is kept in the first category listing it, importances have no gaps
+	require.Len(t, params.Skills, 3)
+	require.Equal(t, "Go", params.Skills[0].Name)
+	require.Equal(t, int32(1), params.Skills[0].Importance)
+	require.Equal(t, "SQL", params.Skills[1].Name)
+	require.Equal(t, "Backend", params.Skills[1].Category)
+	require.Equal(t, int32(2), params.Skills[1].Importance)
+	require.Equal(t, "Redis", params.Skills[2].Name)
+	require.Equal(t, "Databases", params.Skills[2].Category)
+	require.Equal(t, int32(1), params.Skills[2].Importance)
+
+	require.Equal(t, []string{"Go", "Docker"}, params.Projects[0].TechnologyNames)
+}
+
+func TestJSONResumeImportParamsDuplicateGroupNames(t *testing.T) {
+	r := JSONResume{
+		Basics: JSONResumeBasics{Name: "name", Email: "email@example.com"},
+		Skills: []JSONResumeSkill{
+			{Name: "Backend", Keywords: []string{"Go", "SQL"}},
+			{Name: "Frontend", Keywords: []string{"React"}},
+			{Name: "Backend", Keywords: []string{"Redis", "Go"}},
+		},
+	}
+
+	params, err := r.ImportParams()
+	require.NoError(t, err)
+
+	// groups with the same name make up one category, so the importances continue instead of starting over
+	require.Len(t, params.Skills, 4)
+	importances := make(map[string]int32)
+	for _, skill := range params.Skills {
+		if skill.Category == "Backend" {
+			importances[skill.Name] = skill.Importance
+		}
+	}
+	require.Equal(t, map[string]int32{"Go": 1, "SQL": 2, "Redis": 3}, importances)
+}
+
+func TestJSONResumeImportParamsSkillWithoutKeywords(t *testing.T) {
+	r := JSONResume{
+		Basics: JSONResumeBasics{Name: "name", Email: "email@example.com"},
+		Skills: []JSONResumeSkill{{Name: "Go", Level: "Expert"}},
+	}
+
+	params, err := r.ImportParams()
+	require.NoError(t, err)
+	require.Len(t, params.Skills, 1)
+	require.Equal(t, "Go", params.Skills[0].Name)
+	require.Equal(t, "Expert", params.Skills[0].Description)
+}
+
+func TestJSONResumeSkillLevelRoundTrip(t *testing.T) {
+	r := JSONResume{
+		Basics: JSONResumeBasics{Name: "name", Email: "email@example.com"},
+		Skills: []JSONResumeSkill{
+			{Name: "Backend", Level: "Master", Keywords: []string{"Go", "SQL"}},
+			{Name: "Frontend", Keywords: []string{"React"}},
+			{Name: "Docker", Level: "Intermediate"},
+		},
+	}
+
+	params, err := r.ImportParams()
+	require.NoError(t, err)
+
+	var data Data
+	for _, skill := range params.Skills {
+		data.Skills = append(data.Skills, db.Skill{
+			Name:        skill.Name,
+			Description: skill.Description,
+			Category:    skill.Category,
+			Importance:  skill.Importance,
+		})
+	}
+
+	result := ToJSONResume(data)
+	require.Len(t, result.Skills, 3)
+	require.Equal(t, "Master", result.Skills[0].Level)
+	require.Empty(t, result.Skills[1].Level)
+	require.Equal(t, "Intermediate", result.Skills[2].Level)
+
+	// skills with different descriptions leave the level of their group empty
+	data.Skills[1].Description = "Expert"
+	require.Empty(t, ToJSONResume(data).Skills[0].Level)
+}
+
+func TestJSONResumeImportParamsInvalid(t *testing.T) {
+	basics := JSONResumeBasics{Name: "name", Email: "email@example.com"}
+
+	testCases := []struct {
+		name   string
+		resume JSONResume
+	}{
+		{name: "Missing Name", resume: JSONResume{Basics: JSONResumeBasics{Email: basics.Email}}},
+		{name: "Missing Email", resume: JSONResume{Basics: JSONResumeBasics{Name: basics.Name}}},
+		{
+			name: "Invalid Work Date",
+			resume: JSONResume{Basics: basics, Work: []JSONResumeWork{
+				{Name: "company", Position: "position", StartDate: "2020-13-01"},
+			}},
+		},
+		{
+			name: "Invalid Education End Date",
+			resume: JSONResume{Basics: basics, Education: []JSONResumeEducation{
+				{Institution: "institution", StartDate: "2020", EndDate: "soon"},
+			}},
+		},
+		{name: "Missing Project Name", resume: JSONResume{Basics: basics, Projects: []JSONResumeProject{{}}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.resume.ImportParams()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParseJSONResumeDate(t *testing.T) {
+	for value, expected := range map[string]time.Time{
+		"2021-03-15": time.Date(2021, 3, 15, 0, 0, 0, 0, time.UTC),
+		"2021-03":    time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		"2021":       time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		got, err := parseJSONResumeDate(value)
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+	}
+
+	_, err := parseJSONResumeDate("15.03.2021")
+	require.Error(t, err)
+}
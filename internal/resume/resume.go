@@ -0,0 +1,239 @@
+package resume
+
+import (
+	_ "embed"
+	"fmt"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/go-pdf/fpdf"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TemplateClassic = "classic"
+	TemplateModern  = "modern"
+)
+
+// Data holds everything that is rendered into a résumé
+type Data struct {
+	Profile    db.CvProfile
+	Education  []db.CvEducation
+	Experience []db.ListCvExperiencesWithDetailsRow
+	Skills     []db.Skill
+	Projects   []db.ListProjectsWithTechnologiesRow
+}
+
+// SkillGroup is a list of skills that share the same category
+type SkillGroup struct {
+	Category string
+	Skills   []db.Skill
+}
+
+// fontFamily is the embedded TrueType font, unlike the core PDF fonts it covers all of Unicode that
+// profiles are likely to use (Latin Extended, Greek, Cyrillic) and not just cp1252
+const fontFamily = "DejaVu"
+
+var (
+	//go:embed fonts/DejaVuSansCondensed.ttf
+	fontRegular []byte
+	//go:embed fonts/DejaVuSansCondensed-Bold.ttf
+	fontBold []byte
+	//go:embed fonts/DejaVuSansCondensed-Oblique.ttf
+	fontItalic []byte
+)
+
+// layout draws the résumé data on an empty document
+type layout func(doc *document, data Data)
+
+var layouts = map[string]layout{
+	TemplateClassic: renderClassic,
+	TemplateModern:  renderModern,
+}
+
+// Templates returns the names of all built-in templates
+func Templates() []string {
+	return []string{TemplateClassic, TemplateModern}
+}
+
+// Render writes the résumé as a PDF to w, using the given template - "classic" (the default) or "modern"
+func Render(w io.Writer, templateName string, data Data) error {
+	if templateName == "" {
+		templateName = TemplateClassic
+	}
+
+	render, ok := layouts[templateName]
+	if !ok {
+		return fmt.Errorf("unsupported resume template: %q", templateName)
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle(data.Profile.Name, true)
+	pdf.SetCreator("cv-backend-go", false)
+	// keep the output reproducible for the same data
+	pdf.SetCreationDate(data.Profile.CreatedAt)
+	pdf.SetModificationDate(data.Profile.CreatedAt)
+	pdf.SetCatalogSort(true)
+	pdf.SetMargins(18, 16, 18)
+	pdf.SetAutoPageBreak(true, 16)
+	pdf.AddUTF8FontFromBytes(fontFamily, "", fontRegular)
+	pdf.AddUTF8FontFromBytes(fontFamily, "B", fontBold)
+	pdf.AddUTF8FontFromBytes(fontFamily, "I", fontItalic)
+	pdf.AddPage()
+
+	render(&document{Fpdf: pdf}, data)
+
+	if err := pdf.Error(); err != nil {
+		return fmt.Errorf("cannot render resume: %w", err)
+	}
+
+	return pdf.Output(w)
+}
+
+// GroupSkillsByCategory groups skills by their category, keeping the order in which categories first appear
+func GroupSkillsByCategory(skills []db.Skill) []SkillGroup {
+	var groups []SkillGroup
+	indexes := make(map[string]int)
+
+	for _, skill := range skills {
+		i, ok := indexes[skill.Category]
+		if !ok {
+			i = len(groups)
+			indexes[skill.Category] = i
+			groups = append(groups, SkillGroup{Category: skill.Category})
+		}
+		groups[i].Skills = append(groups[i].Skills, skill)
+	}
+
+	return groups
+}
+
+// color is an RGB color used in the document
+type color struct {
+	r, g, b int
+}
+
+var (
+	black     = color{0, 0, 0}
+	darkGray  = color{70, 70, 70}
+	lightGray = color{140, 140, 140}
+	white     = color{255, 255, 255}
+	slate     = color{44, 62, 80}
+)
+
+// parseHexColor parses colors in the "#RRGGBB" or "#RGB" form, the leading "#" is optional
+func parseHexColor(hex string) (color, bool) {
+	hex = strings.TrimPrefix(strings.TrimSpace(hex), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return color{}, false
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color{}, false
+	}
+
+	return color{int(value >> 16 & 0xff), int(value >> 8 & 0xff), int(value & 0xff)}, true
+}
+
+// hexColorOr returns the parsed hex color or the fallback when the value is not a valid color
+func hexColorOr(hex string, fallback color) color {
+	if c, ok := parseHexColor(hex); ok {
+		return c
+	}
+	return fallback
+}
+
+// formatPeriod formats a date range for the résumé, a nil end means that it is still ongoing
+func formatPeriod(start time.Time, end *time.Time) string {
+	if end == nil {
+		return start.Format("Jan 2006") + " - Present"
+	}
+	return start.Format("Jan 2006") + " - " + end.Format("Jan 2006")
+}
+
+// contactDetails returns the non-empty contact details of the profile
+func contactDetails(profile db.CvProfile) []string {
+	return nonEmpty(profile.Email, profile.Phone, profile.Address, profile.GithubUrl, profile.LinkedinUrl.String)
+}
+
+// nonEmpty returns only the values that are not empty
+func nonEmpty(values ...string) []string {
+	var result []string
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// technologyNames returns the names of the technologies joined with commas
+func technologyNames(technologies []db.ListTechnologiesForProjectRow) string {
+	names := make([]string, 0, len(technologies))
+	for _, technology := range technologies {
+		names = append(names, technology.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// document wraps fpdf with the helpers shared by all layouts
+type document struct {
+	*fpdf.Fpdf
+}
+
+func (d *document) color(c color) {
+	d.SetTextColor(c.r, c.g, c.b)
+}
+
+// width returns the width of the printable area
+func (d *document) width() float64 {
+	pageWidth, _ := d.GetPageSize()
+	left, _, right, _ := d.GetMargins()
+	return pageWidth - left - right
+}
+
+// text writes a line of text in the given style
+func (d *document) text(family, style string, size float64, c color, height float64, txt string) {
+	d.SetFont(family, style, size)
+	d.color(c)
+	d.CellFormat(0, height, txt, "", 1, "L", false, 0, "")
+}
+
+// paragraph writes a wrapped block of text in the given style
+func (d *document) paragraph(family, style string, size float64, c color, height float64, txt string) {
+	d.SetFont(family, style, size)
+	d.color(c)
+	d.MultiCell(0, height, txt, "", "L", false)
+}
+
+// row writes the left text and the right-aligned text on the same line
+func (d *document) row(family string, size float64, left, right string) {
+	d.SetFont(family, "", size)
+	d.color(lightGray)
+	rightWidth := d.GetStringWidth(right) + 1
+
+	d.SetFont(family, "B", size)
+	d.color(black)
+	d.CellFormat(d.width()-rightWidth, 5.5, left, "", 0, "L", false, 0, "")
+
+	d.SetFont(family, "", size)
+	d.color(lightGray)
+	d.CellFormat(rightWidth, 5.5, right, "", 1, "R", false, 0, "")
+}
+
+// bullets writes a bulleted list
+func (d *document) bullets(family string, size float64, items []string) {
+	left, _, _, _ := d.GetMargins()
+	d.SetFont(family, "", size)
+	d.color(darkGray)
+	for _, item := range items {
+		d.SetX(left + 3)
+		d.CellFormat(4, 5, "•", "", 0, "L", false, 0, "")
+		d.MultiCell(d.width()-7, 5, item, "", "L", false)
+	}
+}
@@ -0,0 +1,177 @@
+package resume
+
+import (
+	"bytes"
+	"database/sql"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRender(t *testing.T) {
+	data := generateRandomData()
+
+	for _, templateName := range append(Templates(), "") {
+		t.Run(templateName, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Render(&buf, templateName, data)
+			require.NoError(t, err)
+			require.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+
+			// the same data always gives the same document
+			var buf2 bytes.Buffer
+			err = Render(&buf2, templateName, data)
+			require.NoError(t, err)
+			require.Equal(t, buf.Bytes(), buf2.Bytes())
+		})
+	}
+}
+
+func TestRenderEmptyProfile(t *testing.T) {
+	var buf bytes.Buffer
+	err := Render(&buf, TemplateModern, Data{Profile: db.CvProfile{Name: utils.RandomString(6)}})
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+}
+
+func TestRenderNonLatin1Text(t *testing.T) {
+	data := Data{
+		Profile: db.CvProfile{
+			Name: "Łukasz Żółć",
+			Bio:  "Разработчик, Αθήνα, Dvořák",
+		},
+		Skills: []db.Skill{{Name: "Gö ∑", Category: "Инструменты", HexThemeColor: "#00ADD8"}},
+	}
+
+	for _, templateName := range Templates() {
+		t.Run(templateName, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Render(&buf, templateName, data)
+			require.NoError(t, err)
+			require.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+			// the text is drawn with the embedded font instead of the cp1252 core fonts
+			require.Contains(t, buf.String(), "/FontFile2")
+			require.NotContains(t, buf.String(), "/BaseFont /Helvetica")
+		})
+	}
+}
+
+func TestRenderUnknownTemplate(t *testing.T) {
+	var buf bytes.Buffer
+	err := Render(&buf, "fancy", generateRandomData())
+	require.Error(t, err)
+	require.Zero(t, buf.Len())
+}
+
+func TestGroupSkillsByCategory(t *testing.T) {
+	skills := []db.Skill{
+		{ID: 1, Name: "Go", Category: "Backend"},
+		{ID: 2, Name: "React", Category: "Frontend"},
+		{ID: 3, Name: "PostgreSQL", Category: "Backend"},
+	}
+
+	groups := GroupSkillsByCategory(skills)
+	require.Len(t, groups, 2)
+	require.Equal(t, "Backend", groups[0].Category)
+	require.Equal(t, []db.Skill{skills[0], skills[2]}, groups[0].Skills)
+	require.Equal(t, "Frontend", groups[1].Category)
+	require.Equal(t, []db.Skill{skills[1]}, groups[1].Skills)
+
+	require.Empty(t, GroupSkillsByCategory(nil))
+}
+
+func TestParseHexColor(t *testing.T) {
+	testCases := []struct {
+		hex   string
+		color color
+		ok    bool
+	}{
+		{hex: "#00ADD8", color: color{0, 173, 216}, ok: true},
+		{hex: "00add8", color: color{0, 173, 216}, ok: true},
+		{hex: "#fff", color: color{255, 255, 255}, ok: true},
+		{hex: "#12345", ok: false},
+		{hex: "#gggggg", ok: false},
+		{hex: "", ok: false},
+	}
+
+	for _, tc := range testCases {
+		c, ok := parseHexColor(tc.hex)
+		require.Equal(t, tc.ok, ok, tc.hex)
+		require.Equal(t, tc.color, c, tc.hex)
+	}
+}
+
+// generateRandomData generates résumé data with every section filled in
+func generateRandomData() Data {
+	profile := db.CvProfile{
+		ID:      utils.RandomInt(1, 1000),
+		Name:    "Zoë " + utils.RandomString(6),
+		Email:   utils.RandomEmail(),
+		Phone:   utils.RandomString(9),
+		Address: utils.RandomString(6),
+		LinkedinUrl: sql.NullString{
+			String: utils.RandomString(10),
+			Valid:  true,
+		},
+		GithubUrl: utils.RandomString(10),
+		Bio:       utils.RandomString(300),
+		CreatedAt: time.Now(),
+	}
+
+	end := time.Now().Add(-time.Hour * 24 * 365)
+	data := Data{
+		Profile: profile,
+		Education: []db.CvEducation{
+			{
+				Institution: utils.RandomString(8),
+				Degree:      utils.RandomString(8),
+				StartDate:   time.Now().Add(-time.Hour * 24 * 365 * 5),
+				EndDate:     &end,
+			},
+		},
+		Experience: []db.ListCvExperiencesWithDetailsRow{
+			{
+				Company:        utils.RandomString(6),
+				Position:       utils.RandomString(8),
+				Location:       utils.RandomString(5),
+				EmploymentType: "full-time",
+				StartDate:      end,
+				Achievements:   []string{utils.RandomString(150), utils.RandomString(20)},
+			},
+			{
+				Company:      utils.RandomString(6),
+				Position:     utils.RandomString(8),
+				StartDate:    end.Add(-time.Hour * 24 * 365),
+				EndDate:      &end,
+				Achievements: []string{},
+			},
+		},
+	}
+
+	for i := 0; i < 30; i++ {
+		data.Skills = append(data.Skills, db.Skill{
+			ID:            int32(i),
+			Name:          utils.RandomString(int(utils.RandomInt(3, 12))),
+			Category:      []string{"Backend", "Frontend", "Tools"}[i%3],
+			HexThemeColor: []string{"#00ADD8", "#61dafb", "invalid"}[i%3],
+		})
+	}
+
+	for i := 0; i < 5; i++ {
+		data.Projects = append(data.Projects, db.ListProjectsWithTechnologiesRow{
+			ID:               int32(i),
+			Title:            utils.RandomString(8),
+			ShortDescription: utils.RandomString(200),
+			HexThemeColor:    "#336699",
+			ProjectUrl:       utils.RandomString(12),
+			TechnologiesUsed: []db.ListTechnologiesForProjectRow{
+				{ID: 1, Name: utils.RandomString(5)},
+				{ID: 2, Name: utils.RandomString(5)},
+			},
+		})
+	}
+
+	return data
+}
@@ -0,0 +1,165 @@
+package resume
+
+import (
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"strings"
+)
+
+// renderClassic renders a single column, black and white résumé with underlined section headings.
+// Only the skills and their categories are colored, with the theme color of the skills.
+func renderClassic(d *document, data Data) {
+	const family = fontFamily
+	profile := data.Profile
+
+	d.text(family, "B", 22, black, 10, profile.Name)
+	d.text(family, "", 10, lightGray, 5, strings.Join(contactDetails(profile), "  |  "))
+	if profile.Bio != "" {
+		d.Ln(2)
+		d.paragraph(family, "", 11, darkGray, 5, profile.Bio)
+	}
+
+	heading := func(title string) {
+		d.Ln(4)
+		d.text(family, "B", 13, black, 7, strings.ToUpper(title))
+		left, _, _, _ := d.GetMargins()
+		y := d.GetY()
+		d.SetDrawColor(black.r, black.g, black.b)
+		d.Line(left, y, left+d.width(), y)
+		d.Ln(2)
+	}
+
+	renderSections(d, data, family, heading, skillList)
+}
+
+// renderModern renders a résumé with a colored header band.
+// Skills are drawn as badges filled with the theme color of each skill.
+func renderModern(d *document, data Data) {
+	const family = fontFamily
+	profile := data.Profile
+
+	pageWidth, _ := d.GetPageSize()
+	d.SetFillColor(slate.r, slate.g, slate.b)
+	d.Rect(0, 0, pageWidth, 40, "F")
+
+	d.SetY(12)
+	d.text(family, "B", 24, white, 11, profile.Name)
+	d.text(family, "", 9, white, 5, strings.Join(contactDetails(profile), "   "))
+	d.SetY(46)
+
+	if profile.Bio != "" {
+		d.paragraph(family, "", 10, darkGray, 5, profile.Bio)
+	}
+
+	heading := func(title string) {
+		d.Ln(4)
+		left, _, _, _ := d.GetMargins()
+		y := d.GetY()
+		d.SetFillColor(slate.r, slate.g, slate.b)
+		d.Rect(left, y+1, 1.5, 5, "F")
+		d.SetX(left + 4)
+		d.text(family, "B", 13, slate, 7, title)
+		d.Ln(1)
+	}
+
+	renderSections(d, data, family, heading, skillBadges)
+}
+
+// renderSections renders the résumé sections below the header, in the font family of the template
+func renderSections(d *document, data Data, family string, heading func(title string), skills func(d *document, family string, skills []db.Skill)) {
+	if len(data.Experience) > 0 {
+		heading("Experience")
+		for _, experience := range data.Experience {
+			d.row(family, 11, experience.Position+", "+experience.Company, formatPeriod(experience.StartDate, experience.EndDate))
+			d.text(family, "I", 10, lightGray, 5, strings.Join(nonEmpty(experience.Location, experience.EmploymentType), ", "))
+			d.bullets(family, 10, experience.Achievements)
+			d.Ln(2)
+		}
+	}
+
+	if len(data.Education) > 0 {
+		heading("Education")
+		for _, education := range data.Education {
+			d.row(family, 11, education.Degree, formatPeriod(education.StartDate, education.EndDate))
+			d.text(family, "I", 10, lightGray, 5, education.Institution)
+			d.Ln(2)
+		}
+	}
+
+	if len(data.Skills) > 0 {
+		heading("Skills")
+		for _, group := range GroupSkillsByCategory(data.Skills) {
+			// categories have no color of their own in the résumé data, the first (most important) skill sets it
+			d.text(family, "B", 10, hexColorOr(group.Skills[0].HexThemeColor, darkGray), 6, group.Category)
+			skills(d, family, group.Skills)
+			d.Ln(2)
+		}
+	}
+
+	if len(data.Projects) > 0 {
+		heading("Projects")
+		for _, project := range data.Projects {
+			d.text(family, "B", 11, hexColorOr(project.HexThemeColor, black), 5.5, project.Title)
+			d.paragraph(family, "", 10, darkGray, 5, project.ShortDescription)
+			if technologies := technologyNames(project.TechnologiesUsed); technologies != "" {
+				d.text(family, "I", 9, lightGray, 5, technologies)
+			}
+			if project.ProjectUrl != "" {
+				d.text(family, "", 9, lightGray, 5, project.ProjectUrl)
+			}
+			d.Ln(2)
+		}
+	}
+}
+
+// skillList writes the skills one after another, each name in the theme color of the skill
+func skillList(d *document, family string, skills []db.Skill) {
+	for i, skill := range skills {
+		d.SetFont(family, "B", 10)
+		d.color(hexColorOr(skill.HexThemeColor, black))
+		d.Write(5, skill.Name)
+
+		if i < len(skills)-1 {
+			d.SetFont(family, "", 10)
+			d.color(lightGray)
+			d.Write(5, "  /  ")
+		}
+	}
+	d.Ln(5)
+}
+
+// skillBadges writes the skills as badges filled with the theme color of each skill
+func skillBadges(d *document, family string, skills []db.Skill) {
+	const (
+		height  = 6
+		padding = 2.5
+		gap     = 2
+	)
+
+	left, _, _, _ := d.GetMargins()
+	right := left + d.width()
+	d.SetFont(family, "B", 9)
+
+	for _, skill := range skills {
+		width := d.GetStringWidth(skill.Name) + 2*padding
+		if d.GetX()+width > right {
+			d.Ln(height + gap)
+		}
+
+		fill := hexColorOr(skill.HexThemeColor, slate)
+		d.SetFillColor(fill.r, fill.g, fill.b)
+		d.color(contrastColor(fill))
+		d.CellFormat(width, height, skill.Name, "", 0, "C", true, 0, "")
+		d.SetX(d.GetX() + gap)
+	}
+	d.Ln(height)
+}
+
+// contrastColor returns black or white, whichever is more readable on the background
+func contrastColor(background color) color {
+	// relative luminance approximation, see https://www.w3.org/TR/AERT/#color-contrast
+	luminance := (299*background.r + 587*background.g + 114*background.b) / 1000
+	if luminance > 150 {
+		return black
+	}
+	return white
+}
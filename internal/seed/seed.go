@@ -0,0 +1,305 @@
+package seed
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	db "github.com/aalug/cv-backend-go/internal/db/sqlc"
+	"gopkg.in/yaml.v3"
+	"io"
+	"strings"
+	"time"
+)
+
+// dateLayout is the format of dates in seed files, "2006-01" is accepted as well
+const dateLayout = "2006-01-02"
+
+// File is a complete cv profile as written in a seed file.
+// Projects and experiences reference skills and technologies by name.
+type File struct {
+	Profile      Profile      `yaml:"profile"`
+	Technologies []Technology `yaml:"technologies"`
+	Skills       []Skill      `yaml:"skills"`
+	Education    []Education  `yaml:"education"`
+	Experience   []Experience `yaml:"experience"`
+	Projects     []Project    `yaml:"projects"`
+}
+
+type Profile struct {
+	Name           string `yaml:"name"`
+	Email          string `yaml:"email"`
+	Phone          string `yaml:"phone"`
+	Address        string `yaml:"address"`
+	LinkedinUrl    string `yaml:"linkedin_url"`
+	GithubUrl      string `yaml:"github_url"`
+	Bio            string `yaml:"bio"`
+	ProfilePicture string `yaml:"profile_picture"`
+}
+
+type Technology struct {
+	Name string `yaml:"name"`
+	Url  string `yaml:"url"`
+	// Order defaults to the position in the file
+	Order int32 `yaml:"order"`
+}
+
+type Skill struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Category    string `yaml:"category"`
+	// Importance defaults to the position in the category
+	Importance    int32  `yaml:"importance"`
+	Image         string `yaml:"image"`
+	HexThemeColor string `yaml:"hex_theme_color"`
+}
+
+type Education struct {
+	Institution string `yaml:"institution"`
+	Degree      string `yaml:"degree"`
+	StartDate   string `yaml:"start_date"`
+	// EndDate is empty for an ongoing education
+	EndDate string `yaml:"end_date"`
+}
+
+type Experience struct {
+	Company        string `yaml:"company"`
+	Position       string `yaml:"position"`
+	Location       string `yaml:"location"`
+	EmploymentType string `yaml:"employment_type"`
+	StartDate      string `yaml:"start_date"`
+	// EndDate is empty for the current job
+	EndDate      string   `yaml:"end_date"`
+	Achievements []string `yaml:"achievements"`
+	Skills       []string `yaml:"skills"`
+	Technologies []string `yaml:"technologies"`
+}
+
+type Project struct {
+	Title            string `yaml:"title"`
+	ShortDescription string `yaml:"short_description"`
+	Description      string `yaml:"description"`
+	Image            string `yaml:"image"`
+	HexThemeColor    string `yaml:"hex_theme_color"`
+	ProjectUrl       string `yaml:"project_url"`
+	// Significance defaults to the position in the file
+	Significance int32    `yaml:"significance"`
+	Skills       []string `yaml:"skills"`
+	Technologies []string `yaml:"technologies"`
+}
+
+// Parse reads a seed file, unknown fields are an error so that typos are not silently ignored
+func Parse(r io.Reader) (File, error) {
+	var file File
+
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		if errors.Is(err, io.EOF) {
+			return File{}, errors.New("seed file is empty")
+		}
+		return File{}, err
+	}
+
+	return file, nil
+}
+
+// Params validates the file and converts it to the params of db.Store.SeedCvProfileTx.
+// Skills referenced by projects and experiences have to be listed in the file, technologies
+// can also be ones that already exist in the database.
+func (f File) Params() (db.SeedCvProfileTxParams, error) {
+	profile := f.Profile
+	if strings.TrimSpace(profile.Name) == "" {
+		return db.SeedCvProfileTxParams{}, errors.New("profile.name is required")
+	}
+	if strings.TrimSpace(profile.Email) == "" {
+		return db.SeedCvProfileTxParams{}, errors.New("profile.email is required")
+	}
+
+	params := db.SeedCvProfileTxParams{
+		CreateCvProfileParams: db.CreateCvProfileParams{
+			Name:           profile.Name,
+			Email:          profile.Email,
+			Phone:          profile.Phone,
+			Address:        profile.Address,
+			LinkedinUrl:    sql.NullString{String: profile.LinkedinUrl, Valid: profile.LinkedinUrl != ""},
+			GithubUrl:      profile.GithubUrl,
+			Bio:            profile.Bio,
+			ProfilePicture: profile.ProfilePicture,
+		},
+	}
+
+	technologies := make(map[string]bool)
+	for i, technology := range f.Technologies {
+		if technology.Name == "" {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("technologies[%d]: name is required", i)
+		}
+		if technologies[technology.Name] {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("technologies[%d]: duplicate name %q", i, technology.Name)
+		}
+		technologies[technology.Name] = true
+
+		order := technology.Order
+		if order == 0 {
+			order = int32(i + 1)
+		}
+		params.Technologies = append(params.Technologies, db.CreateTechnologyParams{
+			Name:       technology.Name,
+			Url:        technology.Url,
+			OrderField: order,
+		})
+	}
+
+	skills := make(map[string]bool)
+	positions := make(map[string]int32)
+	for i, skill := range f.Skills {
+		if skill.Name == "" || skill.Category == "" {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("skills[%d]: name and category are required", i)
+		}
+		if skills[skill.Name] {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("skills[%d]: duplicate name %q", i, skill.Name)
+		}
+		skills[skill.Name] = true
+
+		positions[skill.Category]++
+		importance := skill.Importance
+		if importance == 0 {
+			importance = positions[skill.Category]
+		}
+		params.Skills = append(params.Skills, db.CreateSkillParams{
+			Name:          skill.Name,
+			Description:   skill.Description,
+			Category:      skill.Category,
+			Importance:    importance,
+			Image:         skill.Image,
+			HexThemeColor: skill.HexThemeColor,
+		})
+	}
+
+	for i, education := range f.Education {
+		if education.Institution == "" || education.Degree == "" {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("education[%d]: institution and degree are required", i)
+		}
+		startDate, err := parseDate(education.StartDate)
+		if err != nil {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("education[%d].start_date: %w", i, err)
+		}
+
+		var endDate *time.Time
+		if education.EndDate != "" {
+			end, err := parseDate(education.EndDate)
+			if err != nil {
+				return db.SeedCvProfileTxParams{}, fmt.Errorf("education[%d].end_date: %w", i, err)
+			}
+			endDate = &end
+		}
+
+		params.Educations = append(params.Educations, db.CreateCvEducationParams{
+			Institution: education.Institution,
+			Degree:      education.Degree,
+			StartDate:   startDate,
+			EndDate:     endDate,
+		})
+	}
+
+	for i, experience := range f.Experience {
+		if experience.Company == "" || experience.Position == "" {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("experience[%d]: company and position are required", i)
+		}
+		startDate, err := parseDate(experience.StartDate)
+		if err != nil {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("experience[%d].start_date: %w", i, err)
+		}
+
+		var endDate sql.NullTime
+		if experience.EndDate != "" {
+			endDate.Time, err = parseDate(experience.EndDate)
+			if err != nil {
+				return db.SeedCvProfileTxParams{}, fmt.Errorf("experience[%d].end_date: %w", i, err)
+			}
+			endDate.Valid = true
+		}
+
+		if err := checkSkills(skills, experience.Skills); err != nil {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("experience[%d].skills: %w", i, err)
+		}
+
+		achievements := experience.Achievements
+		if achievements == nil {
+			achievements = []string{}
+		}
+
+		params.Experiences = append(params.Experiences, db.SeedCvExperienceParams{
+			CreateCvExperienceParams: db.CreateCvExperienceParams{
+				Company:        experience.Company,
+				Position:       experience.Position,
+				Location:       experience.Location,
+				EmploymentType: experience.EmploymentType,
+				StartDate:      startDate,
+				EndDate:        endDate,
+				Achievements:   achievements,
+			},
+			SkillNames:      experience.Skills,
+			TechnologyNames: experience.Technologies,
+		})
+	}
+
+	titles := make(map[string]bool)
+	for i, project := range f.Projects {
+		if project.Title == "" {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("projects[%d]: title is required", i)
+		}
+		if titles[project.Title] {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("projects[%d]: duplicate title %q", i, project.Title)
+		}
+		titles[project.Title] = true
+
+		if err := checkSkills(skills, project.Skills); err != nil {
+			return db.SeedCvProfileTxParams{}, fmt.Errorf("projects[%d].skills: %w", i, err)
+		}
+
+		significance := project.Significance
+		if significance == 0 {
+			significance = int32(i + 1)
+		}
+		params.Projects = append(params.Projects, db.SeedProjectParams{
+			CreateProjectParams: db.CreateProjectParams{
+				Title:            project.Title,
+				ShortDescription: project.ShortDescription,
+				Description:      project.Description,
+				Image:            project.Image,
+				HexThemeColor:    project.HexThemeColor,
+				ProjectUrl:       project.ProjectUrl,
+				Significance:     significance,
+			},
+			SkillNames:      project.Skills,
+			TechnologyNames: project.Technologies,
+		})
+	}
+
+	return params, nil
+}
+
+// checkSkills returns an error for the first name that is not one of the skills of the file
+func checkSkills(skills map[string]bool, names []string) error {
+	for _, name := range names {
+		if !skills[name] {
+			return fmt.Errorf("unknown skill %q", name)
+		}
+	}
+	return nil
+}
+
+// parseDate parses the dates of seed files - "2006-01-02" or "2006-01"
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, errors.New("date is required")
+	}
+
+	for _, layout := range []string{dateLayout, "2006-01"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
@@ -0,0 +1,187 @@
+package seed
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseExample(t *testing.T) {
+	f, err := os.Open("../../seed.example.yaml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	file, err := Parse(f)
+	require.NoError(t, err)
+
+	params, err := file.Params()
+	require.NoError(t, err)
+
+	require.Equal(t, "jane.doe@example.com", params.Email)
+	require.True(t, params.LinkedinUrl.Valid)
+	require.Len(t, params.Technologies, 3)
+	require.Len(t, params.Skills, 3)
+	require.Len(t, params.Educations, 2)
+	require.Len(t, params.Experiences, 1)
+	require.Len(t, params.Projects, 1)
+
+	// defaults follow the order of the file
+	require.Equal(t, int32(3), params.Technologies[2].OrderField)
+	require.Equal(t, int32(1), params.Skills[0].Importance)
+	require.Equal(t, int32(2), params.Skills[1].Importance)
+	require.Equal(t, int32(1), params.Skills[2].Importance)
+	require.Equal(t, int32(1), params.Projects[0].Significance)
+
+	require.NotNil(t, params.Educations[0].EndDate)
+	require.Nil(t, params.Educations[1].EndDate)
+
+	experience := params.Experiences[0]
+	require.Equal(t, time.Date(2018, 7, 1, 0, 0, 0, 0, time.UTC), experience.StartDate)
+	require.False(t, experience.EndDate.Valid)
+	require.Equal(t, []string{"Go", "SQL"}, experience.SkillNames)
+	require.Equal(t, []string{"Go", "SQL", "Docker"}, params.Projects[0].SkillNames)
+	require.Equal(t, []string{"Go", "PostgreSQL", "Docker"}, params.Projects[0].TechnologyNames)
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		check func(t *testing.T, file File, err error)
+	}{
+		{
+			name: "OK",
+			input: `
+profile:
+  name: Jane
+  email: jane@example.com
+skills:
+  - name: Go
+    category: Backend
+    importance: 5
+`,
+			check: func(t *testing.T, file File, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "Jane", file.Profile.Name)
+				require.Len(t, file.Skills, 1)
+				require.Equal(t, int32(5), file.Skills[0].Importance)
+			},
+		},
+		{
+			name: "Unknown Field",
+			input: `
+profile:
+  name: Jane
+  emial: jane@example.com
+`,
+			check: func(t *testing.T, file File, err error) {
+				require.ErrorContains(t, err, "emial")
+			},
+		},
+		{
+			name:  "Empty",
+			input: "",
+			check: func(t *testing.T, file File, err error) {
+				require.EqualError(t, err, "seed file is empty")
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := Parse(strings.NewReader(tc.input))
+			tc.check(t, file, err)
+		})
+	}
+}
+
+func TestFileParams(t *testing.T) {
+	valid := func() File {
+		return File{
+			Profile:      Profile{Name: "Jane", Email: "jane@example.com"},
+			Technologies: []Technology{{Name: "Go", Order: 7}},
+			Skills:       []Skill{{Name: "Go", Category: "Backend", Importance: 3}},
+			Education:    []Education{{Institution: "MIT", Degree: "BSc", StartDate: "2014-10", EndDate: "2018-06-30"}},
+			Experience:   []Experience{{Company: "Acme", Position: "Developer", StartDate: "2018-07-01", EndDate: "2020-01", Skills: []string{"Go"}}},
+			Projects:     []Project{{Title: "CV", Skills: []string{"Go"}, Technologies: []string{"Go", "Postgres"}}},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		modify  func(file *File)
+		wantErr string
+	}{
+		{
+			name:   "OK",
+			modify: func(file *File) {},
+		},
+		{
+			name:    "Missing Profile Email",
+			modify:  func(file *File) { file.Profile.Email = " " },
+			wantErr: "profile.email is required",
+		},
+		{
+			name:    "Duplicate Skill",
+			modify:  func(file *File) { file.Skills = append(file.Skills, Skill{Name: "Go", Category: "Other"}) },
+			wantErr: `skills[1]: duplicate name "Go"`,
+		},
+		{
+			name:    "Duplicate Technology",
+			modify:  func(file *File) { file.Technologies = append(file.Technologies, Technology{Name: "Go"}) },
+			wantErr: `technologies[1]: duplicate name "Go"`,
+		},
+		{
+			name:    "Duplicate Project",
+			modify:  func(file *File) { file.Projects = append(file.Projects, Project{Title: "CV"}) },
+			wantErr: `projects[1]: duplicate title "CV"`,
+		},
+		{
+			name:    "Unknown Project Skill",
+			modify:  func(file *File) { file.Projects[0].Skills = []string{"Rust"} },
+			wantErr: `projects[0].skills: unknown skill "Rust"`,
+		},
+		{
+			name:    "Unknown Experience Skill",
+			modify:  func(file *File) { file.Experience[0].Skills = []string{"Rust"} },
+			wantErr: `experience[0].skills: unknown skill "Rust"`,
+		},
+		{
+			name:    "Invalid Date",
+			modify:  func(file *File) { file.Education[0].EndDate = "June 2018" },
+			wantErr: `education[0].end_date: invalid date "June 2018"`,
+		},
+		{
+			name:    "Missing Start Date",
+			modify:  func(file *File) { file.Experience[0].StartDate = "" },
+			wantErr: "experience[0].start_date: date is required",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			file := valid()
+			tc.modify(&file)
+
+			params, err := file.Params()
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, int32(7), params.Technologies[0].OrderField)
+			require.Equal(t, int32(3), params.Skills[0].Importance)
+			require.True(t, params.Experiences[0].EndDate.Valid)
+			require.Equal(t, []string{}, params.Experiences[0].Achievements)
+			// technologies do not have to be listed in the file
+			require.Equal(t, []string{"Go", "Postgres"}, params.Projects[0].TechnologyNames)
+		})
+	}
+}
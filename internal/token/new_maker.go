@@ -0,0 +1,20 @@
+package token
+
+import "fmt"
+
+const (
+	TypeJWT    = "jwt"
+	TypePaseto = "paseto"
+)
+
+// NewMaker creates a token maker of the given type - "jwt" or "paseto" (the default)
+func NewMaker(tokenType, secretKey string) (Maker, error) {
+	switch tokenType {
+	case TypeJWT:
+		return NewJWTMaker(secretKey)
+	case TypePaseto, "":
+		return NewPasetoMaker(secretKey)
+	default:
+		return nil, fmt.Errorf("unsupported token type: %q", tokenType)
+	}
+}
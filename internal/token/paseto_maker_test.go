@@ -0,0 +1,80 @@
+package token
+
+import (
+	"github.com/aalug/cv-backend-go/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestPasetoMaker(t *testing.T) {
+	maker, err := NewPasetoMaker(utils.RandomString(32))
+	require.NoError(t, err)
+
+	username := utils.RandomString(6)
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
+	token, payload, err := maker.CreateToken(username, duration)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.NotEmpty(t, payload)
+
+	require.NotZero(t, payload.ID)
+	require.Equal(t, username, payload.Username)
+	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
+}
+
+func TestExpiredPasetoToken(t *testing.T) {
+	maker, err := NewPasetoMaker(utils.RandomString(32))
+	require.NoError(t, err)
+
+	token, payload, err := maker.CreateToken(utils.RandomString(6), -time.Minute)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestInvalidPasetoToken(t *testing.T) {
+	maker, err := NewPasetoMaker(utils.RandomString(32))
+	require.NoError(t, err)
+
+	// a token signed with a different key
+	otherMaker, err := NewPasetoMaker(utils.RandomString(32))
+	require.NoError(t, err)
+	token, _, err := otherMaker.CreateToken(utils.RandomString(6), time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestNewMaker(t *testing.T) {
+	key := utils.RandomString(32)
+
+	maker, err := NewMaker(TypeJWT, key)
+	require.NoError(t, err)
+	require.IsType(t, &JWTMaker{}, maker)
+
+	maker, err = NewMaker(TypePaseto, key)
+	require.NoError(t, err)
+	require.IsType(t, &PasetoMaker{}, maker)
+
+	maker, err = NewMaker("invalid", key)
+	require.Error(t, err)
+	require.Nil(t, maker)
+}
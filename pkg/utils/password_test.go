@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"testing"
+)
+
+func TestPassword(t *testing.T) {
+	password := RandomString(8)
+
+	hashedPassword, err := HashPassword(password)
+	require.NoError(t, err)
+	require.NotEmpty(t, hashedPassword)
+	require.NotEqual(t, password, hashedPassword)
+
+	// correct password
+	err = CheckPassword(password, hashedPassword)
+	require.NoError(t, err)
+
+	// wrong password
+	wrongPassword := RandomString(8)
+	err = CheckPassword(wrongPassword, hashedPassword)
+	require.EqualError(t, err, bcrypt.ErrMismatchedHashAndPassword.Error())
+
+	// the same password is hashed with a different salt every time
+	hashedPassword2, err := HashPassword(password)
+	require.NoError(t, err)
+	require.NotEmpty(t, hashedPassword2)
+	require.NotEqual(t, hashedPassword, hashedPassword2)
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -34,3 +35,8 @@ func RandomString(n int) string {
 func RandomEmail() string {
 	return RandomString(6) + "@example.com"
 }
+
+// RandomHexColor returns a random color in the "#rrggbb" format
+func RandomHexColor() string {
+	return fmt.Sprintf("#%06x", rand.Intn(0x1000000))
+}
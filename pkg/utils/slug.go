@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"fmt"
+	"golang.org/x/text/unicode/norm"
+	"hash/fnv"
+	"strings"
+	"unicode"
+)
+
+// transliterations spell out the lower case Latin letters that have no decomposition into a base letter and an accent
+var transliterations = map[rune]string{
+	'æ': "ae",
+	'ð': "d",
+	'đ': "d",
+	'ħ': "h",
+	'ı': "i",
+	'ĸ': "k",
+	'ŀ': "l",
+	'ł': "l",
+	'ŋ': "ng",
+	'ø': "o",
+	'œ': "oe",
+	'ß': "ss",
+	'ſ': "s",
+	'þ': "th",
+	'ŧ': "t",
+}
+
+// Slugify returns the URL slug of a name, e.g. "C++" is "c-plus-plus", "Node.js" is "node-js" and "Łódź" is "lodz".
+// Slugs are lower case, Latin letters are without accents and spelled in ASCII, letters of other scripts are kept.
+// "+" and "#" are spelled out so "C", "C++" and "C#" stay different, and every other run of characters that are
+// not letters or digits becomes a single "-". A name without any letters or digits, e.g. "!?", gets a hash of
+// the name as its slug, so only the empty name has an empty slug.
+// The slug of a slug is the slug itself. Every store saves the slugs made by Slugify, so they are the same
+// on every database, whatever its locale and Unicode version.
+func Slugify(name string) string {
+	var sb strings.Builder
+	separate := false
+
+	writeWord := func(word string) {
+		if separate && sb.Len() > 0 {
+			sb.WriteByte('-')
+		}
+		separate = false
+		sb.WriteString(word)
+	}
+
+	for _, r := range norm.NFD.String(strings.ToLower(name)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// accents are combining marks after the decomposition
+		case transliterations[r] != "":
+			writeWord(transliterations[r])
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			writeWord(string(r))
+		case r == '+':
+			separate = true
+			writeWord("plus")
+			separate = true
+		case r == '#':
+			separate = true
+			writeWord("sharp")
+			separate = true
+		default:
+			separate = true
+		}
+	}
+
+	if sb.Len() == 0 && name != "" {
+		h := fnv.New32a()
+		h.Write([]byte(name))
+		return fmt.Sprintf("%08x", h.Sum32())
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	testCases := []struct {
+		name string
+		slug string
+	}{
+		{name: "Go", slug: "go"},
+		{name: "C", slug: "c"},
+		{name: "C++", slug: "c-plus-plus"},
+		{name: "C#", slug: "c-sharp"},
+		{name: "F#", slug: "f-sharp"},
+		{name: "Node.js", slug: "node-js"},
+		{name: ".NET", slug: "net"},
+		{name: "Vue 3", slug: "vue-3"},
+		{name: "  Ruby   on Rails ", slug: "ruby-on-rails"},
+		{name: "a+b", slug: "a-plus-b"},
+		{name: "Café", slug: "cafe"},
+		{name: "Łódź Ñandú", slug: "lodz-nandu"},
+		{name: "Øresund Straße", slug: "oresund-strasse"},
+		{name: "Þórr Æsir", slug: "thorr-aesir"},
+		{name: "CAFÉ", slug: "cafe"},
+		{name: "Питон", slug: "питон"},
+		{name: "c-plus-plus", slug: "c-plus-plus"},
+		{name: "++", slug: "plus-plus"},
+		{name: "", slug: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			slug := Slugify(tc.name)
+			require.Equal(t, tc.slug, slug)
+			// slugs are stable
+			require.Equal(t, slug, Slugify(slug))
+		})
+	}
+}
+
+func TestSlugifyWithoutLettersOrDigits(t *testing.T) {
+	names := []string{"!?", "...", "* *", "-"}
+
+	// every name has a slug, different names get different slugs
+	slugs := make(map[string]bool)
+	for _, name := range names {
+		slug := Slugify(name)
+		require.Regexp(t, "^[0-9a-f]{8}$", slug, name)
+		require.Equal(t, slug, Slugify(name))
+		require.Equal(t, slug, Slugify(slug))
+		slugs[slug] = true
+	}
+	require.Len(t, slugs, len(names))
+}